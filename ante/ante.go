@@ -9,6 +9,7 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/atomone-hub/govgen/types/errors"
+	globalfeekeeper "github.com/atomone-hub/govgen/x/globalfee/keeper"
 	govkeeper "github.com/atomone-hub/govgen/x/gov/keeper"
 )
 
@@ -18,7 +19,14 @@ type HandlerOptions struct {
 	ante.HandlerOptions
 	Codec           codec.BinaryCodec
 	GovKeeper       *govkeeper.Keeper
+	GlobalFeeKeeper *globalfeekeeper.Keeper
 	StakingSubspace paramtypes.Subspace
+
+	// BypassMinFeeMsgTypes and MaxTotalBypassMinFeeMsgGasUsage define the txs
+	// exempted from the local minimum gas prices, unless the globalfee params
+	// override them.
+	BypassMinFeeMsgTypes            []string
+	MaxTotalBypassMinFeeMsgGasUsage uint64
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
@@ -37,6 +45,9 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.GovKeeper == nil {
 		return nil, errorsmod.Wrap(errors.ErrLogic, "gov keeper is required for AnteHandler")
 	}
+	if opts.GlobalFeeKeeper == nil {
+		return nil, errorsmod.Wrap(errors.ErrLogic, "globalfee keeper is required for AnteHandler")
+	}
 
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
@@ -46,12 +57,14 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
+		NewBaseFeeDecorator(NewMempoolFeeDecorator(opts.GlobalFeeKeeper, opts.BypassMinFeeMsgTypes, opts.MaxTotalBypassMinFeeMsgGasUsage)),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewGovPreventSpamDecorator(opts.Codec, opts.GovKeeper),
+		NewGovRateLimitDecorator(opts.Codec, opts.GovKeeper),
+		NewVoteFeeSponsorshipDecorator(opts.GovKeeper),
 		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
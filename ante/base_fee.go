@@ -0,0 +1,50 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BaseFeeDecorator replaces the MempoolFeeDecorator when the dynamic base fee
+// is enabled in the globalfee params. The fee of a tx must then cover the base
+// fee, which adjusts at the end of each block to the gas used by the block,
+// instead of the static network minimum gas prices. The base fee is enforced
+// in both CheckTx and DeliverTx, raised in CheckTx by the local minimum gas
+// prices, and the same bypass applies as for the minimum gas prices. When the
+// base fee is disabled, the MempoolFeeDecorator is run instead.
+//
+// CONTRACT: Tx must implement FeeTx to use BaseFeeDecorator
+type BaseFeeDecorator struct {
+	MempoolFeeDecorator
+}
+
+func NewBaseFeeDecorator(mfd MempoolFeeDecorator) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		MempoolFeeDecorator: mfd,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	params := bfd.globalFeeKeeper.GetParams(ctx)
+	if !params.BaseFee.Enabled {
+		return bfd.MempoolFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// Skip the base fee when simulating, and for the genesis transactions
+	// which are delivered at height 0 and carry no fees.
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.globalFeeKeeper.GetEffectiveBaseFee(ctx, params.BaseFee)
+	if err := bfd.checkFees(ctx, feeTx, params, baseFee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -1,22 +1,45 @@
 package ante
 
 import (
+	"golang.org/x/exp/slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	globalfeekeeper "github.com/atomone-hub/govgen/x/globalfee/keeper"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
 )
 
 // MempoolFeeDecorator will check if the transaction's fee is at least as large
-// as the local validator's minimum gasFee (defined in validator config).
+// as the network-wide minimum gas prices (defined in the globalfee params),
+// raised by the local validator's minimum gasFee (defined in validator config).
+//
+// If fee is too low, decorator returns error and tx is rejected. The network
+// minimum gas prices are enforced in both CheckTx and DeliverTx, while the
+// local ones only apply when ctx.CheckTx = true. If fee is high enough, then
+// call next AnteHandler.
 //
-// If fee is too low, decorator returns error and tx is rejected from mempool.
-// Note this only applies when ctx.CheckTx = true. If fee is high enough or not
-// CheckTx, then call next AnteHandler.
+// Txs which only contain bypass messages, and whose gas limit is at most the
+// bypass gas cap, are exempted from the minimum gas prices. The bypass defined
+// in the globalfee params exempts them from both the network and local minimum
+// gas prices. Otherwise the bypass configured by the operator (defined in
+// validator config) only exempts them from the local ones.
 //
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
-type MempoolFeeDecorator struct{}
+type MempoolFeeDecorator struct {
+	globalFeeKeeper                 *globalfeekeeper.Keeper
+	bypassMinFeeMsgTypes            []string
+	maxTotalBypassMinFeeMsgGasUsage uint64
+}
 
-func NewMempoolFeeDecorator() MempoolFeeDecorator {
-	return MempoolFeeDecorator{}
+func NewMempoolFeeDecorator(
+	globalFeeKeeper *globalfeekeeper.Keeper, bypassMinFeeMsgTypes []string, maxTotalBypassMinFeeMsgGasUsage uint64,
+) MempoolFeeDecorator {
+	return MempoolFeeDecorator{
+		globalFeeKeeper:                 globalFeeKeeper,
+		bypassMinFeeMsgTypes:            bypassMinFeeMsgTypes,
+		maxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
+	}
 }
 
 func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -25,31 +48,97 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
+	// Skip the minimum fees when simulating, and for the genesis transactions
+	// which are delivered at height 0 and carry no fees.
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := mfd.globalFeeKeeper.GetParams(ctx)
+	if err := mfd.checkFees(ctx, feeTx, params, params.MinimumGasPrices); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// checkFees checks that the fee of the tx covers the network gas prices, and
+// in CheckTx the local minimum gas prices too, unless the tx is exempted by the
+// bypass.
+func (mfd MempoolFeeDecorator) checkFees(ctx sdk.Context, feeTx sdk.FeeTx, params globalfeetypes.Params, networkGasPrices sdk.DecCoins) error {
 	feeCoins := feeTx.GetFee()
 	gas := feeTx.GetGas()
 
-	// Only check for minimum fees if the execution mode is CheckTx and the tx does
-	// not contain operator configured bypass messages. If the tx does contain
-	// operator configured bypass messages only, it's total gas must be less than
-	// or equal to a constant, otherwise minimum fees are checked to prevent spam.
-	if ctx.IsCheckTx() && !simulate {
-		minGasPrices := ctx.MinGasPrices()
-		if !minGasPrices.IsZero() {
-			requiredFees := make(sdk.Coins, len(minGasPrices))
-
-			// Determine the required fees by multiplying each required minimum gas
-			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-			glDec := sdk.NewDec(int64(gas))
-			for i, gp := range minGasPrices {
-				fee := gp.Amount.Mul(glDec)
-				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-			}
-
-			if !feeCoins.IsAnyGTE(requiredFees) {
-				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
-			}
+	// Only the bypass of the globalfee params, which is the same for all the
+	// validators, can exempt a tx from the network gas prices.
+	var minGasPrices sdk.DecCoins
+	if !params.HasBypassMinFeeMsgTypes() ||
+		!isBypassMinFeeTx(feeTx, gas, params.BypassMinFeeMsgTypes, params.MaxTotalBypassMinFeeMsgGasUsage) {
+		minGasPrices = networkGasPrices
+	}
+
+	if ctx.IsCheckTx() {
+		bypassMinFeeMsgTypes, maxTotalBypassMinFeeMsgGasUsage := mfd.bypassMinFeeMsgTypes, mfd.maxTotalBypassMinFeeMsgGasUsage
+		if params.HasBypassMinFeeMsgTypes() {
+			bypassMinFeeMsgTypes, maxTotalBypassMinFeeMsgGasUsage = params.BypassMinFeeMsgTypes, params.MaxTotalBypassMinFeeMsgGasUsage
+		}
+		if !isBypassMinFeeTx(feeTx, gas, bypassMinFeeMsgTypes, maxTotalBypassMinFeeMsgGasUsage) {
+			minGasPrices = combinedMinGasPrices(minGasPrices, ctx.MinGasPrices())
 		}
 	}
 
-	return next(ctx, tx, simulate)
+	if !minGasPrices.IsZero() {
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+
+		// Determine the required fees by multiplying each required minimum gas
+		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+		glDec := sdk.NewDec(int64(gas))
+		for i, gp := range minGasPrices {
+			fee := gp.Amount.Mul(glDec)
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+
+		if !feeCoins.IsAnyGTE(requiredFees) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+		}
+	}
+
+	return nil
+}
+
+// isBypassMinFeeTx returns true if the tx only contains messages of the bypass
+// types, and its gas limit is at most the bypass gas cap.
+func isBypassMinFeeTx(tx sdk.Tx, gas uint64, bypassMinFeeMsgTypes []string, maxTotalBypassMinFeeMsgGasUsage uint64) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 || gas > maxTotalBypassMinFeeMsgGasUsage {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if !slices.Contains(bypassMinFeeMsgTypes, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// combinedMinGasPrices returns the network minimum gas prices raised by the
+// local ones, so that a validator can only be stricter than the network. Local
+// gas prices in denoms that the network does not accept are ignored, unless
+// the network sets no minimum gas prices at all.
+func combinedMinGasPrices(globalMinGasPrices, localMinGasPrices sdk.DecCoins) sdk.DecCoins {
+	if globalMinGasPrices.Empty() {
+		return localMinGasPrices
+	}
+
+	combined := make(sdk.DecCoins, len(globalMinGasPrices))
+	for i, gp := range globalMinGasPrices {
+		if local := localMinGasPrices.AmountOf(gp.Denom); local.GT(gp.Amount) {
+			gp.Amount = local
+		}
+		combined[i] = gp
+	}
+
+	return combined
 }
@@ -14,12 +14,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
 	"github.com/atomone-hub/govgen/ante"
 	govgenapp "github.com/atomone-hub/govgen/app"
 	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	govgenappparams "github.com/atomone-hub/govgen/app/params"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
 )
 
 type FeeIntegrationTestSuite struct {
@@ -103,7 +108,7 @@ func (s *FeeIntegrationTestSuite) TestMempoolFeeDecorator() {
 	s.SetupTest()
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
 
-	mfd := ante.NewMempoolFeeDecorator()
+	mfd := ante.NewMempoolFeeDecorator(&s.app.GlobalFeeKeeper, nil, 0)
 	antehandler := sdk.ChainAnteDecorators(mfd)
 	priv1, _, addr1 := testdata.KeyTestPubAddr()
 
@@ -129,7 +134,285 @@ func (s *FeeIntegrationTestSuite) TestMempoolFeeDecorator() {
 
 	s.ctx = s.ctx.WithIsCheckTx(false)
 
-	// antehandler should not error since we do not check min gas prices in DeliverTx
+	// antehandler should not error since we do not check local min gas prices in DeliverTx
 	_, err = antehandler(s.ctx, tx, false)
 	s.Require().NoError(err, "unexpected error during DeliverTx")
 }
+
+func (s *FeeIntegrationTestSuite) TestMempoolFeeDecoratorGlobalMinGasPrices() {
+	s.SetupTest()
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewMempoolFeeDecorator(&s.app.GlobalFeeKeeper, nil, 0)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// fee of 150atom for 200000 gas, i.e. a gas price of 0.00075atom
+	msg := testdata.NewTestMsg(addr1)
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	tests := []struct {
+		name            string
+		globalGasPrices sdk.DecCoins
+		localGasPrices  sdk.DecCoins
+		isCheckTx       bool
+		simulate        bool
+		expErr          bool
+	}{
+		{
+			name:      "no min gas prices",
+			isCheckTx: true,
+		},
+		{
+			name:            "global min gas prices met in DeliverTx",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(75, 5))),
+		},
+		{
+			name:            "global min gas prices not met in DeliverTx",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			expErr:          true,
+		},
+		{
+			name:            "global min gas prices not met in CheckTx",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			isCheckTx:       true,
+			expErr:          true,
+		},
+		{
+			name:            "global min gas prices not met when simulating",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			simulate:        true,
+		},
+		{
+			name:            "global min gas prices in another denom",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 5))),
+			expErr:          true,
+		},
+		{
+			name:            "local min gas prices cannot lower the global ones",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			localGasPrices:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 5))),
+			isCheckTx:       true,
+			expErr:          true,
+		},
+		{
+			name:            "local min gas prices cannot add a denom to the global ones",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 5))),
+			localGasPrices:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 5))),
+			isCheckTx:       true,
+			expErr:          true,
+		},
+		{
+			name:            "local min gas prices raise the global ones in CheckTx",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 5))),
+			localGasPrices:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			isCheckTx:       true,
+			expErr:          true,
+		},
+		{
+			name:            "local min gas prices ignored in DeliverTx",
+			globalGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 5))),
+			localGasPrices:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+		},
+		{
+			name:           "local min gas prices without global ones",
+			localGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(76, 5))),
+			isCheckTx:      true,
+			expErr:         true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.app.GlobalFeeKeeper.SetParams(s.ctx, globalfeetypes.NewParams(tc.globalGasPrices, nil, 0, globalfeetypes.DefaultBaseFeeParams()))
+			ctx := s.ctx.WithMinGasPrices(tc.localGasPrices).WithIsCheckTx(tc.isCheckTx)
+
+			_, err := antehandler(ctx, tx, tc.simulate)
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *FeeIntegrationTestSuite) TestMempoolFeeDecoratorBypass() {
+	s.SetupTest()
+
+	mfd := ante.NewMempoolFeeDecorator(&s.app.GlobalFeeKeeper,
+		govgenappparams.DefaultBypassMinFeeMsgTypes(), govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	vote := govtypes.NewMsgVote(addr1, 1, govtypes.OptionYes, "")
+	weightedVote := govv1.NewMsgVoteWeighted(addr1, 1, []*govv1.WeightedVoteOption{{Option: govv1.VoteOption_VOTE_OPTION_YES, Weight: "1"}}, "")
+	deposit := govtypes.NewMsgDeposit(addr1, 1, sdk.NewCoins(sdk.NewInt64Coin("ugovgen", 1)))
+	globalGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 2)))
+	localGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(2, 2)))
+
+	tests := []struct {
+		name         string
+		msgs         []sdk.Msg
+		gasLimit     uint64
+		globalParams globalfeetypes.Params
+		isCheckTx    bool
+		expErr       bool
+	}{
+		{
+			name:      "vote bypasses local min gas prices",
+			msgs:      []sdk.Msg{vote},
+			gasLimit:  govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+			isCheckTx: true,
+		},
+		{
+			name:      "votes bypass local min gas prices",
+			msgs:      []sdk.Msg{vote, weightedVote},
+			gasLimit:  govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+			isCheckTx: true,
+		},
+		{
+			name:      "vote above the gas cap",
+			msgs:      []sdk.Msg{vote},
+			gasLimit:  govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage + 1,
+			isCheckTx: true,
+			expErr:    true,
+		},
+		{
+			name:      "vote with a non bypass message",
+			msgs:      []sdk.Msg{vote, deposit},
+			gasLimit:  govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+			isCheckTx: true,
+			expErr:    true,
+		},
+		{
+			name:         "local bypass does not waive global min gas prices in CheckTx",
+			msgs:         []sdk.Msg{vote},
+			gasLimit:     govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+			globalParams: globalfeetypes.NewParams(globalGasPrices, nil, 0, globalfeetypes.DefaultBaseFeeParams()),
+			isCheckTx:    true,
+			expErr:       true,
+		},
+		{
+			name:         "local bypass does not waive global min gas prices in DeliverTx",
+			msgs:         []sdk.Msg{vote},
+			gasLimit:     govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+			globalParams: globalfeetypes.NewParams(globalGasPrices, nil, 0, globalfeetypes.DefaultBaseFeeParams()),
+			expErr:       true,
+		},
+		{
+			name:     "global bypass waives global min gas prices in DeliverTx",
+			msgs:     []sdk.Msg{vote},
+			gasLimit: 100000,
+			globalParams: globalfeetypes.NewParams(globalGasPrices,
+				[]string{sdk.MsgTypeURL(&govtypes.MsgVote{})}, 100000, globalfeetypes.DefaultBaseFeeParams()),
+		},
+		{
+			name:     "global bypass waives global and local min gas prices in CheckTx",
+			msgs:     []sdk.Msg{vote},
+			gasLimit: 100000,
+			globalParams: globalfeetypes.NewParams(globalGasPrices,
+				[]string{sdk.MsgTypeURL(&govtypes.MsgVote{})}, 100000, globalfeetypes.DefaultBaseFeeParams()),
+			isCheckTx: true,
+		},
+		{
+			name:     "global bypass overrides the local message types",
+			msgs:     []sdk.Msg{weightedVote},
+			gasLimit: 100000,
+			globalParams: globalfeetypes.NewParams(sdk.DecCoins{},
+				[]string{sdk.MsgTypeURL(&govtypes.MsgVote{})}, 100000, globalfeetypes.DefaultBaseFeeParams()),
+			isCheckTx: true,
+			expErr:    true,
+		},
+		{
+			name:     "global bypass overrides the local gas cap",
+			msgs:     []sdk.Msg{vote},
+			gasLimit: 100001,
+			globalParams: globalfeetypes.NewParams(sdk.DecCoins{},
+				[]string{sdk.MsgTypeURL(&govtypes.MsgVote{})}, 100000, globalfeetypes.DefaultBaseFeeParams()),
+			isCheckTx: true,
+			expErr:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(tc.msgs...))
+			s.txBuilder.SetFeeAmount(sdk.Coins{})
+			s.txBuilder.SetGasLimit(tc.gasLimit)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			s.app.GlobalFeeKeeper.SetParams(s.ctx, tc.globalParams)
+			ctx := s.ctx.WithMinGasPrices(localGasPrices).WithIsCheckTx(tc.isCheckTx)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func (s *FeeIntegrationTestSuite) TestBaseFeeDecorator() {
+	s.SetupTest()
+
+	mfd := ante.NewMempoolFeeDecorator(&s.app.GlobalFeeKeeper, nil, 0)
+	antehandler := sdk.ChainAnteDecorators(ante.NewBaseFeeDecorator(mfd))
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// the fee of the tx is 0.01ugovgen per gas
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("ugovgen", 1000)))
+	s.txBuilder.SetGasLimit(100000)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 1)))
+	floor := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(8, 3)))
+	baseFeeParams := globalfeetypes.NewBaseFeeParams(true, 1000, sdk.NewDecWithPrec(125, 3), floor)
+
+	// the minimum gas prices apply while the base fee is disabled
+	s.app.GlobalFeeKeeper.SetParams(s.ctx, globalfeetypes.NewParams(minGasPrices, nil, 0, globalfeetypes.DefaultBaseFeeParams()))
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the base fee replaces the minimum gas prices, starting at the floor
+	s.app.GlobalFeeKeeper.SetParams(s.ctx, globalfeetypes.NewParams(minGasPrices, nil, 0, baseFeeParams))
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+
+	// the base fee rises above the fee of the tx after two full blocks
+	s.app.GlobalFeeKeeper.UpdateBaseFee(s.ctx, 2000)
+	s.Require().Equal(sdk.MustNewDecFromStr("0.009"), s.app.GlobalFeeKeeper.GetBaseFee(s.ctx).AmountOf("ugovgen"))
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+	s.app.GlobalFeeKeeper.UpdateBaseFee(s.ctx, 2000)
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// the local minimum gas prices raise the base fee in CheckTx
+	s.app.GlobalFeeKeeper.UpdateBaseFee(s.ctx, 0)
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+	_, err = antehandler(s.ctx.WithIsCheckTx(true).WithMinGasPrices(minGasPrices), tx, false)
+	s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+
+	// disabling the base fee clears it
+	s.app.GlobalFeeKeeper.SetParams(s.ctx, globalfeetypes.NewParams(minGasPrices, nil, 0, globalfeetypes.DefaultBaseFeeParams()))
+	s.app.GlobalFeeKeeper.UpdateBaseFee(s.ctx, 0)
+	s.Require().Empty(s.app.GlobalFeeKeeper.GetBaseFee(s.ctx))
+}
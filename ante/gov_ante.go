@@ -10,6 +10,7 @@ import (
 	"github.com/atomone-hub/govgen/types/errors"
 	govkeeper "github.com/atomone-hub/govgen/x/gov/keeper"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
 )
 
 // initial deposit must be greater than or equal to 1% of the minimum deposit
@@ -44,16 +45,44 @@ func (g GovPreventSpamDecorator) AnteHandle(
 	return next(ctx, tx, simulate)
 }
 
-// validateGovMsgs checks if the InitialDeposit amounts are greater than the minimum initial deposit amount
+// validateGovMsgs checks if the content types of the proposals are allowed and
+// if the InitialDeposit amounts are greater than the minimum initial deposit amount
 func (g GovPreventSpamDecorator) ValidateGovMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
 	validMsg := func(m sdk.Msg) error {
-		if msg, ok := m.(*govtypes.MsgSubmitProposal); ok {
-			// prevent messages with insufficient initial deposit amount
-			depositParams := g.govKeeper.GetDepositParams(ctx)
-			minInitialDeposit := g.calcMinInitialDeposit(depositParams.MinDeposit)
-			if !msg.InitialDeposit.IsAllGTE(minInitialDeposit) {
-				return errorsmod.Wrapf(errors.ErrInsufficientFunds, "insufficient initial deposit amount - required: %v", minInitialDeposit)
+		var (
+			contentTypeURLs []string
+			initialDeposit  sdk.Coins
+		)
+		switch msg := m.(type) {
+		case *govtypes.MsgSubmitProposal:
+			if msg.Content != nil {
+				contentTypeURLs = append(contentTypeURLs, msg.Content.TypeUrl)
 			}
+			initialDeposit = msg.InitialDeposit
+		case *govv1.MsgSubmitProposal:
+			for _, a := range msg.Messages {
+				if exec, ok := a.GetCachedValue().(*govv1.MsgExecLegacyContent); ok && exec.Content != nil {
+					contentTypeURLs = append(contentTypeURLs, exec.Content.TypeUrl)
+				}
+			}
+			initialDeposit = msg.InitialDeposit
+		default:
+			return nil
+		}
+
+		depositParams := g.govKeeper.GetDepositParams(ctx)
+
+		// prevent messages with a content type not allowed by the params
+		for _, typeURL := range contentTypeURLs {
+			if !depositParams.IsContentTypeAllowed(typeURL) {
+				return errorsmod.Wrap(govtypes.ErrContentTypeNotAllowed, typeURL)
+			}
+		}
+
+		// prevent messages with insufficient initial deposit amount
+		minInitialDeposit := g.calcMinInitialDeposit(depositParams.MinDeposit)
+		if !initialDeposit.IsAllGTE(minInitialDeposit) {
+			return errorsmod.Wrapf(errors.ErrInsufficientFunds, "insufficient initial deposit amount - required: %v", minInitialDeposit)
 		}
 
 		return nil
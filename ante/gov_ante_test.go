@@ -16,6 +16,7 @@ import (
 	govgenapp "github.com/atomone-hub/govgen/app"
 	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
 )
 
 var (
@@ -98,3 +99,48 @@ func (s *GovAnteHandlerTestSuite) TestGlobalFeeMinimumGasFeeAnteHandler() {
 		}
 	}
 }
+
+func (s *GovAnteHandlerTestSuite) TestGovAllowedContentTypesAnteHandler() {
+	// setup test
+	s.SetupTest()
+	decorator := ante.NewGovPreventSpamDecorator(s.app.AppCodec(), &s.app.GovKeeper)
+
+	content := govtypes.ContentFromProposalType("title", "description", govtypes.ProposalTypeText)
+	msg, err := govtypes.NewMsgSubmitProposal(content, minCoins, testAddr)
+	s.Require().NoError(err)
+
+	// all content types are allowed by default
+	s.Require().NoError(decorator.ValidateGovMsgs(s.ctx, []sdk.Msg{msg}))
+
+	depositParams := s.app.GovKeeper.GetDepositParams(s.ctx)
+	depositParams.AllowedContentTypes = []string{"/cosmos.params.v1beta1.ParameterChangeProposal"}
+	s.app.GovKeeper.SetDepositParams(s.ctx, depositParams)
+
+	err = decorator.ValidateGovMsgs(s.ctx, []sdk.Msg{msg})
+	s.Require().ErrorIs(err, govtypes.ErrContentTypeNotAllowed)
+}
+
+func (s *GovAnteHandlerTestSuite) TestGovV1SubmitProposalAnteHandler() {
+	// setup test
+	s.SetupTest()
+	decorator := ante.NewGovPreventSpamDecorator(s.app.AppCodec(), &s.app.GovKeeper)
+
+	content := govtypes.ContentFromProposalType("title", "description", govtypes.ProposalTypeText)
+	exec, err := govv1.NewMsgExecLegacyContent(content, s.app.GovKeeper.GetAuthority())
+	s.Require().NoError(err)
+
+	msg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{exec}, insufficientCoins, testAddr, "")
+	s.Require().NoError(err)
+	s.Require().Error(decorator.ValidateGovMsgs(s.ctx, []sdk.Msg{msg}))
+
+	msg, err = govv1.NewMsgSubmitProposal([]sdk.Msg{exec}, minCoins, testAddr, "")
+	s.Require().NoError(err)
+	s.Require().NoError(decorator.ValidateGovMsgs(s.ctx, []sdk.Msg{msg}))
+
+	depositParams := s.app.GovKeeper.GetDepositParams(s.ctx)
+	depositParams.AllowedContentTypes = []string{"/cosmos.params.v1beta1.ParameterChangeProposal"}
+	s.app.GovKeeper.SetDepositParams(s.ctx, depositParams)
+
+	err = decorator.ValidateGovMsgs(s.ctx, []sdk.Msg{msg})
+	s.Require().ErrorIs(err, govtypes.ErrContentTypeNotAllowed)
+}
@@ -0,0 +1,103 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/atomone-hub/govgen/types/errors"
+	govkeeper "github.com/atomone-hub/govgen/x/gov/keeper"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+// GovRateLimitDecorator counts the proposals submitted and the votes cast by
+// each account, including the ones inside authz.MsgExec, and rejects the txs
+// which would make an account exceed the GovMsgRateLimit of the voting params
+// in its sliding window of blocks. The counts are recorded in both CheckTx and
+// DeliverTx, so that the mempool is protected as well.
+type GovRateLimitDecorator struct {
+	govKeeper *govkeeper.Keeper
+	cdc       codec.BinaryCodec
+}
+
+func NewGovRateLimitDecorator(cdc codec.BinaryCodec, govKeeper *govkeeper.Keeper) GovRateLimitDecorator {
+	return GovRateLimitDecorator{
+		govKeeper: govKeeper,
+		cdc:       cdc,
+	}
+}
+
+func (g GovRateLimitDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	counts := make(map[string]govtypes.GovMsgCount)
+	var addrs []string
+	if err := g.countGovMsgs(tx.GetMsgs(), counts, &addrs); err != nil {
+		return ctx, err
+	}
+
+	for _, addr := range addrs {
+		accAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid governance message sender %s: %s", addr, err)
+		}
+		if err := g.govKeeper.RecordGovMsgs(ctx, accAddr, counts[addr]); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// countGovMsgs adds the proposals and votes of msgs to the counts of their
+// proposer or voter, recursing into authz.MsgExec. Each vote of a
+// MsgVoteBatch counts as a vote. The addresses are appended to addrs in the
+// order they are first seen, to keep the recording deterministic.
+func (g GovRateLimitDecorator) countGovMsgs(msgs []sdk.Msg, counts map[string]govtypes.GovMsgCount, addrs *[]string) error {
+	for _, m := range msgs {
+		var (
+			addr  string
+			count govtypes.GovMsgCount
+		)
+		switch msg := m.(type) {
+		case *authz.MsgExec:
+			innerMsgs := make([]sdk.Msg, len(msg.Msgs))
+			for i, v := range msg.Msgs {
+				if err := g.cdc.UnpackAny(v, &innerMsgs[i]); err != nil {
+					return errorsmod.Wrap(errors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+				}
+			}
+			if err := g.countGovMsgs(innerMsgs, counts, addrs); err != nil {
+				return err
+			}
+			continue
+		case *govtypes.MsgSubmitProposal:
+			addr, count = msg.Proposer, govtypes.NewGovMsgCount(1, 0)
+		case *govv1.MsgSubmitProposal:
+			addr, count = msg.Proposer, govtypes.NewGovMsgCount(1, 0)
+		case *govtypes.MsgVote:
+			addr, count = msg.Voter, govtypes.NewGovMsgCount(0, 1)
+		case *govtypes.MsgVoteWeighted:
+			addr, count = msg.Voter, govtypes.NewGovMsgCount(0, 1)
+		case *govtypes.MsgVoteBatch:
+			addr, count = msg.Voter, govtypes.NewGovMsgCount(0, uint64(len(msg.Votes)))
+		case *govv1.MsgVote:
+			addr, count = msg.Voter, govtypes.NewGovMsgCount(0, 1)
+		case *govv1.MsgVoteWeighted:
+			addr, count = msg.Voter, govtypes.NewGovMsgCount(0, 1)
+		default:
+			continue
+		}
+
+		if _, ok := counts[addr]; !ok {
+			*addrs = append(*addrs, addr)
+		}
+		counts[addr] = counts[addr].Add(count)
+	}
+	return nil
+}
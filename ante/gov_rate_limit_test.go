@@ -0,0 +1,111 @@
+package ante_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/atomone-hub/govgen/ante"
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+func (s *GovAnteHandlerTestSuite) TestGovRateLimitAnteHandler() {
+	// setup test
+	s.SetupTest()
+	app, ctx := s.app, s.ctx.WithBlockHeight(1)
+	decorator := ante.NewGovRateLimitDecorator(app.AppCodec(), &app.GovKeeper)
+
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	voter, grantee := addrs[0], addrs[1]
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.GovMsgRateLimit = govtypes.NewGovMsgRateLimit(2, 1, 2)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	vote := govtypes.NewMsgVote(voter, 1, govtypes.OptionYes, "")
+	voteV1 := govv1.NewMsgVote(voter, 1, govv1.VoteOption_VOTE_OPTION_NO, "")
+	proposal, err := govtypes.NewMsgSubmitProposal(govgenhelpers.TestTextProposal, nil, voter)
+	s.Require().NoError(err)
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{vote})
+
+	tests := []struct {
+		name   string
+		height int64
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"vote", 1, []sdk.Msg{vote}, false},
+		{"vote in authz exec", 1, []sdk.Msg{&exec}, false},
+		{"vote over the limit", 1, []sdk.Msg{voteV1}, true},
+		{"proposal", 1, []sdk.Msg{proposal}, false},
+		{"proposal over the limit", 2, []sdk.Msg{proposal}, true},
+		{"votes over the limit in a single tx", 3, []sdk.Msg{vote, &exec, voteV1}, true},
+		{"votes once the window has passed", 3, []sdk.Msg{vote, &exec}, false},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := decorator.AnteHandle(ctx.WithBlockHeight(tc.height), txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr {
+				s.Require().ErrorIs(err, govtypes.ErrGovMsgRateLimitExceeded)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *GovAnteHandlerTestSuite) TestGovRateLimitAnteHandlerVoteBatch() {
+	// setup test
+	s.SetupTest()
+	app, ctx := s.app, s.ctx.WithBlockHeight(1)
+	decorator := ante.NewGovRateLimitDecorator(app.AppCodec(), &app.GovKeeper)
+
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000))
+	voter, grantee := addrs[0], addrs[1]
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.GovMsgRateLimit = govtypes.NewGovMsgRateLimit(2, 1, 2)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	batchVote := func(proposalID uint64) govtypes.BatchVote {
+		return govtypes.BatchVote{ProposalId: proposalID, Options: govtypes.NewNonSplitVoteOption(govtypes.OptionYes)}
+	}
+	batch := govtypes.NewMsgVoteBatch(voter, []govtypes.BatchVote{batchVote(1), batchVote(2)})
+	largeBatch := govtypes.NewMsgVoteBatch(voter, []govtypes.BatchVote{batchVote(1), batchVote(2), batchVote(3)})
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{largeBatch})
+
+	tests := []struct {
+		name   string
+		height int64
+		msgs   []sdk.Msg
+		expErr bool
+	}{
+		{"batch over the limit", 1, []sdk.Msg{largeBatch}, true},
+		{"batch over the limit in authz exec", 1, []sdk.Msg{&exec}, true},
+		{"batch", 1, []sdk.Msg{batch}, false},
+		{"vote after a batch reaching the limit", 2, []sdk.Msg{govtypes.NewMsgVote(voter, 1, govtypes.OptionYes, "")}, true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+
+			_, err := decorator.AnteHandle(ctx.WithBlockHeight(tc.height), txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr {
+				s.Require().ErrorIs(err, govtypes.ErrGovMsgRateLimitExceeded)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	govkeeper "github.com/atomone-hub/govgen/x/gov/keeper"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+// VoteFeeSponsorshipDecorator pays the fees of the txs which only contain
+// votes of an account with bonded stake on a proposal from the vote fee pool,
+// within the budget per account per proposal of the gov voting params. The fee
+// is sent from the pool to the voter, who must be the fee payer, so this
+// decorator must be placed before the DeductFeeDecorator. Txs which are not
+// eligible are passed on unchanged, and their fees are paid as usual.
+//
+// CONTRACT: Tx must implement FeeTx to use VoteFeeSponsorshipDecorator
+type VoteFeeSponsorshipDecorator struct {
+	govKeeper *govkeeper.Keeper
+}
+
+func NewVoteFeeSponsorshipDecorator(govKeeper *govkeeper.Keeper) VoteFeeSponsorshipDecorator {
+	return VoteFeeSponsorshipDecorator{
+		govKeeper: govKeeper,
+	}
+}
+
+func (vfd VoteFeeSponsorshipDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() || feeTx.FeeGranter() != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	voter, proposalID, ok := voteOnlyTx(tx.GetMsgs())
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	voterAddr, err := sdk.AccAddressFromBech32(voter)
+	if err != nil || !voterAddr.Equals(feeTx.FeePayer()) {
+		return next(ctx, tx, simulate)
+	}
+
+	if _, err := vfd.govKeeper.SponsorVoteFee(ctx, proposalID, voterAddr, fee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// voteOnlyTx returns the voter and the proposal of the messages if they are
+// all votes of the same voter on the same proposal. MsgVoteBatch is not
+// eligible: its votes target several proposals, while the fee is charged to
+// the sponsorship budget of the voter on a single proposal.
+func voteOnlyTx(msgs []sdk.Msg) (voter string, proposalID uint64, ok bool) {
+	for i, m := range msgs {
+		var (
+			msgVoter      string
+			msgProposalID uint64
+		)
+		switch msg := m.(type) {
+		case *govtypes.MsgVote:
+			msgVoter, msgProposalID = msg.Voter, msg.ProposalId
+		case *govtypes.MsgVoteWeighted:
+			msgVoter, msgProposalID = msg.Voter, msg.ProposalId
+		case *govv1.MsgVote:
+			msgVoter, msgProposalID = msg.Voter, msg.ProposalId
+		case *govv1.MsgVoteWeighted:
+			msgVoter, msgProposalID = msg.Voter, msg.ProposalId
+		default:
+			return "", 0, false
+		}
+		if i > 0 && (msgVoter != voter || msgProposalID != proposalID) {
+			return "", 0, false
+		}
+		voter, proposalID = msgVoter, msgProposalID
+	}
+	return voter, proposalID, len(msgs) > 0
+}
@@ -0,0 +1,68 @@
+package ante_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/ante"
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+func (s *GovAnteHandlerTestSuite) TestVoteFeeSponsorshipAnteHandler() {
+	// setup test
+	s.SetupTest()
+	app, ctx := s.app, s.ctx
+	decorator := ante.NewVoteFeeSponsorshipDecorator(&app.GovKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)) }
+
+	staker := app.StakingKeeper.GetAllDelegations(ctx)[0].GetDelegatorAddr()
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	s.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VoteFeeSponsorshipBudget = coins(1000)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	s.Require().NoError(govgenhelpers.FundModuleAccount(app.BankKeeper, ctx, govtypes.VoteFeePoolName, coins(5000)))
+
+	vote := govtypes.NewMsgVote(staker, proposal.ProposalId, govtypes.OptionYes, "")
+	voteV1 := govv1.NewMsgVote(staker, proposal.ProposalId, govv1.VoteOption_VOTE_OPTION_NO, "")
+	otherVote := govtypes.NewMsgVote(staker, proposal.ProposalId+1, govtypes.OptionYes, "")
+	deposit := govtypes.NewMsgDeposit(staker, proposal.ProposalId, coins(1))
+
+	tests := []struct {
+		name      string
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		sponsored bool
+	}{
+		{"vote", []sdk.Msg{vote}, coins(300), true},
+		{"v1 vote and vote", []sdk.Msg{voteV1, vote}, coins(300), true},
+		{"vote with a deposit", []sdk.Msg{vote, deposit}, coins(300), false},
+		{"votes on several proposals", []sdk.Msg{vote, otherVote}, coins(300), false},
+		{"vote over the budget", []sdk.Msg{vote}, coins(500), false},
+		{"vote within the remaining budget", []sdk.Msg{vote}, coins(400), true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeeAmount(tc.fee)
+
+			balance := app.BankKeeper.GetAllBalances(ctx, staker)
+			_, err := decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			s.Require().NoError(err)
+
+			expBalance := balance
+			if tc.sponsored {
+				expBalance = balance.Add(tc.fee...)
+			}
+			s.Require().Equal(expBalance, app.BankKeeper.GetAllBalances(ctx, staker))
+		})
+	}
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/atomone-hub/govgen/app/keepers"
 	govgenappparams "github.com/atomone-hub/govgen/app/params"
 	"github.com/atomone-hub/govgen/app/upgrades"
+	v2 "github.com/atomone-hub/govgen/app/upgrades/v2"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
 )
 
@@ -55,7 +56,8 @@ var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
 
-	Upgrades = []upgrades.Upgrade{}
+	Upgrades = []upgrades.Upgrade{v2.Upgrade}
+	Forks    = []upgrades.Fork{}
 )
 
 var (
@@ -187,6 +189,15 @@ func NewGovGenApp(
 	app.MountTransientStores(app.GetTransientStoreKey())
 	app.MountMemoryStores(app.GetMemoryStoreKey())
 
+	bypassMinFeeMsgTypes := cast.ToStringSlice(appOpts.Get(govgenappparams.BypassMinFeeMsgTypesKey))
+	if bypassMinFeeMsgTypes == nil {
+		bypassMinFeeMsgTypes = govgenappparams.DefaultBypassMinFeeMsgTypes()
+	}
+	maxTotalBypassMinFeeMsgGasUsage := govgenappparams.DefaultMaxTotalBypassMinFeeMsgGasUsage
+	if v := appOpts.Get(govgenappparams.MaxTotalBypassMinFeeMsgGasUsageKey); v != nil {
+		maxTotalBypassMinFeeMsgGasUsage = cast.ToUint64(v)
+	}
+
 	anteHandler, err := govgenante.NewAnteHandler(
 		govgenante.HandlerOptions{
 			HandlerOptions: ante.HandlerOptions{
@@ -198,7 +209,11 @@ func NewGovGenApp(
 			},
 			Codec:           appCodec,
 			GovKeeper:       &app.GovKeeper,
+			GlobalFeeKeeper: &app.GlobalFeeKeeper,
 			StakingSubspace: app.GetSubspace(stakingtypes.ModuleName),
+
+			BypassMinFeeMsgTypes:            bypassMinFeeMsgTypes,
+			MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
 		},
 	)
 	if err != nil {
@@ -227,6 +242,7 @@ func (app *GovGenApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *GovGenApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	BeginBlockForks(ctx, app)
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -0,0 +1,17 @@
+package govgen
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlockForks runs the BeginForkLogic of every fork registered at the
+// current block height, in the order of Forks, before the modules
+// BeginBlockers.
+func BeginBlockForks(ctx sdk.Context, app *GovGenApp) {
+	for _, fork := range Forks {
+		if ctx.BlockHeight() == fork.UpgradeHeight {
+			ctx.Logger().Info("running fork", "name", fork.UpgradeName, "height", fork.UpgradeHeight)
+			fork.BeginForkLogic(ctx, &app.AppKeepers)
+		}
+	}
+}
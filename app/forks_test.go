@@ -0,0 +1,67 @@
+package govgen_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	"github.com/atomone-hub/govgen/app/keepers"
+	"github.com/atomone-hub/govgen/app/upgrades"
+)
+
+func TestBeginBlockForks(t *testing.T) {
+	const forkHeight = 5
+	runs := 0
+	govgenhelpers.SetForks(t, upgrades.Fork{
+		UpgradeName:   "test-fork",
+		UpgradeHeight: forkHeight,
+		BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) {
+			runs++
+			params := keepers.StakingKeeper.GetParams(ctx)
+			params.MaxValidators = 42
+			keepers.StakingKeeper.SetParams(ctx, params)
+		},
+	})
+
+	app := govgenhelpers.Setup(t)
+
+	ctx := govgenhelpers.AdvanceToHeight(t, app, forkHeight-1)
+	require.Equal(t, 0, runs)
+	require.Equal(t, uint32(100), app.StakingKeeper.MaxValidators(ctx))
+
+	ctx = govgenhelpers.AdvanceToHeight(t, app, forkHeight)
+	require.Equal(t, 1, runs)
+	require.Equal(t, uint32(42), app.StakingKeeper.MaxValidators(ctx))
+
+	ctx = govgenhelpers.AdvanceToHeight(t, app, forkHeight+2)
+	require.Equal(t, 1, runs)
+	require.Equal(t, uint32(42), app.StakingKeeper.MaxValidators(ctx))
+}
+
+func TestBeginBlockForksSameHeight(t *testing.T) {
+	const forkHeight = 3
+	var runs []string
+	newFork := func(name string, maxValidators uint32) upgrades.Fork {
+		return upgrades.Fork{
+			UpgradeName:   name,
+			UpgradeHeight: forkHeight,
+			BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) {
+				runs = append(runs, name)
+				params := keepers.StakingKeeper.GetParams(ctx)
+				params.MaxValidators = maxValidators
+				keepers.StakingKeeper.SetParams(ctx, params)
+			},
+		}
+	}
+	govgenhelpers.SetForks(t, newFork("test-fork-1", 42), newFork("test-fork-2", 43))
+
+	app := govgenhelpers.Setup(t)
+
+	// both forks run, in the order they are registered
+	ctx := govgenhelpers.AdvanceToHeight(t, app, forkHeight)
+	require.Equal(t, []string{"test-fork-1", "test-fork-2"}, runs)
+	require.Equal(t, uint32(43), app.StakingKeeper.MaxValidators(ctx))
+}
@@ -2,6 +2,8 @@ package govgen
 
 import (
 	"fmt"
+	"net/http"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/version"
 	paramsrest "github.com/cosmos/cosmos-sdk/x/params/client/rest"
 	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
@@ -19,6 +22,7 @@ import (
 
 	govclient "github.com/atomone-hub/govgen/x/gov/client"
 	"github.com/atomone-hub/govgen/x/gov/client/cli"
+	govrest "github.com/atomone-hub/govgen/x/gov/client/rest"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
 )
 
@@ -35,6 +39,14 @@ var (
 		newCmdSubmitCancelUpgradeProposal,
 		govclient.WrapPropposalRESTHandler(upgraderest.ProposalRESTHandler),
 	)
+	fundVoteFeePoolProposalHandler = govclient.NewProposalHandler(
+		newCmdSubmitFundVoteFeePoolProposal,
+		fundVoteFeePoolProposalRESTHandler,
+	)
+	updateParamsProposalHandler = govclient.NewProposalHandler(
+		newCmdSubmitUpdateParamsProposal,
+		updateParamsProposalRESTHandler,
+	)
 )
 
 // newSubmitParamChangeProposalTxCmd returns a CLI command handler for creating
@@ -53,16 +65,15 @@ func newSubmitParamChangeProposalTxCmd() *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
+The changes are executed in a discarded branch of the state when the proposal is
+submitted, so the submission fails if any "value" change is invalid for its
+respective parameter (ie. wrong type or out of bounds), eg. "MaxValidators" must
+be an integer and not a decimal. The changes can be checked beforehand with:
 
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+$ %[1]s query gov dry-run-param-change <path/to/proposal.json>
 
 Example:
-$ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
+$ %[1]s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
 Where proposal.json contains:
 
@@ -124,7 +135,8 @@ func newCmdSubmitUpgradeProposal() *cobra.Command {
 		Short: "Submit a software upgrade proposal",
 		Long: "Submit a software upgrade along with an initial deposit.\n" +
 			"Please specify a unique name and height for the upgrade to take effect.\n" +
-			"You may include info to reference a binary download link, in a format compatible with: https://github.com/cosmos/cosmos-sdk/tree/master/cosmovisor",
+			"The height must come after the latest expected end of the voting period, deposit period included.\n" +
+			"The info must reference the binary download links with their checksums, in a format compatible with: https://github.com/cosmos/cosmos-sdk/tree/master/cosmovisor",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -135,6 +147,10 @@ func newCmdSubmitUpgradeProposal() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if !hasUpgradeHandler(name) {
+				cmd.PrintErrf("WARNING: this binary has no upgrade handler registered for %q, "+
+					"make sure the upgrade binary provides one before the upgrade height.\n", name)
+			}
 
 			from := clientCtx.GetFromAddress()
 
@@ -160,7 +176,7 @@ func newCmdSubmitUpgradeProposal() *cobra.Command {
 	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
 	cmd.Flags().Int64(upgradecli.FlagUpgradeHeight, 0, "The height at which the upgrade must happen")
-	cmd.Flags().String(upgradecli.FlagUpgradeInfo, "", "Optional info for the planned upgrade such as commit hash, etc.")
+	cmd.Flags().String(upgradecli.FlagUpgradeInfo, "", "The cosmovisor-style binaries JSON of the planned upgrade, including checksums")
 
 	return cmd
 }
@@ -223,6 +239,17 @@ func newCmdSubmitCancelUpgradeProposal() *cobra.Command {
 	return cmd
 }
 
+// hasUpgradeHandler returns true if the running binary registers an upgrade
+// handler for the given plan name.
+func hasUpgradeHandler(name string) bool {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return true
+		}
+	}
+	return false
+}
+
 func parseArgsToContent(cmd *cobra.Command, name string) (govtypes.Content, error) {
 	title, err := cmd.Flags().GetString(cli.FlagTitle)
 	if err != nil {
@@ -248,3 +275,234 @@ func parseArgsToContent(cmd *cobra.Command, name string) (govtypes.Content, erro
 	content := upgradetypes.NewSoftwareUpgradeProposal(title, description, plan)
 	return content, nil
 }
+
+// newCmdSubmitFundVoteFeePoolProposal implements a command handler for
+// submitting a proposal to fund the vote fee pool from the community pool.
+func newCmdSubmitFundVoteFeePoolProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-vote-fee-pool [amount] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to fund the vote fee pool from the community pool",
+		Long: "Submit a proposal to transfer coins from the community pool to the vote fee pool, " +
+			"which pays the fees of the votes of the accounts with bonded stake, along with an initial deposit.",
+		Example: fmt.Sprintf(
+			"$ %s tx gov submit-proposal fund-vote-fee-pool 1000000ugovgen --title=<title> --description=<description> --deposit=<deposit> --from=<key_or_address>",
+			version.AppName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(cli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(cli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(cli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := govtypes.NewFundVoteFeePoolProposal(title, description, amount)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(cli.FlagTitle)       //nolint:errcheck
+	cmd.MarkFlagRequired(cli.FlagDescription) //nolint:errcheck
+
+	return cmd
+}
+
+// fundVoteFeePoolProposalReq defines a vote fee pool funding proposal request
+// body.
+type fundVoteFeePoolProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// fundVoteFeePoolProposalRESTHandler returns a ProposalRESTHandler that
+// exposes the vote fee pool funding REST handler.
+func fundVoteFeePoolProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "fund_vote_fee_pool",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req fundVoteFeePoolProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := govtypes.NewFundVoteFeePoolProposal(req.Title, req.Description, req.Amount)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
+
+// updateParamsProposalJSON defines a params update proposal read from a JSON
+// file.
+type updateParamsProposalJSON struct {
+	Title         string                 `json:"title" yaml:"title"`
+	Description   string                 `json:"description" yaml:"description"`
+	DepositParams govtypes.DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams  govtypes.VotingParams  `json:"voting_params" yaml:"voting_params"`
+	TallyParams   govtypes.TallyParams   `json:"tally_params" yaml:"tally_params"`
+	Deposit       string                 `json:"deposit" yaml:"deposit"`
+}
+
+// newCmdSubmitUpdateParamsProposal implements a command handler for
+// submitting a proposal to update the gov params.
+func newCmdSubmitUpdateParamsProposal() *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-params [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the gov params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the deposit, voting and tally params of the gov
+module along with an initial deposit. Unlike a param-change proposal, all the
+params are replaced, so the JSON file must contain every param. The current
+params can be retrieved with:
+
+$ %[1]s query gov params
+
+Example:
+$ %[1]s tx gov submit-proposal update-params <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Gov Params Update",
+  "description": "Update the gov params",
+  "deposit_params": {...},
+  "voting_params": {...},
+  "tally_params": {...},
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proposal updateParamsProposalJSON
+			if err := clientCtx.LegacyAmino.UnmarshalJSON(contents, &proposal); err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := govtypes.NewUpdateParamsProposal(
+				proposal.Title, proposal.Description, proposal.DepositParams, proposal.VotingParams, proposal.TallyParams,
+			)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
+
+// updateParamsProposalReq defines a params update proposal request body.
+type updateParamsProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title         string                 `json:"title" yaml:"title"`
+	Description   string                 `json:"description" yaml:"description"`
+	DepositParams govtypes.DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams  govtypes.VotingParams  `json:"voting_params" yaml:"voting_params"`
+	TallyParams   govtypes.TallyParams   `json:"tally_params" yaml:"tally_params"`
+	Proposer      sdk.AccAddress         `json:"proposer" yaml:"proposer"`
+	Deposit       sdk.Coins              `json:"deposit" yaml:"deposit"`
+}
+
+// updateParamsProposalRESTHandler returns a ProposalRESTHandler that exposes
+// the params update REST handler.
+func updateParamsProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_params",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req updateParamsProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+
+			req.BaseReq = req.BaseReq.Sanitize()
+			if !req.BaseReq.ValidateBasic(w) {
+				return
+			}
+
+			content := govtypes.NewUpdateParamsProposal(
+				req.Title, req.Description, req.DepositParams, req.VotingParams, req.TallyParams,
+			)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+			if rest.CheckBadRequestError(w, err) {
+				return
+			}
+			if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+				return
+			}
+
+			tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+		},
+	}
+}
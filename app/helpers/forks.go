@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govgenapp "github.com/atomone-hub/govgen/app"
+	"github.com/atomone-hub/govgen/app/upgrades"
+)
+
+// SetForks replaces the forks registered in the app for the duration of the
+// test.
+func SetForks(t *testing.T, forks ...upgrades.Fork) {
+	t.Helper()
+
+	previous := govgenapp.Forks
+	govgenapp.Forks = forks
+	t.Cleanup(func() { govgenapp.Forks = previous })
+}
+
+// AdvanceToHeight ends and commits the block in progress (as left by Setup),
+// then commits empty blocks until the block at the given height has begun, so
+// that the fork registered at that height has run. It returns a context on
+// the deliver state of that block, which allows to assert the state changes
+// of the fork.
+func AdvanceToHeight(t *testing.T, app *govgenapp.GovGenApp, height int64) sdk.Context {
+	t.Helper()
+
+	require.Greater(t, height, app.LastBlockHeight()+1, "block %d has already begun", height)
+
+	for {
+		app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+		app.Commit()
+
+		header := tmproto.Header{
+			Height:  app.LastBlockHeight() + 1,
+			AppHash: app.LastCommitID().Hash,
+		}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		if header.Height == height {
+			return app.BaseApp.NewContext(false, header)
+		}
+	}
+}
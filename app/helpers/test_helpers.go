@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -55,6 +56,15 @@ var (
 			Height: 42,
 		},
 	)
+	// TestSoftwareUpgradeProposalWithInfo passes the pre-flight checks of the
+	// software upgrade proposals, so it can be submitted.
+	TestSoftwareUpgradeProposalWithInfo = upgradetypes.NewSoftwareUpgradeProposal(
+		"Test", "description", upgradetypes.Plan{
+			Name:   "plan",
+			Height: 1_000_000,
+			Info:   `{"binaries":{"linux/amd64":"https://example.com/govgend?checksum=sha256:` + strings.Repeat("0", 64) + `"}}`,
+		},
+	)
 	TestCancelSoftwareUpgradeProposal = upgradetypes.NewCancelSoftwareUpgradeProposal(
 		"Test", "description",
 	)
@@ -40,6 +40,8 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	globalfeekeeper "github.com/atomone-hub/govgen/x/globalfee/keeper"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
 	govkeeper "github.com/atomone-hub/govgen/x/gov/keeper"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
 )
@@ -65,6 +67,7 @@ type AppKeepers struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	AuthzKeeper      authzkeeper.Keeper
+	GlobalFeeKeeper  globalfeekeeper.Keeper
 }
 
 func NewAppKeeper(
@@ -206,8 +209,15 @@ func NewAppKeeper(
 
 	govRouter := govtypes.NewRouter()
 	govRouter.
-		AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, govtypes.WrapSDKHandler(params.NewParamChangeProposalHandler(appKeepers.ParamsKeeper))).
+		AddRoute(govtypes.RouterKey, govkeeper.NewFundVoteFeePoolProposalHandler(
+			&appKeepers.GovKeeper,
+			appKeepers.DistrKeeper,
+			govkeeper.NewUpdateParamsProposalHandler(&appKeepers.GovKeeper, govtypes.ProposalHandler),
+		)).
+		AddRoute(paramproposal.RouterKey, govkeeper.NewParamChangePolicyHandler(
+			&appKeepers.GovKeeper,
+			govtypes.WrapSDKHandler(params.NewParamChangeProposalHandler(appKeepers.ParamsKeeper)),
+		)).
 		AddRoute(upgradetypes.RouterKey, govtypes.WrapSDKHandler(upgrade.NewSoftwareUpgradeProposalHandler(appKeepers.UpgradeKeeper)))
 
 	/*
@@ -223,6 +233,12 @@ func NewAppKeeper(
 		appKeepers.BankKeeper,
 		&stakingKeeper,
 		govRouter,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.GlobalFeeKeeper = globalfeekeeper.NewKeeper(
+		appKeepers.keys[globalfeetypes.StoreKey],
+		appKeepers.GetSubspace(globalfeetypes.ModuleName),
 	)
 
 	return appKeepers
@@ -246,6 +262,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(govtypes.ParamKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
+	paramsKeeper.Subspace(globalfeetypes.ModuleName).WithKeyTable(globalfeetypes.ParamKeyTable())
 
 	return paramsKeeper
 }
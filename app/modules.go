@@ -35,6 +35,8 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	govgenappparams "github.com/atomone-hub/govgen/app/params"
+	"github.com/atomone-hub/govgen/x/globalfee"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
 	"github.com/atomone-hub/govgen/x/gov"
 	govtypes "github.com/atomone-hub/govgen/x/gov/types"
 )
@@ -45,7 +47,8 @@ var maccPerms = map[string][]string{
 	minttypes.ModuleName:           {authtypes.Minter},
 	stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
-	govtypes.ModuleName:            {authtypes.Burner},
+	govtypes.ModuleName:            {authtypes.Burner, authtypes.Staking},
+	govtypes.VoteFeePoolName:       nil,
 }
 
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
@@ -63,6 +66,8 @@ var ModuleBasics = module.NewBasicManager(
 		paramsChangeProposalHandler,
 		upgradeProposalHandler,
 		cancelUpgradeProposalHandler,
+		fundVoteFeePoolProposalHandler,
+		updateParamsProposalHandler,
 	),
 	params.AppModuleBasic{},
 	crisis.AppModuleBasic{},
@@ -72,6 +77,7 @@ var ModuleBasics = module.NewBasicManager(
 	upgrade.AppModuleBasic{},
 	evidence.AppModuleBasic{},
 	vesting.AppModuleBasic{},
+	globalfee.AppModuleBasic{},
 )
 
 func appModules(
@@ -103,6 +109,7 @@ func appModules(
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		params.NewAppModule(app.ParamsKeeper),
+		globalfee.NewAppModule(app.GlobalFeeKeeper),
 	}
 }
 
@@ -162,6 +169,7 @@ func orderBeginBlockers() []string {
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
+		globalfeetypes.ModuleName,
 	}
 }
 
@@ -183,6 +191,7 @@ func orderEndBlockers() []string {
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		globalfeetypes.ModuleName,
 	}
 }
 
@@ -190,6 +199,8 @@ func orderEndBlockers() []string {
 NOTE: The genutils module must occur after staking so that pools are
 properly initialized with tokens from genesis accounts.
 NOTE: The genutils module must also occur after auth so that it can access the params from auth.
+NOTE: The globalfee module must occur before genutil so that the minimum gas
+prices are set when the genesis transactions are delivered.
 NOTE: Capability module must occur first so that it can initialize any capabilities
 so that other modules that want to create or claim capabilities afterwards in InitChain
 can do so safely.
@@ -205,6 +216,7 @@ func orderInitBlockers() []string {
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
 		crisistypes.ModuleName,
+		globalfeetypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -0,0 +1,63 @@
+package params
+
+import (
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+	govv1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+// app.toml keys of the GovGen configuration
+const (
+	BypassMinFeeMsgTypesKey            = "bypass-min-fee.msg-types"
+	MaxTotalBypassMinFeeMsgGasUsageKey = "bypass-min-fee.max-total-gas-usage"
+)
+
+// DefaultMaxTotalBypassMinFeeMsgGasUsage is the default maximum gas limit of
+// the txs exempted from the minimum gas prices, which is enough for a vote.
+const DefaultMaxTotalBypassMinFeeMsgGasUsage uint64 = 200_000
+
+// DefaultBypassMinFeeMsgTypes returns the default message types of the txs
+// exempted from the minimum gas prices, which are the votes.
+func DefaultBypassMinFeeMsgTypes() []string {
+	return []string{
+		sdk.MsgTypeURL(&govtypes.MsgVote{}),
+		sdk.MsgTypeURL(&govtypes.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+	}
+}
+
+// BypassMinFeeConfig defines the txs exempted from the minimum gas prices of
+// the validator. The globalfee params override it when they set their own
+// bypass message types.
+type BypassMinFeeConfig struct {
+	MsgTypes         []string `mapstructure:"msg-types"`
+	MaxTotalGasUsage uint64   `mapstructure:"max-total-gas-usage"`
+}
+
+// CustomAppConfig extends the SDK app.toml configuration with the GovGen one.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	BypassMinFee BypassMinFeeConfig `mapstructure:"bypass-min-fee"`
+}
+
+// CustomConfigTemplate is the app.toml template of the GovGen configuration,
+// appended to the SDK one.
+const CustomConfigTemplate = `
+###############################################################################
+###                        GovGen Configuration                             ###
+###############################################################################
+
+[bypass-min-fee]
+
+# Message type URLs of the txs exempted from the minimum-gas-prices when their
+# gas limit is at most max-total-gas-usage. The bypass_min_fee_msg_types
+# globalfee param overrides this list when it is not empty.
+msg-types = [{{ range .BypassMinFee.MsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+# Maximum gas limit of the txs exempted from the minimum-gas-prices.
+max-total-gas-usage = {{ .BypassMinFee.MaxTotalGasUsage }}
+`
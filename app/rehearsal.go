@@ -0,0 +1,265 @@
+package govgen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	govgenappparams "github.com/atomone-hub/govgen/app/params"
+	"github.com/atomone-hub/govgen/app/upgrades"
+)
+
+// rehearsalBlockTime is the block time used to advance the chain during an
+// upgrade rehearsal.
+const rehearsalBlockTime = 5 * time.Second
+
+// UpgradeRehearsal is the report of an upgrade rehearsal.
+type UpgradeRehearsal struct {
+	Name             string
+	Height           int64
+	StoreUpgrades    storetypes.StoreUpgrades
+	Migrations       []ModuleMigration
+	Invariants       int
+	BrokenInvariants []string
+}
+
+// ModuleMigration reports the consensus version change of a module during an
+// upgrade. FromVersion is 0 for a module added by the upgrade, and ToVersion
+// is 0 for a module removed by the upgrade.
+type ModuleMigration struct {
+	Module      string
+	FromVersion uint64
+	ToVersion   uint64
+}
+
+// String implements the Stringer interface.
+func (r UpgradeRehearsal) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "upgrade %q applied at height %d\n", r.Name, r.Height)
+	fmt.Fprintf(&b, "store upgrades: added %v, renamed %v, deleted %v\n",
+		r.StoreUpgrades.Added, r.StoreUpgrades.Renamed, r.StoreUpgrades.Deleted)
+	if len(r.Migrations) == 0 {
+		b.WriteString("module migrations: none\n")
+	} else {
+		b.WriteString("module migrations:\n")
+		for _, m := range r.Migrations {
+			fmt.Fprintf(&b, "  %s: %d -> %d\n", m.Module, m.FromVersion, m.ToVersion)
+		}
+	}
+	fmt.Fprintf(&b, "invariants: %d checked, %d broken", r.Invariants, len(r.BrokenInvariants))
+	for _, inv := range r.BrokenInvariants {
+		fmt.Fprintf(&b, "\n  %s", inv)
+	}
+	return b.String()
+}
+
+// RehearseUpgrade runs the upgrade registered in Upgrades under the given name
+// against the state of an exported genesis, in-process and in-memory.
+//
+// The chain is initialized from the genesis as before the upgrade: the stores
+// added by the upgrade are neither mounted nor initialized, the module version
+// map is seeded with the PreUpgradeVersions of the upgrade, and the state
+// layout is restored with its SetPreUpgradeState. The upgrade plan is then
+// scheduled for the next block. Then, like a node restarting with the new
+// binary at the upgrade height, a new GovGenApp is loaded from the same
+// database with the upgrade store loader, and the upgrade handler is run in the
+// BeginBlocker. Finally all the crisis invariants are checked against the
+// upgraded state.
+//
+// The upgrade info is written under homePath, which should be a temporary
+// directory.
+func RehearseUpgrade(
+	logger log.Logger, homePath string, appOpts servertypes.AppOptions,
+	genDoc *tmtypes.GenesisDoc, name string,
+) (rehearsal UpgradeRehearsal, err error) {
+	var upgrade *upgrades.Upgrade
+	for i := range Upgrades {
+		if Upgrades[i].UpgradeName == name {
+			upgrade = &Upgrades[i]
+			break
+		}
+	}
+	if upgrade == nil {
+		return rehearsal, fmt.Errorf("no upgrade handler registered for %q", name)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("upgrade rehearsal panicked: %v", r)
+		}
+	}()
+
+	db := dbm.NewMemDB()
+	encodingConfig := MakeTestEncodingConfig()
+
+	// initialize the chain from the genesis as before the upgrade
+	app := newPreUpgradeApp(logger, db, homePath, encodingConfig, appOpts, upgrade)
+	app.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: tmtypes.TM2PB.ConsensusParams(genDoc.ConsensusParams),
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+
+	height := genDoc.InitialHeight
+	if height < 1 {
+		height = 1
+	}
+	header := tmproto.Header{
+		ChainID: genDoc.ChainID,
+		Height:  height,
+		Time:    genDoc.GenesisTime,
+		AppHash: app.LastCommitID().Hash,
+	}
+	plan := upgradetypes.Plan{Name: name, Height: height + 1}
+
+	// schedule the upgrade for the next block
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.NewContext(false, header)
+	if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+		return rehearsal, err
+	}
+	fromVersions := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// restart with the upgrade store loader, as if the node was halted at the
+	// upgrade height and restarted with the new binary
+	if err := app.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan.Name); err != nil {
+		return rehearsal, err
+	}
+	app = NewGovGenApp(logger, db, nil, true, map[int64]bool{}, homePath, 0, encodingConfig, appOpts)
+
+	header = tmproto.Header{
+		ChainID: genDoc.ChainID,
+		Height:  plan.Height,
+		Time:    header.Time.Add(rehearsalBlockTime),
+		AppHash: app.LastCommitID().Hash,
+	}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	ctx = app.NewContext(true, header)
+	if doneHeight := app.UpgradeKeeper.GetDoneHeight(ctx, name); doneHeight != plan.Height {
+		return rehearsal, fmt.Errorf("upgrade %q was not applied at height %d", name, plan.Height)
+	}
+
+	rehearsal = UpgradeRehearsal{
+		Name:          name,
+		Height:        plan.Height,
+		StoreUpgrades: upgrade.StoreUpgrades,
+		Migrations:    moduleMigrations(fromVersions, app.UpgradeKeeper.GetModuleVersionMap(ctx)),
+	}
+	for _, route := range app.CrisisKeeper.Routes() {
+		rehearsal.Invariants++
+		if msg, broken := route.Invar(ctx); broken {
+			rehearsal.BrokenInvariants = append(rehearsal.BrokenInvariants,
+				fmt.Sprintf("%s: %s", route.FullRoute(), strings.TrimSpace(msg)))
+		}
+	}
+
+	return rehearsal, nil
+}
+
+// newPreUpgradeApp returns a GovGenApp loaded from db as the binary running
+// before the upgrade: the stores added by the upgrade are not mounted and the
+// modules owning them are removed from the module manager. Its InitChainer
+// seeds the module version map with the versions before the upgrade and
+// restores the state layout before the upgrade.
+func newPreUpgradeApp(
+	logger log.Logger, db dbm.DB, homePath string, encodingConfig govgenappparams.EncodingConfig,
+	appOpts servertypes.AppOptions, upgrade *upgrades.Upgrade,
+) *GovGenApp {
+	added := make(map[string]bool)
+	for _, storeName := range upgrade.StoreUpgrades.Added {
+		added[storeName] = true
+	}
+	skipAddedStores := func(bApp *baseapp.BaseApp) {
+		bApp.SetCMS(preUpgradeStore{CommitMultiStore: bApp.CommitMultiStore(), added: added})
+	}
+
+	app := NewGovGenApp(logger, db, nil, false, map[int64]bool{}, homePath, 0, encodingConfig, appOpts, skipAddedStores)
+
+	// the modules added by the upgrade are named after their store
+	for storeName := range added {
+		delete(app.mm.Modules, storeName)
+	}
+	without := func(modules []string) []string {
+		var filtered []string
+		for _, m := range modules {
+			if !added[m] {
+				filtered = append(filtered, m)
+			}
+		}
+		return filtered
+	}
+	app.mm.OrderInitGenesis = without(app.mm.OrderInitGenesis)
+	app.mm.OrderBeginBlockers = without(app.mm.OrderBeginBlockers)
+	app.mm.OrderEndBlockers = without(app.mm.OrderEndBlockers)
+	app.mm.OrderExportGenesis = without(app.mm.OrderExportGenesis)
+
+	app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		res := app.InitChainer(ctx, req)
+		app.UpgradeKeeper.SetModuleVersionMap(ctx, upgrade.PreUpgradeVersions)
+		if upgrade.SetPreUpgradeState != nil {
+			upgrade.SetPreUpgradeState(ctx, &app.AppKeepers)
+		}
+		return res
+	})
+	if err := app.LoadLatestVersion(); err != nil {
+		panic(fmt.Errorf("failed to load latest version: %w", err))
+	}
+	return app
+}
+
+// preUpgradeStore is a CommitMultiStore which doesn't mount the stores added
+// by an upgrade, so that the upgrade store loader can add them at the upgrade
+// height.
+type preUpgradeStore struct {
+	storetypes.CommitMultiStore
+	added map[string]bool
+}
+
+// MountStoreWithDB implements the CommitMultiStore interface.
+func (s preUpgradeStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	if s.added[key.Name()] {
+		return
+	}
+	s.CommitMultiStore.MountStoreWithDB(key, typ, db)
+}
+
+// moduleMigrations returns the modules whose consensus version differs
+// between the two version maps, sorted by module name.
+func moduleMigrations(from, to map[string]uint64) []ModuleMigration {
+	modules := make(map[string]bool)
+	for m := range from {
+		modules[m] = true
+	}
+	for m := range to {
+		modules[m] = true
+	}
+
+	var migrations []ModuleMigration
+	for m := range modules {
+		if from[m] != to[m] {
+			migrations = append(migrations, ModuleMigration{Module: m, FromVersion: from[m], ToVersion: to[m]})
+		}
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Module < migrations[j].Module })
+	return migrations
+}
@@ -0,0 +1,63 @@
+package govgen_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+
+	govgen "github.com/atomone-hub/govgen/app"
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	v2 "github.com/atomone-hub/govgen/app/upgrades/v2"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func exportGenesisDoc(t *testing.T) *tmtypes.GenesisDoc {
+	t.Helper()
+
+	app := govgenhelpers.Setup(t)
+	exported, err := app.ExportAppStateAndValidators(false, []string{})
+	require.NoError(t, err)
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:         "govgen-rehearsal",
+		GenesisTime:     time.Now().UTC(),
+		InitialHeight:   exported.Height,
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        exported.AppState,
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	return genDoc
+}
+
+func TestRehearseUpgrade(t *testing.T) {
+	genDoc := exportGenesisDoc(t)
+
+	rehearsal, err := govgen.RehearseUpgrade(log.NewNopLogger(), t.TempDir(),
+		govgenhelpers.EmptyAppOptions{}, genDoc, v2.UpgradeName)
+
+	require.NoError(t, err)
+	require.Equal(t, v2.UpgradeName, rehearsal.Name)
+	require.Equal(t, genDoc.InitialHeight+1, rehearsal.Height)
+	require.Equal(t, storetypes.StoreUpgrades{Added: []string{globalfeetypes.StoreKey}}, rehearsal.StoreUpgrades)
+	require.Equal(t, []govgen.ModuleMigration{
+		{Module: globalfeetypes.ModuleName, FromVersion: 0, ToVersion: 1},
+		{Module: govtypes.ModuleName, FromVersion: 2, ToVersion: 3},
+	}, rehearsal.Migrations)
+	require.NotZero(t, rehearsal.Invariants)
+	require.Empty(t, rehearsal.BrokenInvariants)
+}
+
+func TestRehearseUpgradeUnknown(t *testing.T) {
+	genDoc := exportGenesisDoc(t)
+
+	_, err := govgen.RehearseUpgrade(log.NewNopLogger(), t.TempDir(),
+		govgenhelpers.EmptyAppOptions{}, genDoc, "v3")
+
+	require.EqualError(t, err, `no upgrade handler registered for "v3"`)
+}
@@ -22,6 +22,16 @@ type Upgrade struct {
 
 	// Store upgrades, should be used for any new modules introduced, new modules deleted, or store names renamed.
 	StoreUpgrades store.StoreUpgrades
+
+	// PreUpgradeVersions are the consensus versions, before the upgrade, of the
+	// modules migrated by the upgrade. The upgrade rehearsal initializes the
+	// chain with these versions, the other modules keep their current version.
+	PreUpgradeVersions module.VersionMap
+
+	// SetPreUpgradeState, if set, restores the state layout of the migrated
+	// modules before the upgrade, from the state initialized by the current
+	// binary. It is only used by the upgrade rehearsal.
+	SetPreUpgradeState func(ctx sdk.Context, keepers *keepers.AppKeepers)
 }
 
 // Fork defines a struct containing the requisite fields for a non-software upgrade proposal
@@ -0,0 +1,31 @@
+package v2
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/atomone-hub/govgen/app/upgrades"
+	globalfeetypes "github.com/atomone-hub/govgen/x/globalfee/types"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+const (
+	// UpgradeName defines the on-chain upgrade name.
+	UpgradeName = "v2"
+)
+
+// MinimumGasPrice is the network-wide minimum gas price, in the bond denom,
+// set by the upgrade. It is the minimum-gas-prices typically configured by the
+// validators, and the default of the testnet command.
+var MinimumGasPrice = sdk.NewDecWithPrec(6, 6) // 0.000006
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{globalfeetypes.StoreKey},
+	},
+	PreUpgradeVersions: module.VersionMap{govtypes.ModuleName: 2},
+	SetPreUpgradeState: setLegacyGovParams,
+}
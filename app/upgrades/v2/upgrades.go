@@ -0,0 +1,97 @@
+package v2
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/atomone-hub/govgen/app/keepers"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// CreateUpgradeHandler returns the v2 upgrade handler. It runs the module
+// migrations, which initialize the new globalfee module, then sets the
+// network-wide minimum gas prices from the current validator setting. It also
+// allows the gov module account to hold deposits drawn from locked vesting
+// coins.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Starting module migrations...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		params := keepers.GlobalFeeKeeper.GetParams(ctx)
+		params.MinimumGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec(keepers.StakingKeeper.BondDenom(ctx), MinimumGasPrice))
+		keepers.GlobalFeeKeeper.SetParams(ctx, params)
+		ctx.Logger().Info("Set network-wide minimum gas prices", "minimum_gas_prices", params.MinimumGasPrices.String())
+
+		if err := migrateGovDeposits(ctx, keepers); err != nil {
+			return vm, err
+		}
+
+		ctx.Logger().Info("Upgrade complete")
+		return vm, nil
+	}
+}
+
+// migrateGovDeposits grants the staking permission to the gov module account,
+// which is required to track the deposits like delegations, and tracks the
+// existing deposits of the vesting accounts as delegated free coins, so that
+// they can be refunded with the delegation tracking. These deposits were paid
+// with spendable coins, tracking them as delegated vesting coins would unlock
+// the same amount of vesting coins.
+func migrateGovDeposits(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	govAcc, ok := keepers.AccountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected %s module account type", govtypes.ModuleName)
+	}
+	if !govAcc.HasPermission(authtypes.Staking) {
+		govAcc.Permissions = append(govAcc.Permissions, authtypes.Staking)
+		keepers.AccountKeeper.SetModuleAccount(ctx, govAcc)
+	}
+
+	keepers.GovKeeper.IterateAllDeposits(ctx, func(deposit govtypes.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+		acc := keepers.AccountKeeper.GetAccount(ctx, depositor)
+
+		var bva *vestingtypes.BaseVestingAccount
+		switch vacc := acc.(type) {
+		case *vestingtypes.ContinuousVestingAccount:
+			bva = vacc.BaseVestingAccount
+		case *vestingtypes.DelayedVestingAccount:
+			bva = vacc.BaseVestingAccount
+		case *vestingtypes.PeriodicVestingAccount:
+			bva = vacc.BaseVestingAccount
+		case *vestingtypes.PermanentLockedAccount:
+			bva = vacc.BaseVestingAccount
+		default:
+			return false
+		}
+
+		// no vesting coins, so that the whole deposit is delegated free coins
+		balance := keepers.BankKeeper.GetAllBalances(ctx, depositor).Add(deposit.Amount...)
+		bva.TrackDelegation(balance, sdk.NewCoins(), deposit.Amount)
+		keepers.AccountKeeper.SetAccount(ctx, acc)
+		return false
+	})
+	return nil
+}
+
+// setLegacyGovParams stores the gov params in the x/params gov subspace, where
+// the gov module stores them before its v3 migration.
+func setLegacyGovParams(ctx sdk.Context, keepers *keepers.AppKeepers) {
+	subspace := keepers.GetSubspace(govtypes.ModuleName)
+	subspace.Set(ctx, govtypes.ParamStoreKeyDepositParams, keepers.GovKeeper.GetDepositParams(ctx))
+	subspace.Set(ctx, govtypes.ParamStoreKeyVotingParams, keepers.GovKeeper.GetVotingParams(ctx))
+	subspace.Set(ctx, govtypes.ParamStoreKeyTallyParams, keepers.GovKeeper.GetTallyParams(ctx))
+}
@@ -0,0 +1,76 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	v2 "github.com/atomone-hub/govgen/app/upgrades/v2"
+	govtypes "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func TestUpgrade(t *testing.T) {
+	const upgradeHeight = 3
+	app := govgenhelpers.Setup(t)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	require.Empty(t, app.GlobalFeeKeeper.GetMinimumGasPrices(ctx))
+
+	// gov module account and deposit of a vesting account as before the upgrade
+	govAcc := app.AccountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).(*authtypes.ModuleAccount)
+	govAcc.Permissions = []string{authtypes.Burner}
+	app.AccountKeeper.SetModuleAccount(ctx, govAcc)
+
+	lockedCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	addr := sdk.AccAddress("vesting_depositor___")
+	vacc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), lockedCoins,
+		time.Now().Unix(), time.Now().Add(365*24*time.Hour).Unix(),
+	)
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	require.NoError(t, govgenhelpers.FundAccount(app.BankKeeper, ctx, addr, lockedCoins.Add(deposit...)))
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	require.NoError(t, err)
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, govtypes.ModuleName, deposit))
+	app.GovKeeper.SetDeposit(ctx, govtypes.NewDeposit(proposal.ProposalId, addr, deposit))
+	proposal.TotalDeposit = deposit
+	app.GovKeeper.SetProposal(ctx, proposal)
+	spendable := app.BankKeeper.SpendableCoins(ctx, addr)
+
+	err = app.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{Name: v2.UpgradeName, Height: upgradeHeight})
+	require.NoError(t, err)
+
+	ctx = govgenhelpers.AdvanceToHeight(t, app, upgradeHeight)
+	require.Equal(t, int64(upgradeHeight), app.UpgradeKeeper.GetDoneHeight(ctx, v2.UpgradeName))
+	require.Equal(t,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, v2.MinimumGasPrice)),
+		app.GlobalFeeKeeper.GetMinimumGasPrices(ctx),
+	)
+
+	// the deposit, paid with spendable coins, is tracked as delegated free
+	// coins, so that no vesting coin is unlocked, and can be refunded
+	require.True(t, app.AccountKeeper.GetModuleAccount(ctx, govtypes.ModuleName).HasPermission(authtypes.Staking))
+	vestingAccount := func() vestingexported.VestingAccount {
+		return app.AccountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount)
+	}
+	require.True(t, vestingAccount().GetDelegatedVesting().IsZero())
+	require.Equal(t, deposit, vestingAccount().GetDelegatedFree())
+	require.Equal(t, spendable, app.BankKeeper.SpendableCoins(ctx, addr))
+
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposal.ProposalId, addr)
+	require.NoError(t, err)
+	require.True(t, vestingAccount().GetDelegatedVesting().IsZero())
+	require.True(t, vestingAccount().GetDelegatedFree().IsZero())
+	require.Equal(t, lockedCoins.Add(deposit...), app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, spendable.Add(deposit...), app.BankKeeper.SpendableCoins(ctx, addr))
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	govgen "github.com/atomone-hub/govgen/app"
+)
+
+// RehearseUpgradeCommand returns rehearse-upgrade cobra Command.
+func RehearseUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rehearse-upgrade [genesis.json] [upgrade-name]",
+		Short: "Rehearse a registered upgrade against an exported genesis",
+		Long: `Rehearse a registered upgrade against an exported genesis
+
+The chain is initialized in-memory from the genesis file as before the upgrade,
+without the stores added by the upgrade and with the module versions before the
+upgrade. The upgrade plan is scheduled for the next block, and the node is
+restarted with the upgrade store loader to run the upgrade handler. Then all the crisis invariants are checked
+and the module version migrations are reported. Everything runs in-process,
+without network.
+
+Example:
+	govgend export > exported.json
+	govgend debug rehearse-upgrade exported.json v2
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			homeDir, err := os.MkdirTemp("", "govgen-rehearsal")
+			if err != nil {
+				return err
+			}
+			defer os.RemoveAll(homeDir)
+
+			logger := log.NewFilter(log.NewTMLogger(log.NewSyncWriter(cmd.ErrOrStderr())), log.AllowError())
+			rehearsal, err := govgen.RehearseUpgrade(logger, homeDir, server.GetServerContextFromCmd(cmd).Viper, genDoc, args[1])
+			if err != nil {
+				return fmt.Errorf("rehearsal of upgrade %q failed: %w", args[1], err)
+			}
+
+			cmd.Println(rehearsal.String())
+			if len(rehearsal.BrokenInvariants) > 0 {
+				return fmt.Errorf("%d invariant(s) broken by upgrade %q", len(rehearsal.BrokenInvariants), args[1])
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -82,7 +82,15 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotInterval = 1000
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
-	return serverconfig.DefaultConfigTemplate, *srvCfg
+	customAppConfig := params.CustomAppConfig{
+		Config: *srvCfg,
+		BypassMinFee: params.BypassMinFeeConfig{
+			MsgTypes:         params.DefaultBypassMinFeeMsgTypes(),
+			MaxTotalGasUsage: params.DefaultMaxTotalBypassMinFeeMsgGasUsage,
+		},
+	}
+
+	return serverconfig.DefaultConfigTemplate + params.CustomConfigTemplate, customAppConfig
 }
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
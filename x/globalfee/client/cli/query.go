@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/atomone-hub/govgen/x/globalfee/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the globalfee module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryMinimumGasPrices(),
+		GetCmdQueryBaseFee(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryMinimumGasPrices implements the query minimum-gas-prices command.
+func GetCmdQueryMinimumGasPrices() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minimum-gas-prices",
+		Args:  cobra.NoArgs,
+		Short: "Query the network-wide minimum gas prices",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum gas prices enforced by every validator, in both CheckTx
+and DeliverTx. The local minimum-gas-prices of a validator can only raise them.
+
+Example:
+$ %s query globalfee minimum-gas-prices
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinimumGasPrices(cmd.Context(), &types.QueryMinimumGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBaseFee implements the query base-fee command.
+func GetCmdQueryBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Args:  cobra.NoArgs,
+		Short: "Query the dynamic base fee of the next block",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dynamic base fee which, when enabled, replaces the network-wide
+minimum gas prices. It adjusts at the end of each block to the gas used by the
+block versus the target block gas.
+
+Example:
+$ %s query globalfee base-fee
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
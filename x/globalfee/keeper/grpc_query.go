@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/globalfee/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// MinimumGasPrices returns the network-wide minimum gas prices.
+func (k Keeper) MinimumGasPrices(c context.Context, req *types.QueryMinimumGasPricesRequest) (*types.QueryMinimumGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryMinimumGasPricesResponse{MinimumGasPrices: k.GetMinimumGasPrices(ctx)}, nil
+}
+
+// BaseFee returns the dynamic base fee of the next block.
+func (k Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx).BaseFee
+	if !params.Enabled {
+		return &types.QueryBaseFeeResponse{}, nil
+	}
+	return &types.QueryBaseFeeResponse{Enabled: true, BaseFee: k.GetEffectiveBaseFee(ctx, params)}, nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/atomone-hub/govgen/x/globalfee/types"
+)
+
+// Keeper of the globalfee params and base fee. The params live in the x/params
+// subspace of the module so that they can be changed by a parameter change
+// proposal.
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+// NewKeeper returns a globalfee keeper.
+func NewKeeper(key sdk.StoreKey, paramSpace paramtypes.Subspace) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{storeKey: key, paramSpace: paramSpace}
+}
+
+// GetParams returns the globalfee params. Unset params, e.g. when the module
+// genesis has not run yet, are returned with their zero value.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
+}
+
+// SetParams sets the globalfee params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetMinimumGasPrices returns the network-wide minimum gas prices.
+func (k Keeper) GetMinimumGasPrices(ctx sdk.Context) sdk.DecCoins {
+	return k.GetParams(ctx).MinimumGasPrices
+}
+
+// GetBaseFee returns the dynamic base fee of the next block. It is empty when
+// the base fee is disabled.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BaseFeeKeyPrefix)
+	defer iterator.Close()
+
+	var baseFee sdk.DecCoins
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Dec
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		baseFee = append(baseFee, sdk.NewDecCoinFromDec(string(iterator.Key()[len(types.BaseFeeKeyPrefix):]), amount))
+	}
+	return baseFee
+}
+
+// SetBaseFee replaces the dynamic base fee of the next block.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.DecCoins) {
+	k.deleteBaseFee(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, coin := range baseFee {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.BaseFeeKey(coin.Denom), bz)
+	}
+}
+
+func (k Keeper) deleteBaseFee(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BaseFeeKeyPrefix)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the
+// current block, when the base fee is enabled. Otherwise the base fee is
+// cleared, so that it starts again from the floor once re-enabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, gasUsed uint64) {
+	params := k.GetParams(ctx).BaseFee
+	if !params.Enabled {
+		k.deleteBaseFee(ctx)
+		return
+	}
+
+	k.SetBaseFee(ctx, params.NextBaseFee(k.GetBaseFee(ctx), gasUsed))
+}
+
+// GetEffectiveBaseFee returns the base fee enforced in the current block,
+// which starts at the floor until the first update.
+func (k Keeper) GetEffectiveBaseFee(ctx sdk.Context, params types.BaseFeeParams) sdk.DecCoins {
+	baseFee := k.GetBaseFee(ctx)
+	if baseFee.Empty() {
+		return params.Floor
+	}
+	return baseFee
+}
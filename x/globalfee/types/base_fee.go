@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default base fee params
+var (
+	DefaultBaseFeeTargetBlockGas uint64 = 10_000_000
+	DefaultBaseFeeMaxChangeRate         = sdk.NewDecWithPrec(125, 3)
+)
+
+// NewBaseFeeParams creates a new BaseFeeParams object
+func NewBaseFeeParams(enabled bool, targetBlockGas uint64, maxChangeRate sdk.Dec, floor sdk.DecCoins) BaseFeeParams {
+	return BaseFeeParams{
+		Enabled:        enabled,
+		TargetBlockGas: targetBlockGas,
+		MaxChangeRate:  maxChangeRate,
+		Floor:          floor,
+	}
+}
+
+// DefaultBaseFeeParams returns the default base fee params, which disable the
+// base fee.
+func DefaultBaseFeeParams() BaseFeeParams {
+	return NewBaseFeeParams(false, DefaultBaseFeeTargetBlockGas, DefaultBaseFeeMaxChangeRate, sdk.DecCoins{})
+}
+
+// NextBaseFee returns the base fee of the next block, given the base fee of
+// the current block and the gas it used. Like EIP-1559, the base fee changes
+// by max_change_rate times the relative deviation of the gas used from the
+// target, bounded by max_change_rate, and it never goes below the floor. An
+// unset base fee starts at the floor.
+func (p BaseFeeParams) NextBaseFee(baseFee sdk.DecCoins, gasUsed uint64) sdk.DecCoins {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(p.TargetBlockGas))
+	deviation := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).Sub(target).Quo(target)
+	change := p.MaxChangeRate.Mul(deviation)
+	if change.GT(p.MaxChangeRate) {
+		change = p.MaxChangeRate
+	} else if change.LT(p.MaxChangeRate.Neg()) {
+		change = p.MaxChangeRate.Neg()
+	}
+	factor := sdk.OneDec().Add(change)
+
+	next := make(sdk.DecCoins, len(p.Floor))
+	for i, floor := range p.Floor {
+		amount := sdk.MaxDec(baseFee.AmountOf(floor.Denom), floor.Amount)
+		next[i] = sdk.NewDecCoinFromDec(floor.Denom, sdk.MaxDec(amount.Mul(factor), floor.Amount))
+	}
+	return next
+}
+
+// validateBaseFeeParams checks that the floor is a valid set of gas prices and
+// that the max change rate is between 0 and 1. When the base fee is enabled,
+// the max change rate must be set, the target block gas must be positive and
+// the floor must have positive gas prices.
+func validateBaseFeeParams(i interface{}) error {
+	v, ok := i.(BaseFeeParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := validateMinimumGasPrices(v.Floor); err != nil {
+		return fmt.Errorf("invalid base fee floor: %w", err)
+	}
+	if !v.MaxChangeRate.IsNil() && (v.MaxChangeRate.IsNegative() || v.MaxChangeRate.GT(sdk.OneDec())) {
+		return fmt.Errorf("base fee max change rate must be between 0 and 1: %s", v.MaxChangeRate)
+	}
+
+	if !v.Enabled {
+		return nil
+	}
+	if v.MaxChangeRate.IsNil() {
+		return fmt.Errorf("base fee max change rate must be set")
+	}
+	if v.TargetBlockGas == 0 {
+		return fmt.Errorf("base fee target block gas must be positive")
+	}
+	if v.Floor.Empty() || !v.Floor.IsAllPositive() {
+		return fmt.Errorf("base fee floor must have positive gas prices: %s", v.Floor)
+	}
+
+	return nil
+}
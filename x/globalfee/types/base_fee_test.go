@@ -0,0 +1,122 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/globalfee/types"
+)
+
+func TestNextBaseFee(t *testing.T) {
+	floor := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+		sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 2)),
+	)
+	params := types.NewBaseFeeParams(true, 1000, sdk.NewDecWithPrec(125, 3), floor)
+	baseFee := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(2, 3)),
+		sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(2, 2)),
+	)
+
+	tests := []struct {
+		name       string
+		baseFee    sdk.DecCoins
+		gasUsed    uint64
+		expBaseFee sdk.DecCoins
+	}{
+		{
+			name:       "unset base fee starts at the floor",
+			gasUsed:    1000,
+			expBaseFee: floor,
+		},
+		{
+			name:       "target gas used",
+			baseFee:    baseFee,
+			gasUsed:    1000,
+			expBaseFee: baseFee,
+		},
+		{
+			name:    "half of the target gas used",
+			baseFee: baseFee,
+			gasUsed: 500,
+			expBaseFee: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.001875")),
+				sdk.NewDecCoinFromDec("ugovgen", sdk.MustNewDecFromStr("0.01875")),
+			),
+		},
+		{
+			name:    "twice the target gas used",
+			baseFee: baseFee,
+			gasUsed: 2000,
+			expBaseFee: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.00225")),
+				sdk.NewDecCoinFromDec("ugovgen", sdk.MustNewDecFromStr("0.0225")),
+			),
+		},
+		{
+			name:    "change bounded by the max change rate",
+			baseFee: baseFee,
+			gasUsed: 10000,
+			expBaseFee: sdk.NewDecCoins(
+				sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.00225")),
+				sdk.NewDecCoinFromDec("ugovgen", sdk.MustNewDecFromStr("0.0225")),
+			),
+		},
+		{
+			name:       "base fee bounded by the floor",
+			baseFee:    floor,
+			gasUsed:    0,
+			expBaseFee: floor,
+		},
+		{
+			name:       "denoms outside the floor are dropped",
+			baseFee:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("uosmo", sdk.OneDec())),
+			gasUsed:    1000,
+			expBaseFee: floor,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expBaseFee, params.NextBaseFee(tt.baseFee, tt.gasUsed))
+		})
+	}
+}
+
+func TestParamsValidateBaseFee(t *testing.T) {
+	floor := sdk.NewDecCoins(sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 2)))
+	rate := sdk.NewDecWithPrec(125, 3)
+
+	tests := []struct {
+		name    string
+		baseFee types.BaseFeeParams
+		expErr  bool
+	}{
+		{"default", types.DefaultBaseFeeParams(), false},
+		{"unset", types.BaseFeeParams{}, false},
+		{"enabled", types.NewBaseFeeParams(true, 1000, rate, floor), false},
+		{"enabled without target", types.NewBaseFeeParams(true, 0, rate, floor), true},
+		{"enabled without floor", types.NewBaseFeeParams(true, 1000, rate, sdk.DecCoins{}), true},
+		{"enabled without max change rate", types.NewBaseFeeParams(true, 1000, sdk.Dec{}, floor), true},
+		{"zero floor", types.NewBaseFeeParams(true, 1000, rate, sdk.DecCoins{sdk.NewDecCoinFromDec("ugovgen", sdk.ZeroDec())}), true},
+		{"negative max change rate", types.NewBaseFeeParams(false, 1000, sdk.NewDec(-1), floor), true},
+		{"max change rate above one", types.NewBaseFeeParams(false, 1000, sdk.NewDec(2), floor), true},
+		{"invalid floor", types.NewBaseFeeParams(false, 1000, rate, sdk.DecCoins{{Denom: "1", Amount: sdk.OneDec()}}), true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewParams(sdk.DecCoins{}, nil, 0, tt.baseFee).Validate()
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
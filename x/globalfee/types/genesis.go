@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state for the globalfee module.
+func NewGenesisState(params Params, baseFee sdk.DecCoins) *GenesisState {
+	return &GenesisState{Params: params, BaseFee: baseFee}
+}
+
+// DefaultGenesisState returns a default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), sdk.DecCoins{})
+}
+
+// ValidateGenesis checks if the globalfee genesis state is valid.
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid globalfee params: %w", err)
+	}
+	if err := validateMinimumGasPrices(data.BaseFee); err != nil {
+		return fmt.Errorf("invalid globalfee base fee: %w", err)
+	}
+	return nil
+}
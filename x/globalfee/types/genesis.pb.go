@@ -0,0 +1,1062 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govgen/globalfee/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the globalfee module's genesis state.
+type GenesisState struct {
+	// params defines all the paramaters of the module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// base_fee defines the dynamic base fee of the next block, when enabled.
+	BaseFee github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b29b1ad734e9bde, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetBaseFee() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+// Params defines the set of globalfee parameters.
+type Params struct {
+	// minimum_gas_prices defines the network-wide minimum gas prices enforced in
+	// both CheckTx and DeliverTx. The local minimum gas prices of a validator can
+	// only raise them.
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices" yaml:"minimum_gas_prices"`
+	// bypass_min_fee_msg_types defines the message type URLs of the txs which
+	// are exempted from the minimum gas prices when their gas limit is at most
+	// max_total_bypass_min_fee_msg_gas_usage. When empty, the bypass configured
+	// in the app.toml of a validator applies to its local minimum gas prices only.
+	BypassMinFeeMsgTypes []string `protobuf:"bytes,2,rep,name=bypass_min_fee_msg_types,json=bypassMinFeeMsgTypes,proto3" json:"bypass_min_fee_msg_types,omitempty" yaml:"bypass_min_fee_msg_types"`
+	// max_total_bypass_min_fee_msg_gas_usage defines the maximum gas limit of the
+	// txs exempted from the minimum gas prices.
+	MaxTotalBypassMinFeeMsgGasUsage uint64 `protobuf:"varint,3,opt,name=max_total_bypass_min_fee_msg_gas_usage,json=maxTotalBypassMinFeeMsgGasUsage,proto3" json:"max_total_bypass_min_fee_msg_gas_usage,omitempty" yaml:"max_total_bypass_min_fee_msg_gas_usage"`
+	// base_fee defines the params of the dynamic base fee which, when enabled,
+	// replaces the minimum gas prices.
+	BaseFee BaseFeeParams `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b29b1ad734e9bde, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetBypassMinFeeMsgTypes() []string {
+	if m != nil {
+		return m.BypassMinFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTotalBypassMinFeeMsgGasUsage() uint64 {
+	if m != nil {
+		return m.MaxTotalBypassMinFeeMsgGasUsage
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFee() BaseFeeParams {
+	if m != nil {
+		return m.BaseFee
+	}
+	return BaseFeeParams{}
+}
+
+// BaseFeeParams defines the params of the dynamic base fee, which adjusts at
+// the end of each block to the gas used by the block versus a target.
+type BaseFeeParams struct {
+	// enabled defines whether the base fee replaces the minimum gas prices.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// target_block_gas defines the gas used by a block which keeps the base fee
+	// unchanged. The base fee rises above it and decreases below it.
+	TargetBlockGas uint64 `protobuf:"varint,2,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// max_change_rate defines the maximum relative change of the base fee from
+	// one block to the next.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate" yaml:"max_change_rate"`
+	// floor defines the minimum base fee, and the denoms it can be paid in.
+	Floor github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=floor,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"floor"`
+}
+
+func (m *BaseFeeParams) Reset()         { *m = BaseFeeParams{} }
+func (m *BaseFeeParams) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeParams) ProtoMessage()    {}
+func (*BaseFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b29b1ad734e9bde, []int{2}
+}
+func (m *BaseFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeParams.Merge(m, src)
+}
+func (m *BaseFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeParams proto.InternalMessageInfo
+
+func (m *BaseFeeParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *BaseFeeParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *BaseFeeParams) GetFloor() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Floor
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "govgen.globalfee.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "govgen.globalfee.v1beta1.Params")
+	proto.RegisterType((*BaseFeeParams)(nil), "govgen.globalfee.v1beta1.BaseFeeParams")
+}
+
+func init() {
+	proto.RegisterFile("govgen/globalfee/v1beta1/genesis.proto", fileDescriptor_7b29b1ad734e9bde)
+}
+
+var fileDescriptor_7b29b1ad734e9bde = []byte{
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xdb, 0x90, 0xb6, 0x53, 0x4a, 0x2b, 0xab, 0xa2, 0xa6, 0x20, 0x3b, 0x32, 0x52, 0x88,
+	0x84, 0x62, 0x2b, 0xed, 0x8e, 0x05, 0x0b, 0x17, 0x12, 0x58, 0x54, 0x8a, 0x4c, 0xd9, 0xd0, 0x85,
+	0x35, 0x76, 0x27, 0x13, 0xab, 0x1e, 0x8f, 0xe5, 0x99, 0x54, 0xc9, 0x0a, 0x89, 0x13, 0x70, 0x00,
+	0x4e, 0xc0, 0x49, 0x2a, 0xb1, 0xa0, 0x4b, 0xc4, 0xc2, 0xa0, 0x44, 0xe2, 0x00, 0x39, 0x01, 0x1a,
+	0x8f, 0xd3, 0xa4, 0x40, 0x44, 0xba, 0x4a, 0xf2, 0xe7, 0xbd, 0xf7, 0xdf, 0xff, 0xf3, 0x32, 0xa0,
+	0x86, 0xe9, 0x05, 0x46, 0xb1, 0x8d, 0x23, 0xea, 0xc3, 0xa8, 0x8b, 0x90, 0x7d, 0xd1, 0xf4, 0x11,
+	0x87, 0x4d, 0x1b, 0xa3, 0x18, 0xb1, 0x90, 0x59, 0x49, 0x4a, 0x39, 0x55, 0x35, 0x89, 0xb3, 0xae,
+	0x71, 0x56, 0x81, 0xdb, 0xdf, 0xc5, 0x14, 0xd3, 0x1c, 0x64, 0x8b, 0x6f, 0x12, 0xbf, 0xaf, 0x07,
+	0x94, 0x11, 0xca, 0x6c, 0x1f, 0xb2, 0x99, 0x64, 0x40, 0xc3, 0x58, 0x9e, 0x9b, 0x5f, 0x15, 0x70,
+	0xb7, 0x2d, 0x3b, 0xbc, 0xe1, 0x90, 0x23, 0xf5, 0x39, 0xa8, 0x24, 0x30, 0x85, 0x84, 0x69, 0x4a,
+	0x55, 0xa9, 0x6f, 0x1e, 0x54, 0xad, 0x45, 0x1d, 0xad, 0x4e, 0x8e, 0x73, 0xca, 0x97, 0x99, 0x51,
+	0x72, 0x0b, 0x96, 0xfa, 0x1e, 0xac, 0x8b, 0x5e, 0x5e, 0x17, 0x21, 0x6d, 0xa5, 0xba, 0x5a, 0xdf,
+	0x3c, 0x78, 0x64, 0x49, 0x0f, 0x96, 0xa8, 0x5f, 0x93, 0x5f, 0xa0, 0xe0, 0x88, 0x86, 0xb1, 0xd3,
+	0x12, 0xec, 0x49, 0x66, 0x6c, 0x0f, 0x21, 0x89, 0x9e, 0x99, 0x53, 0xae, 0xf9, 0xf9, 0x87, 0xf1,
+	0x14, 0x87, 0xbc, 0xd7, 0xf7, 0xad, 0x80, 0x12, 0xbb, 0x18, 0x43, 0x7e, 0x34, 0xd8, 0xd9, 0xb9,
+	0xcd, 0x87, 0x09, 0x62, 0x53, 0x19, 0xe6, 0xae, 0x09, 0x66, 0x0b, 0x21, 0xf3, 0xd7, 0x2a, 0xa8,
+	0x48, 0x67, 0xea, 0x27, 0x05, 0xa8, 0x24, 0x8c, 0x43, 0xd2, 0x27, 0x1e, 0x86, 0xcc, 0x4b, 0xd2,
+	0x30, 0x40, 0x62, 0xb0, 0xff, 0xdb, 0xea, 0x14, 0xb6, 0x1e, 0x48, 0x5b, 0x7f, 0xab, 0xdc, 0xda,
+	0xe0, 0x4e, 0xa1, 0xd1, 0x86, 0xac, 0x93, 0x2b, 0xa8, 0xa7, 0x40, 0xf3, 0x87, 0x09, 0x64, 0xcc,
+	0x23, 0x61, 0x2c, 0x86, 0xf6, 0x08, 0xc3, 0x5e, 0x4e, 0xcb, 0x57, 0xb7, 0xe1, 0x3c, 0x9e, 0x64,
+	0x86, 0x51, 0x2c, 0x66, 0x01, 0xd2, 0x74, 0x77, 0xe5, 0xd1, 0x71, 0x18, 0xb7, 0x10, 0x3a, 0x66,
+	0xf8, 0x44, 0x94, 0xd5, 0x0f, 0x0a, 0xa8, 0x11, 0x38, 0xf0, 0x38, 0xe5, 0x30, 0xf2, 0xfe, 0xc1,
+	0x16, 0xa3, 0xf4, 0x19, 0xc4, 0x48, 0x5b, 0xad, 0x2a, 0xf5, 0xb2, 0xd3, 0x9c, 0x64, 0x46, 0xa3,
+	0x98, 0x76, 0x29, 0x9e, 0xe9, 0x1a, 0x04, 0x0e, 0x4e, 0x04, 0xce, 0xb9, 0xe9, 0xa0, 0x0d, 0xd9,
+	0x5b, 0x81, 0x50, 0x4f, 0xe7, 0xc2, 0x50, 0xce, 0xe3, 0xf4, 0x64, 0x71, 0x9c, 0x1c, 0x79, 0x81,
+	0x45, 0xaa, 0xf6, 0x16, 0xe4, 0x62, 0x76, 0xd1, 0x5f, 0x56, 0xc0, 0xd6, 0x0d, 0x8e, 0xaa, 0x81,
+	0x35, 0x14, 0x43, 0x3f, 0x42, 0x67, 0x79, 0x78, 0xd7, 0xdd, 0xe9, 0x4f, 0xf5, 0x25, 0xd8, 0xe1,
+	0x30, 0xc5, 0x88, 0x7b, 0x7e, 0x44, 0x83, 0x73, 0x31, 0x84, 0xb6, 0x92, 0x8f, 0xfd, 0x70, 0x92,
+	0x19, 0x7b, 0xb2, 0xc7, 0x9f, 0x08, 0xd3, 0xbd, 0x27, 0x4b, 0x8e, 0xa8, 0xb4, 0x21, 0x53, 0x13,
+	0xb0, 0x2d, 0x76, 0x13, 0xf4, 0x60, 0x8c, 0x91, 0x97, 0x42, 0x2e, 0x97, 0xb7, 0xe1, 0xbc, 0x12,
+	0x6e, 0xbf, 0x67, 0x46, 0x6d, 0xb9, 0x44, 0x4c, 0x32, 0xe3, 0xfe, 0x6c, 0xd5, 0x73, 0x72, 0xa6,
+	0xbb, 0x45, 0xe0, 0xe0, 0x28, 0x2f, 0xb8, 0xe2, 0xef, 0x88, 0xc1, 0x9d, 0x6e, 0x44, 0x69, 0xaa,
+	0x95, 0x97, 0x08, 0xed, 0xa1, 0x70, 0x71, 0xdb, 0x5c, 0x4a, 0x7d, 0xe7, 0xf5, 0xe5, 0x48, 0x57,
+	0xae, 0x46, 0xba, 0xf2, 0x73, 0xa4, 0x2b, 0x1f, 0xc7, 0x7a, 0xe9, 0x6a, 0xac, 0x97, 0xbe, 0x8d,
+	0xf5, 0xd2, 0x3b, 0x7b, 0x4e, 0x0d, 0x72, 0x4a, 0x68, 0x8c, 0x1a, 0xbd, 0xbe, 0x6f, 0x17, 0x2f,
+	0xd6, 0x60, 0xee, 0xcd, 0xca, 0xa5, 0xfd, 0x4a, 0xfe, 0xb4, 0x1c, 0xfe, 0x1e, 0x00, 0x8e, 0xf9,
+	0xe4, 0x9b, 0xd4, 0x04, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for iNdEx := len(m.BypassMinFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BypassMinFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.BypassMinFeeMsgTypes[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BypassMinFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Floor) > 0 {
+		for iNdEx := len(m.Floor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Floor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BypassMinFeeMsgTypes) > 0 {
+		for _, s := range m.BypassMinFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxTotalBypassMinFeeMsgGasUsage != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTotalBypassMinFeeMsgGasUsage))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *BaseFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Floor) > 0 {
+		for _, e := range m.Floor {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = append(m.BaseFee, types.DecCoin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassMinFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BypassMinFeeMsgTypes = append(m.BypassMinFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalBypassMinFeeMsgGasUsage", wireType)
+			}
+			m.MaxTotalBypassMinFeeMsgGasUsage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalBypassMinFeeMsgGasUsage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Floor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Floor = append(m.Floor, types.DecCoin{})
+			if err := m.Floor[len(m.Floor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "globalfee"
+
+	// StoreKey is the store key string for globalfee
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for globalfee
+	QuerierRoute = ModuleName
+)
+
+// Keys for globalfee store
+// Items are stored with the following key: values
+//
+// - 0x01<denom_Bytes>: base fee amount (sdk.Dec)
+var BaseFeeKeyPrefix = []byte{0x01}
+
+// BaseFeeKey returns the key of the base fee amount in the given denom
+func BaseFeeKey(denom string) []byte {
+	return append(BaseFeeKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	ParamStoreKeyMinGasPrices                    = []byte("MinimumGasPrices")
+	ParamStoreKeyBypassMinFeeMsgTypes            = []byte("BypassMinFeeMsgTypes")
+	ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage = []byte("MaxTotalBypassMinFeeMsgGasUsage")
+	ParamStoreKeyBaseFee                         = []byte("BaseFee")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable - Key declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	minGasPrices sdk.DecCoins, bypassMinFeeMsgTypes []string, maxTotalBypassMinFeeMsgGasUsage uint64, baseFee BaseFeeParams,
+) Params {
+	return Params{
+		MinimumGasPrices:                minGasPrices,
+		BypassMinFeeMsgTypes:            bypassMinFeeMsgTypes,
+		MaxTotalBypassMinFeeMsgGasUsage: maxTotalBypassMinFeeMsgGasUsage,
+		BaseFee:                         baseFee,
+	}
+}
+
+// DefaultParams returns the default globalfee params, which enforce no
+// network-wide minimum gas prices, leave the fee bypass to the app.toml of the
+// validators and disable the dynamic base fee.
+func DefaultParams() Params {
+	return NewParams(sdk.DecCoins{}, []string{}, 0, DefaultBaseFeeParams())
+}
+
+// Validate performs basic validation of the globalfee params.
+func (p Params) Validate() error {
+	if err := validateMinimumGasPrices(p.MinimumGasPrices); err != nil {
+		return err
+	}
+	if err := validateBypassMinFeeMsgTypes(p.BypassMinFeeMsgTypes); err != nil {
+		return err
+	}
+	if err := validateMaxTotalBypassMinFeeMsgGasUsage(p.MaxTotalBypassMinFeeMsgGasUsage); err != nil {
+		return err
+	}
+	return validateBaseFeeParams(p.BaseFee)
+}
+
+// HasBypassMinFeeMsgTypes returns true if the params set the txs exempted from
+// the minimum gas prices, overriding the app.toml of the validators.
+func (p Params) HasBypassMinFeeMsgTypes() bool {
+	return len(p.BypassMinFeeMsgTypes) > 0
+}
+
+// ParamSetPairs implements the ParamSet interface.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrices, &p.MinimumGasPrices, validateMinimumGasPrices),
+		paramtypes.NewParamSetPair(ParamStoreKeyBypassMinFeeMsgTypes, &p.BypassMinFeeMsgTypes, validateBypassMinFeeMsgTypes),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalBypassMinFeeMsgGasUsage, &p.MaxTotalBypassMinFeeMsgGasUsage, validateMaxTotalBypassMinFeeMsgGasUsage),
+		paramtypes.NewParamSetPair(ParamStoreKeyBaseFee, &p.BaseFee, validateBaseFeeParams),
+	}
+}
+
+// validateMinimumGasPrices checks that the gas prices are sorted, have no
+// duplicate denom and are not negative. Zero gas prices are allowed.
+func validateMinimumGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, coin := range v {
+		if err := sdk.ValidateDenom(coin.Denom); err != nil {
+			return fmt.Errorf("invalid minimum gas price denom: %w", err)
+		}
+		if coin.Amount.IsNil() || coin.Amount.IsNegative() {
+			return fmt.Errorf("minimum gas price must not be negative: %s", coin)
+		}
+		if i > 0 && v[i-1].Denom >= coin.Denom {
+			return fmt.Errorf("minimum gas prices must be sorted and have unique denoms: %s", v)
+		}
+	}
+
+	return nil
+}
+
+// validateBypassMinFeeMsgTypes checks that the message type URLs are not empty
+// and unique.
+func validateBypassMinFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") {
+			return fmt.Errorf("invalid bypass min fee message type URL: %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate bypass min fee message type URL: %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+func validateMaxTotalBypassMinFeeMsgGasUsage(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/globalfee/types"
+)
+
+func TestParamsValidateMinimumGasPrices(t *testing.T) {
+	tests := []struct {
+		name         string
+		minGasPrices sdk.DecCoins
+		expErr       bool
+	}{
+		{"empty", sdk.DecCoins{}, false},
+		{"nil", nil, false},
+		{"zero gas price", sdk.DecCoins{sdk.NewDecCoinFromDec("ugovgen", sdk.ZeroDec())}, false},
+		{"several denoms", sdk.DecCoins{
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+			sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(6, 6)),
+		}, false},
+		{"negative gas price", sdk.DecCoins{{Denom: "ugovgen", Amount: sdk.NewDec(-1)}}, true},
+		{"invalid denom", sdk.DecCoins{{Denom: "1", Amount: sdk.NewDec(1)}}, true},
+		{"unsorted denoms", sdk.DecCoins{
+			sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(6, 6)),
+			sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3)),
+		}, true},
+		{"duplicate denoms", sdk.DecCoins{
+			sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(6, 6)),
+			sdk.NewDecCoinFromDec("ugovgen", sdk.NewDecWithPrec(1, 3)),
+		}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.NewParams(tt.minGasPrices, nil, 0, types.DefaultBaseFeeParams()).Validate()
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsValidateBypassMinFeeMsgTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		msgTypes []string
+		expErr   bool
+	}{
+		{"empty", []string{}, false},
+		{"nil", nil, false},
+		{"votes", []string{"/govgen.gov.v1beta1.MsgVote", "/govgen.gov.v1beta1.MsgVoteWeighted"}, false},
+		{"empty type URL", []string{""}, true},
+		{"invalid type URL", []string{"govgen.gov.v1beta1.MsgVote"}, true},
+		{"duplicate type URLs", []string{"/govgen.gov.v1beta1.MsgVote", "/govgen.gov.v1beta1.MsgVote"}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.NewParams(sdk.DecCoins{}, tt.msgTypes, 200000, types.DefaultBaseFeeParams())
+			err := params.Validate()
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tt.msgTypes) > 0, params.HasBypassMinFeeMsgTypes())
+		})
+	}
+}
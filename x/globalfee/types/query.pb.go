@@ -0,0 +1,943 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govgen/globalfee/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryMinimumGasPricesRequest is the request type for the
+// Query/MinimumGasPrices RPC method.
+type QueryMinimumGasPricesRequest struct {
+}
+
+func (m *QueryMinimumGasPricesRequest) Reset()         { *m = QueryMinimumGasPricesRequest{} }
+func (m *QueryMinimumGasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinimumGasPricesRequest) ProtoMessage()    {}
+func (*QueryMinimumGasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e082ddaa60bc8, []int{0}
+}
+func (m *QueryMinimumGasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinimumGasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinimumGasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinimumGasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinimumGasPricesRequest.Merge(m, src)
+}
+func (m *QueryMinimumGasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinimumGasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinimumGasPricesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinimumGasPricesRequest proto.InternalMessageInfo
+
+// QueryMinimumGasPricesResponse is the response type for the
+// Query/MinimumGasPrices RPC method.
+type QueryMinimumGasPricesResponse struct {
+	MinimumGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=minimum_gas_prices,json=minimumGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"minimum_gas_prices" yaml:"minimum_gas_prices"`
+}
+
+func (m *QueryMinimumGasPricesResponse) Reset()         { *m = QueryMinimumGasPricesResponse{} }
+func (m *QueryMinimumGasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinimumGasPricesResponse) ProtoMessage()    {}
+func (*QueryMinimumGasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e082ddaa60bc8, []int{1}
+}
+func (m *QueryMinimumGasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinimumGasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinimumGasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinimumGasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinimumGasPricesResponse.Merge(m, src)
+}
+func (m *QueryMinimumGasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinimumGasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinimumGasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinimumGasPricesResponse proto.InternalMessageInfo
+
+func (m *QueryMinimumGasPricesResponse) GetMinimumGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinimumGasPrices
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest is the request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e082ddaa60bc8, []int{2}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// enabled defines whether the base fee replaces the minimum gas prices.
+	Enabled bool                                        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BaseFee github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_fee" yaml:"base_fee"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b0e082ddaa60bc8, []int{3}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryBaseFeeResponse) GetBaseFee() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryMinimumGasPricesRequest)(nil), "govgen.globalfee.v1beta1.QueryMinimumGasPricesRequest")
+	proto.RegisterType((*QueryMinimumGasPricesResponse)(nil), "govgen.globalfee.v1beta1.QueryMinimumGasPricesResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "govgen.globalfee.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "govgen.globalfee.v1beta1.QueryBaseFeeResponse")
+}
+
+func init() {
+	proto.RegisterFile("govgen/globalfee/v1beta1/query.proto", fileDescriptor_9b0e082ddaa60bc8)
+}
+
+var fileDescriptor_9b0e082ddaa60bc8 = []byte{
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x3f, 0x6f, 0x13, 0x31,
+	0x18, 0xc6, 0xe3, 0x20, 0x48, 0x65, 0x06, 0x2a, 0x53, 0xa4, 0x23, 0x0a, 0x97, 0xea, 0xd4, 0xa1,
+	0x02, 0x62, 0xab, 0x05, 0x81, 0xc4, 0x18, 0x50, 0x11, 0x03, 0x52, 0xc9, 0xc8, 0x12, 0xf9, 0xae,
+	0x6f, 0xdd, 0x13, 0x67, 0xbf, 0xd7, 0xd8, 0x57, 0x91, 0x09, 0x89, 0x4f, 0x80, 0x04, 0x23, 0x9f,
+	0x80, 0x8d, 0x91, 0x85, 0xb9, 0x63, 0x25, 0x16, 0xc4, 0x50, 0x50, 0xc2, 0x27, 0xe0, 0x13, 0xa0,
+	0x9c, 0xef, 0xa0, 0x7f, 0x14, 0x68, 0xa7, 0xfb, 0xf3, 0x3e, 0x7e, 0xdf, 0xe7, 0xe7, 0xc7, 0xa6,
+	0x2b, 0x0a, 0xf7, 0x14, 0x18, 0xa1, 0x32, 0x8c, 0x65, 0xb6, 0x0d, 0x20, 0xf6, 0xd6, 0x62, 0x70,
+	0x72, 0x4d, 0xec, 0x16, 0x30, 0x1a, 0xf3, 0x7c, 0x84, 0x0e, 0x59, 0xe0, 0x55, 0xfc, 0x8f, 0x8a,
+	0x57, 0xaa, 0xf6, 0x92, 0x42, 0x85, 0xa5, 0x48, 0xcc, 0xde, 0xbc, 0xbe, 0xdd, 0x51, 0x88, 0x2a,
+	0x03, 0x21, 0xf3, 0x54, 0x48, 0x63, 0xd0, 0x49, 0x97, 0xa2, 0xb1, 0x55, 0x35, 0x4c, 0xd0, 0x6a,
+	0xb4, 0x22, 0x96, 0xf6, 0xef, 0xb8, 0x04, 0x53, 0xe3, 0xeb, 0x51, 0x48, 0x3b, 0xcf, 0x66, 0xc3,
+	0x9f, 0xa6, 0x26, 0xd5, 0x85, 0x7e, 0x2c, 0xed, 0xe6, 0x28, 0x4d, 0xc0, 0x0e, 0x60, 0xb7, 0x00,
+	0xeb, 0xa2, 0xcf, 0x84, 0xde, 0x98, 0x23, 0xb0, 0x39, 0x1a, 0x0b, 0xec, 0x3d, 0xa1, 0x4c, 0xfb,
+	0xe2, 0x50, 0x49, 0x3b, 0xcc, 0xcb, 0x72, 0x40, 0x96, 0x2f, 0xac, 0x5e, 0x5e, 0xef, 0x70, 0x3f,
+	0x9f, 0xcf, 0xe6, 0xd7, 0x20, 0xfc, 0x11, 0x24, 0x0f, 0x31, 0x35, 0xfd, 0xcd, 0xfd, 0xc3, 0x6e,
+	0xe3, 0xd7, 0x61, 0xf7, 0xfa, 0x58, 0xea, 0xec, 0x41, 0x74, 0xba, 0x4b, 0xf4, 0xe1, 0x7b, 0xf7,
+	0x96, 0x4a, 0xdd, 0x4e, 0x11, 0xf3, 0x04, 0xb5, 0xa8, 0x60, 0xfc, 0xa3, 0x67, 0xb7, 0x5e, 0x08,
+	0x37, 0xce, 0xc1, 0xd6, 0x0d, 0xed, 0x60, 0x51, 0x9f, 0xb0, 0x19, 0x5d, 0xa3, 0x57, 0x4b, 0xff,
+	0x7d, 0x69, 0x61, 0x03, 0xa0, 0xe6, 0xfa, 0x48, 0xe8, 0xd2, 0xf1, 0xff, 0x15, 0x4e, 0x40, 0x5b,
+	0x60, 0x64, 0x9c, 0xc1, 0x56, 0x40, 0x96, 0xc9, 0xea, 0xc2, 0xa0, 0xfe, 0x64, 0xaf, 0xe8, 0xc2,
+	0x8c, 0x62, 0xb8, 0x0d, 0x10, 0x34, 0xcf, 0x40, 0xb7, 0x51, 0xd1, 0x5d, 0xf1, 0x74, 0xf5, 0xda,
+	0x73, 0x33, 0xb5, 0x62, 0x6f, 0x71, 0xfd, 0x5b, 0x93, 0x5e, 0x2c, 0x3d, 0xb3, 0x4f, 0x84, 0x2e,
+	0x9e, 0x0c, 0x84, 0xdd, 0xe3, 0xf3, 0x4e, 0x0e, 0xff, 0x57, 0xc4, 0xed, 0xfb, 0xe7, 0x5e, 0xe7,
+	0xb7, 0x2a, 0xba, 0xfb, 0xfa, 0xcb, 0xcf, 0xb7, 0x4d, 0xce, 0x6e, 0x8b, 0xb9, 0x07, 0xfb, 0x74,
+	0xa4, 0xec, 0x1d, 0xa1, 0xad, 0x6a, 0xd3, 0x59, 0xef, 0x3f, 0xa3, 0x8f, 0x87, 0xd6, 0xe6, 0x67,
+	0x95, 0x57, 0x06, 0x6f, 0x96, 0x06, 0x57, 0x58, 0x34, 0xdf, 0x60, 0x9d, 0x4a, 0xff, 0xc9, 0xfe,
+	0x24, 0x24, 0x07, 0x93, 0x90, 0xfc, 0x98, 0x84, 0xe4, 0xcd, 0x34, 0x6c, 0x1c, 0x4c, 0xc3, 0xc6,
+	0xd7, 0x69, 0xd8, 0x78, 0x2e, 0x8e, 0x84, 0x25, 0x1d, 0x6a, 0x34, 0xd0, 0xdb, 0x29, 0xe2, 0xba,
+	0xe7, 0xcb, 0x23, 0x5d, 0xcb, 0xe4, 0xe2, 0x4b, 0xe5, 0xd5, 0xba, 0xf3, 0x7b, 0x00, 0x80, 0x88,
+	0x0d, 0x29, 0xf0, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// MinimumGasPrices queries the network-wide minimum gas prices.
+	MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error)
+	// BaseFee queries the dynamic base fee of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) MinimumGasPrices(ctx context.Context, in *QueryMinimumGasPricesRequest, opts ...grpc.CallOption) (*QueryMinimumGasPricesResponse, error) {
+	out := new(QueryMinimumGasPricesResponse)
+	err := c.cc.Invoke(ctx, "/govgen.globalfee.v1beta1.Query/MinimumGasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/govgen.globalfee.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// MinimumGasPrices queries the network-wide minimum gas prices.
+	MinimumGasPrices(context.Context, *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error)
+	// BaseFee queries the dynamic base fee of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) MinimumGasPrices(ctx context.Context, req *QueryMinimumGasPricesRequest) (*QueryMinimumGasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinimumGasPrices not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_MinimumGasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinimumGasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinimumGasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.globalfee.v1beta1.Query/MinimumGasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinimumGasPrices(ctx, req.(*QueryMinimumGasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.globalfee.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "govgen.globalfee.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MinimumGasPrices",
+			Handler:    _Query_MinimumGasPrices_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "govgen/globalfee/v1beta1/query.proto",
+}
+
+func (m *QueryMinimumGasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinimumGasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinimumGasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for iNdEx := len(m.MinimumGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinimumGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryMinimumGasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMinimumGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		for _, e := range m.MinimumGasPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryMinimumGasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinimumGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinimumGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = append(m.MinimumGasPrices, types.DecCoin{})
+			if err := m.MinimumGasPrices[len(m.MinimumGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = append(m.BaseFee, types.DecCoin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
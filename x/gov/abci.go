@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -20,16 +21,19 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	// delete inactive proposal from store and its deposits
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.ProposalId)
-		keeper.DeleteDeposits(ctx, proposal.ProposalId)
+		outcome := keeper.SettleInactiveProposalDeposits(ctx, proposal.ProposalId)
+		keeper.DecreaseProposerOpenProposals(ctx, proposal)
 
 		// called when proposal become inactive
-		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId)
+		keeper.AfterProposalFailedMinDeposit(ctx, proposal.ProposalId, outcome)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeInactiveProposal,
 				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
 				sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueProposalDropped),
+				sdk.NewAttribute(types.AttributeKeyRefundedDeposits, outcome.Refunded.String()),
+				sdk.NewAttribute(types.AttributeKeyBurnedDeposits, outcome.Burned.String()),
 			),
 		)
 
@@ -39,31 +43,72 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 			"title", proposal.GetTitle(),
 			"min_deposit", keeper.GetDepositParams(ctx).MinDeposit.String(),
 			"total_deposit", proposal.TotalDeposit.String(),
+			"refunded_deposits", outcome.Refunded.String(),
+			"burned_deposits", outcome.Burned.String(),
 		)
 
 		return false
 	})
 
+	// end the voting period of active proposals whose outcome is already decided,
+	// they are then tallied along with the proposals whose voting period ended
+	if interval := keeper.GetVotingParams(ctx).EarlyTerminationCheckInterval; interval > 0 && uint64(ctx.BlockHeight())%interval == 0 {
+		var activeProposals []types.Proposal
+		keeper.IterateAllActiveProposalsQueue(ctx, func(proposal types.Proposal) bool {
+			if proposal.VotingEndTime.After(ctx.BlockHeader().Time) {
+				activeProposals = append(activeProposals, proposal)
+			}
+			return false
+		})
+
+		var decidedProposals []types.Proposal
+		for _, proposal := range earlyTerminationCandidates(activeProposals, uint64(ctx.BlockHeight())/interval) {
+			if keeper.IsOutcomeDecided(ctx, proposal) {
+				decidedProposals = append(decidedProposals, proposal)
+			}
+		}
+
+		for _, proposal := range decidedProposals {
+			keeper.SetProposalVotingEndTime(ctx, proposal, ctx.BlockHeader().Time)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeEarlyTermination,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+				),
+			)
+
+			logger.Info(
+				"proposal outcome decided; voting period ended early",
+				"proposal", proposal.ProposalId,
+				"title", proposal.GetTitle(),
+				"voting_end_time", proposal.VotingEndTime.String(),
+			)
+		}
+	}
+
 	// fetch active proposals whose voting periods have ended (are passed the block time)
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal types.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		passes, burnDeposits, vetoed, tallyResults := keeper.Tally(ctx, proposal)
 
 		if burnDeposits {
 			keeper.DeleteDeposits(ctx, proposal.ProposalId)
+
+			// the proposer of a vetoed proposal is put in cooldown
+			if vetoed {
+				keeper.RecordVetoedProposal(ctx, proposal)
+			}
 		} else {
 			keeper.RefundDeposits(ctx, proposal.ProposalId)
 		}
 
 		if passes {
-			handler := keeper.Router().GetRoute(proposal.ProposalRoute())
-			cacheCtx, writeCache := ctx.CacheContext()
-
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, proposal.GetContent())
+			cacheCtx, writeCache, err := keeper.ExecuteProposal(ctx, proposal)
 			if err == nil {
 				proposal.Status = types.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
@@ -92,6 +137,8 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		keeper.SetProposal(ctx, proposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+		keeper.DeleteVoteFeeSponsorships(ctx, proposal.ProposalId)
+		keeper.DecreaseProposerOpenProposals(ctx, proposal)
 
 		// when proposal become active
 		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.ProposalId)
@@ -112,4 +159,29 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		)
 		return false
 	})
+
+	// delete the governance message counts which are out of the rate limit
+	// window of the next block
+	keeper.PruneGovMsgCounts(ctx)
+}
+
+// earlyTerminationCandidates returns the active proposals whose outcome is
+// checked at the given check round. At most MaxEarlyTerminationChecks
+// proposals are checked per round, in proposal ID order, starting where the
+// previous round stopped, so that all the active proposals are checked in turn.
+func earlyTerminationCandidates(activeProposals []types.Proposal, round uint64) []types.Proposal {
+	if len(activeProposals) <= types.MaxEarlyTerminationChecks {
+		return activeProposals
+	}
+
+	sort.Slice(activeProposals, func(i, j int) bool {
+		return activeProposals[i].ProposalId < activeProposals[j].ProposalId
+	})
+	n := uint64(len(activeProposals))
+	start := round * types.MaxEarlyTerminationChecks % n
+	candidates := make([]types.Proposal, 0, types.MaxEarlyTerminationChecks)
+	for i := uint64(0); i < types.MaxEarlyTerminationChecks; i++ {
+		candidates = append(candidates, activeProposals[(start+i)%n])
+	}
+	return candidates
 }
@@ -221,7 +221,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 		},
 		{
 			name:         "software upgrade proposal",
-			content:      govgenhelpers.TestSoftwareUpgradeProposal,
+			content:      govgenhelpers.TestSoftwareUpgradeProposalWithInfo,
 			votingPeriod: types.DefaultPeriodSoftwareUpgrade,
 		},
 		{
@@ -338,7 +338,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	deposits := initialModuleAccCoins.Add(proposal.TotalDeposit...).Add(proposalCoins...)
 	require.True(t, moduleAccCoins.IsEqual(deposits))
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -354,6 +354,53 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestEndBlockerUpdateParamsProposal(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 1, valTokens)
+
+	handler := gov.NewHandler(app.GovKeeper)
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MaxDepositPeriod = 2 * depositParams.MaxDepositPeriod
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.MaxVoteRationaleLength = 42
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.Quorum = sdk.NewDecWithPrec(5, 1)
+	content := types.NewUpdateParamsProposal("Test", "description", depositParams, votingParams, tallyParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, content)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	handleAndCheck(t, handler, ctx, types.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
+	require.NoError(t, err)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(app.GovKeeper.GetVotingPeriod(ctx, content))
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, depositParams, app.GovKeeper.GetDepositParams(ctx))
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, tallyParams, app.GovKeeper.GetTallyParams(ctx))
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := govgenhelpers.SetupNoValset(false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -381,7 +428,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 
 	handleAndCheck(t, gov.NewHandler(app.GovKeeper), ctx, newDepositMsg)
 
-	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.NoError(t, err)
 
 	newHeader := ctx.BlockHeader()
@@ -397,3 +444,291 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// validate that the proposal fails/has been rejected
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
+
+func TestEndBlockerEarlyTermination(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// the whole supply is bonded
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{42, 42, 42})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.EarlyTerminationCheckInterval = 2
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	// votes are final, so that the decided outcome can't be reversed
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNoWithVeto), "")
+	require.ErrorIs(t, err, types.ErrVoteFinal)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Hour)
+
+	// outcome is decided but the block height is not a check height
+	newHeader.Height = 3
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+
+	newHeader.Height = 4
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusPassed, proposal.Status)
+	require.Equal(t, newHeader.Time, proposal.VotingEndTime)
+
+	activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, proposal.VotingEndTime.Add(types.DefaultPeriodText))
+	require.False(t, activeQueue.Valid())
+	activeQueue.Close()
+}
+
+func TestEndBlockerEarlyTerminationRotation(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{42, 42, 42})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.EarlyTerminationCheckInterval = 2
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	// more decided proposals than can be checked at once
+	var proposalIDs []uint64
+	for i := 0; i < types.MaxEarlyTerminationChecks+2; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+		require.NoError(t, err)
+		app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+	}
+	countPassed := func() (passed int) {
+		for _, proposalID := range proposalIDs {
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			if proposal.Status == types.StatusPassed {
+				passed++
+			}
+		}
+		return passed
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(time.Hour)
+	newHeader.Height = 4
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, types.MaxEarlyTerminationChecks, countPassed())
+
+	// the remaining proposals are checked at the next check height
+	newHeader.Height = 6
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, len(proposalIDs), countPassed())
+}
+
+func TestEndBlockerProposerOpenProposals(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 2, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.MaxProposalsPerProposer = 2
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	submit := func(ctx sdk.Context, proposer sdk.AccAddress, deposit sdk.Coins) error {
+		msg, err := types.NewMsgSubmitProposal(govgenhelpers.TestTextProposal, deposit, proposer)
+		require.NoError(t, err)
+		_, err = govHandler(ctx, msg)
+		return err
+	}
+	smallDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))
+
+	// one proposal in deposit period and one in voting period
+	require.NoError(t, submit(ctx, addrs[0], smallDeposit))
+	require.NoError(t, submit(ctx, addrs[0], depositParams.MinDeposit))
+	require.Equal(t, uint64(2), app.GovKeeper.GetProposerOpenProposals(ctx, addrs[0]))
+	require.ErrorIs(t, submit(ctx, addrs[0], smallDeposit), types.ErrTooManyProposals)
+	require.NoError(t, submit(ctx, addrs[1], smallDeposit))
+
+	// the proposal in deposit period is deleted
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(depositParams.MaxDepositPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, uint64(1), app.GovKeeper.GetProposerOpenProposals(ctx, addrs[0]))
+	require.Zero(t, app.GovKeeper.GetProposerOpenProposals(ctx, addrs[1]))
+	require.NoError(t, submit(ctx, addrs[0], smallDeposit))
+	require.ErrorIs(t, submit(ctx, addrs[0], smallDeposit), types.ErrTooManyProposals)
+
+	// the proposal in voting period is tallied
+	newHeader.Time = ctx.BlockHeader().Time.Add(types.DefaultPeriodText)
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Zero(t, app.GovKeeper.GetProposerOpenProposals(ctx, addrs[0]))
+}
+
+func TestEndBlockerVetoedProposerCooldown(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 3, valTokens)
+
+	stakingHandler := staking.NewHandler(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingHandler, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1]), sdk.ValAddress(addrs[2])}, []int64{10, 10, 10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.VetoCooldownPeriod = 24 * time.Hour
+	depositParams.VetoMinDepositMultiplier = sdk.OneDec()
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	submit := func(ctx sdk.Context, deposit sdk.Coins) (types.Proposal, error) {
+		msg, err := types.NewMsgSubmitProposal(govgenhelpers.TestTextProposal, deposit, addrs[0])
+		require.NoError(t, err)
+		res, err := govHandler(ctx, msg)
+		if err != nil {
+			return types.Proposal{}, err
+		}
+		var resMsg types.MsgSubmitProposalResponse
+		require.NoError(t, proto.Unmarshal(res.Data, &resMsg))
+		proposal, ok := app.GovKeeper.GetProposal(ctx, resMsg.ProposalId)
+		require.True(t, ok)
+		return proposal, nil
+	}
+
+	proposal, err := submit(ctx, depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+	for _, addr := range addrs {
+		require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addr, types.NewNonSplitVoteOption(types.OptionNoWithVeto), ""))
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	record, found := app.GovKeeper.GetProposerVetoRecord(ctx, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewProposerVetoRecord(addrs[0], 1, newHeader.Time.Add(24*time.Hour)), record)
+
+	res, err := app.GovKeeper.ProposersInCooldown(sdk.WrapSDKContext(ctx), &types.QueryProposersInCooldownRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ProposerVetoRecord{record}, res.VetoRecords)
+
+	// the proposer can't submit proposals during the cooldown
+	_, err = submit(ctx, depositParams.MinDeposit)
+	require.ErrorIs(t, err, types.ErrProposerInCooldown)
+
+	// after the cooldown, the min deposit of the proposer is doubled
+	newHeader.Time = record.CooldownEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	res, err = app.GovKeeper.ProposersInCooldown(sdk.WrapSDKContext(ctx), &types.QueryProposersInCooldownRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.VetoRecords)
+
+	proposal, err = submit(ctx, depositParams.MinDeposit)
+	require.NoError(t, err)
+	require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+	_, err = govHandler(ctx, types.NewMsgDeposit(addrs[1], proposal.ProposalId, depositParams.MinDeposit))
+	require.NoError(t, err)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, types.StatusVotingPeriod, proposal.Status)
+}
+
+func TestEndBlockerInactiveProposalRefund(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 2, valTokens)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	govHandler := gov.NewHandler(app.GovKeeper)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.InactiveProposalRefundRatio = sdk.NewDecWithPrec(5, 1)
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	newProposalMsg, err := types.NewMsgSubmitProposal(
+		types.ContentFromProposalType("test", "test", types.ProposalTypeText),
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)),
+		addrs[0],
+	)
+	require.NoError(t, err)
+	res, err := govHandler(ctx, newProposalMsg)
+	require.NoError(t, err)
+	var resMsg types.MsgSubmitProposalResponse
+	require.NoError(t, proto.Unmarshal(res.Data, &resMsg))
+
+	_, err = govHandler(ctx, types.NewMsgDeposit(addrs[1], resMsg.ProposalId, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3))))
+	require.NoError(t, err)
+
+	addr0Balance := app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom)
+	addr1Balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(depositParams.MaxDepositPeriod)
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	_, ok := app.GovKeeper.GetProposal(ctx, resMsg.ProposalId)
+	require.False(t, ok)
+	require.Empty(t, app.GovKeeper.GetDeposits(ctx, resMsg.ProposalId))
+
+	// half of each deposit, rounded down, is refunded and the rest is burned
+	require.Equal(t, addr0Balance.AddAmount(sdk.NewInt(2)), app.BankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom))
+	require.Equal(t, addr1Balance.AddAmount(sdk.NewInt(1)), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+	require.Equal(t, supply.SubAmount(sdk.NewInt(5)), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, app.GovKeeper.GetGovernanceAccount(ctx).GetAddress()).IsZero())
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeInactiveProposal {
+			continue
+		}
+		found = true
+		attrs := make(map[string]string)
+		for _, attr := range event.Attributes {
+			attrs[string(attr.Key)] = string(attr.Value)
+		}
+		require.Equal(t, "3stake", attrs[types.AttributeKeyRefundedDeposits])
+		require.Equal(t, "5stake", attrs[types.AttributeKeyBurnedDeposits])
+	}
+	require.True(t, found)
+}
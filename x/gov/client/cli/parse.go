@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/pflag"
 
 	govutils "github.com/atomone-hub/govgen/x/gov/client/utils"
+	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
 func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
@@ -42,3 +47,75 @@ func parseSubmitProposalFlags(fs *pflag.FlagSet) (*proposal, error) {
 
 	return proposal, nil
 }
+
+// batchVote is the file representation of a single vote of a vote batch.
+type batchVote struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Options    string `json:"options"`
+	Rationale  string `json:"rationale"`
+}
+
+// parseVoteBatchFile reads the votes of a vote batch from a file. Files with a
+// .csv extension are read as CSV records of the form
+// proposal-id,weighted-options[,rationale], any other file is read as a JSON
+// array of batchVote.
+func parseVoteBatchFile(path string) ([]types.BatchVote, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes []batchVote
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		votes, err = parseVoteBatchCSV(string(contents))
+	} else {
+		err = json.Unmarshal(contents, &votes)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make([]types.BatchVote, len(votes))
+	for i, v := range votes {
+		options, err := types.WeightedVoteOptionsFromString(govutils.NormalizeWeightedVoteOptions(v.Options))
+		if err != nil {
+			return nil, fmt.Errorf("invalid options for proposal %d: %w", v.ProposalID, err)
+		}
+		batch[i] = types.BatchVote{
+			ProposalId: v.ProposalID,
+			Options:    options,
+			Rationale:  v.Rationale,
+		}
+	}
+
+	return batch, nil
+}
+
+func parseVoteBatchCSV(contents string) ([]batchVote, error) {
+	r := csv.NewReader(strings.NewReader(contents))
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]batchVote, len(records))
+	for i, record := range records {
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("line %d: expected proposal-id,weighted-options[,rationale], got %d fields", i+1, len(record))
+		}
+		proposalID, err := strconv.ParseUint(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: proposal-id %s not a valid int", i+1, record[0])
+		}
+		votes[i] = batchVote{ProposalID: proposalID, Options: strings.TrimSpace(record[1])}
+		if len(record) == 3 {
+			votes[i].Rationale = record[2]
+		}
+	}
+
+	return votes, nil
+}
@@ -1,11 +1,16 @@
 package cli
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
 func TestParseSubmitProposalFlags(t *testing.T) {
@@ -67,3 +72,52 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
+
+func TestParseVoteBatchFile(t *testing.T) {
+	expected := []types.BatchVote{
+		{ProposalId: 1, Options: types.NewNonSplitVoteOption(types.OptionYes)},
+		{ProposalId: 2, Options: types.WeightedVoteOptions{
+			types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(6, 1)},
+			types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(4, 1)},
+		}, Rationale: "Mostly in favor"},
+	}
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+[
+  {"proposal_id": 1, "options": "yes"},
+  {"proposal_id": 2, "options": "yes=0.6,no=0.4", "rationale": "Mostly in favor"}
+]
+`)
+	votes, err := parseVoteBatchFile(okJSON.Name())
+	require.NoError(t, err)
+	require.Equal(t, expected, votes)
+
+	dir := t.TempDir()
+	okCSV := filepath.Join(dir, "votes.csv")
+	require.NoError(t, os.WriteFile(okCSV, []byte("# proposal-id,options,rationale\n1,yes\n2,\"yes=0.6,no=0.4\",Mostly in favor\n"), 0o600))
+	votes, err = parseVoteBatchFile(okCSV)
+	require.NoError(t, err)
+	require.Equal(t, expected, votes)
+
+	// nonexistent file
+	_, err = parseVoteBatchFile("fileDoesNotExist")
+	require.Error(t, err)
+
+	// invalid json
+	badJSON := testutil.WriteToNewTempFile(t, "bad json")
+	_, err = parseVoteBatchFile(badJSON.Name())
+	require.Error(t, err)
+
+	// invalid option
+	badOption := testutil.WriteToNewTempFile(t, `[{"proposal_id": 1, "options": "maybe"}]`)
+	_, err = parseVoteBatchFile(badOption.Name())
+	require.Error(t, err)
+
+	// invalid csv records
+	for i, content := range []string{"x,yes\n", "1\n", "1,yes,rationale,extra\n"} {
+		badCSV := filepath.Join(dir, "bad.csv")
+		require.NoError(t, os.WriteFile(badCSV, []byte(content), 0o600))
+		_, err = parseVoteBatchFile(badCSV)
+		require.Error(t, err, "test: %d", i)
+	}
+}
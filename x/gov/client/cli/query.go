@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 
 	gcutils "github.com/atomone-hub/govgen/x/gov/client/utils"
 	"github.com/atomone-hub/govgen/x/gov/types"
@@ -38,6 +39,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryProposalStatusDetail(),
+		GetCmdQueryTallyByValidator(),
+		GetCmdQueryProposersInCooldown(),
+		GetCmdQueryContentTypes(),
+		GetCmdQueryDryRunParamChange(),
+		GetCmdQuerySimulateExecution(),
 	)
 
 	return govQueryCmd
@@ -541,6 +548,295 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryProposalStatusDetail implements the command to query for the
+// projected outcome of a proposal.
+func GetCmdQueryProposalStatusDetail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status-detail [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the projected outcome of a proposal in voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the projected outcome of a proposal in voting period: the turnout,
+whether the quorum, threshold and veto are reached, the voting power needed to
+flip the outcome and the time remaining before the end of the voting period.
+
+Example:
+$ %s query gov status-detail 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalStatusDetail(
+				cmd.Context(),
+				&types.QueryProposalStatusDetailRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.StatusDetail)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTallyByValidator implements the command to query for the
+// per-validator breakdown of the tally of a proposal.
+func GetCmdQueryTallyByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-by-validator [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Get the tally of a proposal broken down by validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power of the delegations to each validator that voted on
+a proposal, split by vote option. For a proposal in voting period the breakdown
+is computed from the votes cast so far, otherwise the breakdown stored with the
+final tally is returned.
+
+Example:
+$ %[1]s query gov tally-by-validator 1
+$ %[1]s query gov tally-by-validator 1 --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TallyByValidator(
+				cmd.Context(),
+				&types.QueryTallyByValidatorRequest{ProposalId: proposalID, Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "validator tallies")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposersInCooldown implements the command to query for the
+// proposers which can't submit proposals after a vetoed proposal.
+func GetCmdQueryProposersInCooldown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposers-in-cooldown",
+		Args:  cobra.NoArgs,
+		Short: "Query the proposers in cooldown after a vetoed proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposers which can't submit proposals because one of their
+proposals was vetoed, along with their number of vetoed proposals and the end
+of their cooldown.
+
+Example:
+$ %[1]s query gov proposers-in-cooldown
+$ %[1]s query gov proposers-in-cooldown --page=2 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProposersInCooldown(
+				cmd.Context(),
+				&types.QueryProposersInCooldownRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "proposers in cooldown")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryContentTypes implements the command to query for the proposal
+// content types that can be submitted.
+func GetCmdQueryContentTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-types",
+		Args:  cobra.NoArgs,
+		Short: "Query the proposal content types that can be submitted",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposal content types allowed by the allowed_content_types deposit
+param along with the content types registered in the application and whether
+the proposal router has a handler for them.
+
+Example:
+$ %s query gov content-types
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContentTypes(cmd.Context(), &types.QueryContentTypesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDryRunParamChange implements the command to check the changes of
+// a parameter change proposal without submitting it.
+func GetCmdQueryDryRunParamChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Check the changes of a parameter change proposal without submitting it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Check the changes of a parameter change proposal the same way they are checked
+when the proposal is submitted, by executing them with the parameter change
+proposal handler: each change must be allowed by the param change policy and
+its value must be valid for its respective parameter. The proposal
+file is the one of the "tx gov submit-proposal param-change" command.
+
+Example:
+$ %s query gov dry-run-param-change <path/to/proposal.json>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposal, err := paramscutils.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DryRunParamChanges(
+				cmd.Context(),
+				&types.QueryDryRunParamChangesRequest{Changes: proposal.Changes.ToParamChanges()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateExecution implements the command to simulate the
+// execution of a proposal.
+func GetCmdQuerySimulateExecution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-execution [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of a proposal at the current height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the content of a proposal as it would be executed if the proposal
+passed, in a discarded branch of the state at the current height. Returns
+whether the execution succeeds, or its error, and the events it emits.
+
+Example:
+$ %s query gov simulate-execution 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.SimulateProposalExecution(
+				cmd.Context(),
+				&types.QuerySimulateProposalExecutionRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -563,7 +859,7 @@ $ %s query gov params
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			// Query store for all 3 params
+			// Query store for all 4 params
 			ctx := cmd.Context()
 			votingRes, err := queryClient.Params(
 				ctx,
@@ -589,10 +885,19 @@ $ %s query gov params
 				return err
 			}
 
+			paramChangeRes, err := queryClient.Params(
+				ctx,
+				&types.QueryParamsRequest{ParamsType: "param_change"},
+			)
+			if err != nil {
+				return err
+			}
+
 			params := types.NewParams(
 				votingRes.GetVotingParams(),
 				tallyRes.GetTallyParams(),
 				depositRes.GetDepositParams(),
+				paramChangeRes.GetParamChangePolicy(),
 			)
 
 			return clientCtx.PrintObjectLegacy(params)
@@ -609,7 +914,7 @@ func GetCmdQueryParam() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "param [param-type]",
 		Args:  cobra.ExactArgs(1),
-		Short: "Query the parameters (voting|tallying|deposit) of the governance process",
+		Short: "Query the parameters (voting|tallying|deposit|param_change) of the governance process",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query the all the parameters for the governance process.
 
@@ -617,8 +922,9 @@ Example:
 $ %s query gov param voting
 $ %s query gov param tallying
 $ %s query gov param deposit
+$ %s query gov param param_change
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -645,8 +951,10 @@ $ %s query gov param deposit
 				out = res.GetTallyParams()
 			case "deposit":
 				out = res.GetDepositParams()
+			case "param_change":
+				out = res.GetParamChangePolicy()
 			default:
-				return fmt.Errorf("argument must be one of (voting|tallying|deposit), was %s", args[0])
+				return fmt.Errorf("argument must be one of (voting|tallying|deposit|param_change), was %s", args[0])
 			}
 
 			return clientCtx.PrintObjectLegacy(out)
@@ -27,6 +27,7 @@ const (
 	flagDepositor    = "depositor"
 	flagStatus       = "status"
 	FlagProposal     = "proposal"
+	FlagRationale    = "rationale"
 )
 
 type proposal struct {
@@ -68,8 +69,10 @@ func NewTxCmd(propCmds []*cobra.Command) *cobra.Command {
 
 	govTxCmd.AddCommand(
 		NewCmdDeposit(),
+		NewCmdWithdrawDeposit(),
 		NewCmdVote(),
 		NewCmdWeightedVote(),
+		NewCmdVoteBatch(),
 		cmdSubmitProp,
 	)
 
@@ -189,6 +192,46 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdWithdrawDeposit implements withdrawing a deposit command.
+func NewCmdWithdrawDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-deposit [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw your deposit from a proposal in its deposit period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the whole deposit you made on a proposal which is still in its
+deposit period. The proposer's deposit can't be withdrawn. You can find the
+proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s tx gov withdraw-deposit 1 --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			msg := types.NewMsgWithdrawDeposit(clientCtx.GetFromAddress(), proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -200,7 +243,7 @@ func NewCmdVote() *cobra.Command {
 find the proposal-id by running "%s query gov proposals".
 
 Example:
-$ %s tx gov vote 1 yes --from mykey
+$ %s tx gov vote 1 yes --rationale "I support this change" --from mykey
 `,
 				version.AppName, version.AppName,
 			),
@@ -225,13 +268,19 @@ $ %s tx gov vote 1 yes --from mykey
 				return err
 			}
 
+			rationale, err := cmd.Flags().GetString(FlagRationale)
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID, byteVoteOption)
+			msg := types.NewMsgVote(from, proposalID, byteVoteOption, rationale)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagRationale, "", "Optional rationale explaining the vote")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -248,7 +297,7 @@ func NewCmdWeightedVote() *cobra.Command {
 find the proposal-id by running "%s query gov proposals".
 
 Example:
-$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+$ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --rationale "Mostly in favor" --from mykey
 `,
 				version.AppName, version.AppName,
 			),
@@ -274,8 +323,82 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 				return err
 			}
 
+			rationale, err := cmd.Flags().GetString(FlagRationale)
+			if err != nil {
+				return err
+			}
+
+			// Build vote message and run basic validation
+			msg := types.NewMsgVoteWeighted(from, proposalID, options, rationale)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRationale, "", "Optional rationale explaining the vote")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdVoteBatch implements creating a new vote batch command.
+func NewCmdVoteBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-batch [votes-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Vote for several active proposals at once",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit weighted votes for several active proposals in a single message.
+Either all the votes are cast or none is.
+
+The votes are read from a JSON file, or from a CSV file if the file has a .csv
+extension. Options follow the weighted-vote command format.
+
+Example:
+$ %s tx gov vote-batch votes.json --from mykey
+
+Where votes.json contains:
+
+[
+  {
+    "proposal_id": 1,
+    "options": "yes"
+  },
+  {
+    "proposal_id": 2,
+    "options": "yes=0.6,no=0.4",
+    "rationale": "Mostly in favor"
+  }
+]
+
+Or, in CSV format (proposal-id,weighted-options[,rationale]):
+
+1,yes
+2,"yes=0.6,no=0.4",Mostly in favor
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Get voter address
+			from := clientCtx.GetFromAddress()
+
+			votes, err := parseVoteBatchFile(args[0])
+			if err != nil {
+				return err
+			}
+
 			// Build vote message and run basic validation
-			msg := types.NewMsgVoteWeighted(from, proposalID, options)
+			msg := types.NewMsgVoteBatch(from, votes)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
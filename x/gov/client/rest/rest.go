@@ -53,7 +53,8 @@ type DepositReq struct {
 
 // VoteReq defines the properties of a vote request's body.
 type VoteReq struct {
-	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
-	Voter   sdk.AccAddress `json:"voter" yaml:"voter"`   // address of the voter
-	Option  string         `json:"option" yaml:"option"` // option from OptionSet chosen by the voter
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Voter     sdk.AccAddress `json:"voter" yaml:"voter"`         // address of the voter
+	Option    string         `json:"option" yaml:"option"`       // option from OptionSet chosen by the voter
+	Rationale string         `json:"rationale" yaml:"rationale"` // optional rationale for the vote
 }
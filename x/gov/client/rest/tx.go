@@ -118,7 +118,7 @@ func newVoteHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		}
 
 		// create the message
-		msg := types.NewMsgVote(req.Voter, proposalID, voteOption)
+		msg := types.NewMsgVote(req.Voter, proposalID, voteOption, req.Rationale)
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
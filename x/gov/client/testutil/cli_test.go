@@ -22,7 +22,7 @@ func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
 	genesisState := types.DefaultGenesisState()
-	genesisState.DepositParams = types.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
+	genesisState.DepositParams = types.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, types.DefaultMinDepositTokens)), time.Duration(15)*time.Second, nil, 0, 0, sdk.ZeroDec(), sdk.ZeroDec())
 	genesisState.VotingParams = types.NewVotingParams(time.Duration(5) * time.Second)
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
@@ -135,6 +135,7 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 						Voter:      voteMsg.Voter,
 						ProposalId: params.ProposalID,
 						Options:    types.NewNonSplitVoteOption(voteMsg.Option),
+						Rationale:  voteMsg.Rationale,
 					})
 				}
 
@@ -143,6 +144,7 @@ func QueryVotesByTxQuery(clientCtx client.Context, params types.QueryProposalVot
 						Voter:      voteWeightedMsg.Voter,
 						ProposalId: params.ProposalID,
 						Options:    voteWeightedMsg.Options,
+						Rationale:  voteWeightedMsg.Rationale,
 					})
 				}
 			}
@@ -210,6 +212,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 					Voter:      voteMsg.Voter,
 					ProposalId: params.ProposalID,
 					Options:    types.NewNonSplitVoteOption(voteMsg.Option),
+					Rationale:  voteMsg.Rationale,
 				}
 			}
 
@@ -218,6 +221,7 @@ func QueryVoteByTxQuery(clientCtx client.Context, params types.QueryVoteParams)
 					Voter:      voteWeightedMsg.Voter,
 					ProposalId: params.ProposalID,
 					Options:    voteWeightedMsg.Options,
+					Rationale:  voteWeightedMsg.Rationale,
 				}
 			}
 
@@ -89,12 +89,12 @@ func TestGetPaginatedVotes(t *testing.T) {
 	acc2 := make(sdk.AccAddress, 20)
 	acc2[0] = 2
 	acc1Msgs := []sdk.Msg{
-		types.NewMsgVote(acc1, 0, types.OptionYes),
-		types.NewMsgVote(acc1, 0, types.OptionYes),
+		types.NewMsgVote(acc1, 0, types.OptionYes, ""),
+		types.NewMsgVote(acc1, 0, types.OptionYes, ""),
 	}
 	acc2Msgs := []sdk.Msg{
-		types.NewMsgVote(acc2, 0, types.OptionYes),
-		types.NewMsgVoteWeighted(acc2, 0, types.NewNonSplitVoteOption(types.OptionYes)),
+		types.NewMsgVote(acc2, 0, types.OptionYes, ""),
+		types.NewMsgVoteWeighted(acc2, 0, types.NewNonSplitVoteOption(types.OptionYes), "rationale"),
 	}
 	for _, tc := range []testCase{
 		{
@@ -106,8 +106,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs[:1],
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
-				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes), ""),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes), ""),
 			},
 		},
 		{
@@ -119,8 +119,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
-				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes), ""),
+				types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes), ""),
 			},
 		},
 		{
@@ -132,8 +132,8 @@ func TestGetPaginatedVotes(t *testing.T) {
 				acc2Msgs,
 			},
 			votes: []types.Vote{
-				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
-				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes)),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes), ""),
+				types.NewVote(0, acc2, types.NewNonSplitVoteOption(types.OptionYes), "rationale"),
 			},
 		},
 		{
@@ -143,7 +143,7 @@ func TestGetPaginatedVotes(t *testing.T) {
 			msgs: [][]sdk.Msg{
 				acc1Msgs[:1],
 			},
-			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes))},
+			votes: []types.Vote{types.NewVote(0, acc1, types.NewNonSplitVoteOption(types.OptionYes), "")},
 		},
 		{
 			description: "InvalidPage",
@@ -15,6 +15,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetDepositParams(ctx, data.DepositParams)
 	k.SetVotingParams(ctx, data.VotingParams)
 	k.SetTallyParams(ctx, data.TallyParams)
+	k.SetParamChangePolicy(ctx, data.ParamChangePolicy)
 
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
@@ -36,12 +37,26 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		switch proposal.Status {
 		case types.StatusDepositPeriod:
 			k.InsertInactiveProposalQueue(ctx, proposal.ProposalId, proposal.DepositEndTime)
+			k.IncreaseProposerOpenProposals(ctx, proposal)
 		case types.StatusVotingPeriod:
 			k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+			k.IncreaseProposerOpenProposals(ctx, proposal)
 		}
 		k.SetProposal(ctx, proposal)
 	}
 
+	for _, validatorTally := range data.ValidatorTallies {
+		k.SetValidatorTally(ctx, validatorTally)
+	}
+
+	for _, sponsorship := range data.VoteFeeSponsorships {
+		k.SetVoteFeeSponsorship(ctx, sponsorship)
+	}
+
+	for _, record := range data.ProposerVetoRecords {
+		k.SetProposerVetoRecord(ctx, record)
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -60,25 +75,35 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	depositParams := k.GetDepositParams(ctx)
 	votingParams := k.GetVotingParams(ctx)
 	tallyParams := k.GetTallyParams(ctx)
+	paramChangePolicy := k.GetParamChangePolicy(ctx)
 	proposals := k.GetProposals(ctx)
 
 	var proposalsDeposits types.Deposits
 	var proposalsVotes types.Votes
+	var validatorTallies types.ValidatorTallies
+	var voteFeeSponsorships types.VoteFeeSponsorships
 	for _, proposal := range proposals {
 		deposits := k.GetDeposits(ctx, proposal.ProposalId)
 		proposalsDeposits = append(proposalsDeposits, deposits...)
 
 		votes := k.GetVotes(ctx, proposal.ProposalId)
 		proposalsVotes = append(proposalsVotes, votes...)
+
+		validatorTallies = append(validatorTallies, k.GetValidatorTallies(ctx, proposal.ProposalId)...)
+		voteFeeSponsorships = append(voteFeeSponsorships, k.GetVoteFeeSponsorships(ctx, proposal.ProposalId)...)
 	}
 
 	return &types.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		DepositParams:      depositParams,
-		VotingParams:       votingParams,
-		TallyParams:        tallyParams,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		DepositParams:       depositParams,
+		VotingParams:        votingParams,
+		TallyParams:         tallyParams,
+		ValidatorTallies:    validatorTallies,
+		ParamChangePolicy:   paramChangePolicy,
+		VoteFeeSponsorships: voteFeeSponsorships,
+		ProposerVetoRecords: k.GetProposerVetoRecords(ctx),
 	}
 }
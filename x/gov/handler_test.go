@@ -3,15 +3,19 @@ package gov_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
 	"github.com/atomone-hub/govgen/x/gov"
 	"github.com/atomone-hub/govgen/x/gov/keeper"
+	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
 func TestInvalidMsg(t *testing.T) {
@@ -23,3 +27,77 @@ func TestInvalidMsg(t *testing.T) {
 	require.Nil(t, res)
 	require.True(t, strings.Contains(err.Error(), "unrecognized gov message type"))
 }
+
+func TestHandleMsgVoteBatch(t *testing.T) {
+	app := govgenhelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := govgenhelpers.AddTestAddrs(app, ctx, 1, sdk.NewInt(10000000))
+	h := gov.NewHandler(app.GovKeeper)
+
+	var proposalIDs []uint64
+	for i := 0; i < 2; i++ {
+		proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+		require.NoError(t, err)
+		proposal.Status = types.StatusVotingPeriod
+		app.GovKeeper.SetProposal(ctx, proposal)
+		proposalIDs = append(proposalIDs, proposal.ProposalId)
+	}
+
+	// a vote on an unknown proposal fails the whole batch
+	cacheCtx, _ := ctx.CacheContext()
+	msg := types.NewMsgVoteBatch(addrs[0], []types.BatchVote{
+		{ProposalId: proposalIDs[0], Options: types.NewNonSplitVoteOption(types.OptionYes)},
+		{ProposalId: 100, Options: types.NewNonSplitVoteOption(types.OptionYes)},
+	})
+	_, err := h(cacheCtx, msg)
+	require.ErrorIs(t, err, types.ErrUnknownProposal)
+
+	msg = types.NewMsgVoteBatch(addrs[0], []types.BatchVote{
+		{ProposalId: proposalIDs[0], Options: types.NewNonSplitVoteOption(types.OptionYes)},
+		{ProposalId: proposalIDs[1], Options: types.NewNonSplitVoteOption(types.OptionNo), Rationale: "no"},
+	})
+	res, err := h(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	vote, found := app.GovKeeper.GetVote(ctx, proposalIDs[0], addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionYes), types.WeightedVoteOptions(vote.Options))
+	vote, found = app.GovKeeper.GetVote(ctx, proposalIDs[1], addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewNonSplitVoteOption(types.OptionNo), types.WeightedVoteOptions(vote.Options))
+	require.Equal(t, "no", vote.Rationale)
+}
+
+func TestHandleMsgUpdateParams(t *testing.T) {
+	app := govgenhelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	h := gov.NewHandler(app.GovKeeper)
+	authority, err := sdk.AccAddressFromBech32(app.GovKeeper.GetAuthority())
+	require.NoError(t, err)
+
+	depositParams := types.NewDepositParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 42)), time.Hour, nil, 0, 0, sdk.ZeroDec(), sdk.ZeroDec())
+	votingParams := types.DefaultVotingParams()
+	votingParams.MaxVoteRationaleLength = 42
+	tallyParams := types.NewTallyParams(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(4, 1))
+
+	// only the gov module account is allowed
+	msg := types.NewMsgUpdateParams(sdk.AccAddress("other"), depositParams, votingParams, tallyParams)
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// params are validated together
+	vetoAboveThreshold := types.NewTallyParams(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(7, 1))
+	msg = types.NewMsgUpdateParams(authority, depositParams, votingParams, vetoAboveThreshold)
+	_, err = h(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	msg = types.NewMsgUpdateParams(authority, depositParams, votingParams, tallyParams)
+	res, err := h(ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	require.Equal(t, depositParams, app.GovKeeper.GetDepositParams(ctx))
+	require.Equal(t, votingParams, app.GovKeeper.GetVotingParams(ctx))
+	require.Equal(t, tallyParams, app.GovKeeper.GetTallyParams(ctx))
+}
@@ -51,7 +51,10 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID uint64) (deposits t
 	return
 }
 
-// DeleteDeposits deletes all the deposits on a specific proposal without refunding them
+// DeleteDeposits deletes all the deposits on a specific proposal without refunding them.
+// Like for slashed delegations, the burned deposits remain tracked as delegated
+// by the vesting accounts, so that the locked coins they were drawn from are
+// not unlocked again.
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
 
@@ -68,6 +71,42 @@ func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// SettleInactiveProposalDeposits deletes all the deposits on a specific
+// proposal which failed to reach the minimum deposit, refunding the
+// InactiveProposalRefundRatio of each deposit to its depositor and burning
+// the rest.
+func (keeper Keeper) SettleInactiveProposalDeposits(ctx sdk.Context, proposalID uint64) types.DepositsOutcome {
+	store := ctx.KVStore(keeper.storeKey)
+	depositParams := keeper.GetDepositParams(ctx)
+	outcome := types.DepositsOutcome{Refunded: sdk.NewCoins(), Burned: sdk.NewCoins()}
+
+	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+		refunded, burned := depositParams.SplitInactiveProposalDeposit(deposit.Amount)
+
+		if !refunded.IsZero() {
+			err := keeper.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refunded)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if !burned.IsZero() {
+			err := keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burned)
+			if err != nil {
+				panic(err)
+			}
+		}
+
+		outcome.Refunded = outcome.Refunded.Add(refunded...)
+		outcome.Burned = outcome.Burned.Add(burned...)
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+
+	return outcome
+}
+
 // IterateAllDeposits iterates over the all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -118,8 +157,10 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		return false, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
 	}
 
-	// update the governance module's account coins pool
-	err := keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
+	// update the governance module's account coins pool, the deposit is
+	// tracked like a delegation so that vesting accounts can deposit their
+	// locked coins
+	err := keeper.bankKeeper.DelegateCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, depositAmount)
 	if err != nil {
 		return false, err
 	}
@@ -131,7 +172,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(keeper.GetProposalMinDeposit(ctx, proposal)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -162,6 +203,57 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// WithdrawDeposit refunds and deletes the deposit of a specific depositor on a
+// specific proposal, which must still be in its deposit period, and deducts it
+// from the total deposit of the proposal. It returns the refunded amount.
+// The deposit of the proposer, which includes the initial deposit, is locked
+// until the end of the deposit period, so that proposals can't be kept open
+// without the minimum initial deposit.
+func (keeper Keeper) WithdrawDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) (sdk.Coins, error) {
+	// Checks to see if proposal exists
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// Deposits can only be withdrawn before the voting period starts
+	if proposal.Status != types.StatusDepositPeriod {
+		return nil, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d: not in deposit period", proposalID)
+	}
+
+	if proposal.Proposer == depositorAddr.String() {
+		return nil, sdkerrors.Wrapf(types.ErrProposerDepositLocked, "proposer %s of proposal %d", depositorAddr, proposalID)
+	}
+
+	deposit, found := keeper.GetDeposit(ctx, proposalID, depositorAddr)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownDeposit, "depositor %s on proposal %d", depositorAddr, proposalID)
+	}
+
+	err := keeper.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, depositorAddr, deposit.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update proposal
+	proposal.TotalDeposit = proposal.TotalDeposit.Sub(deposit.Amount)
+	keeper.SetProposal(ctx, proposal)
+
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.DepositKey(proposalID, depositorAddr))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+		),
+	)
+
+	return deposit.Amount, nil
+}
+
 // RefundDeposits refunds and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -169,7 +261,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID uint64) {
 	keeper.IterateDeposits(ctx, proposalID, func(deposit types.Deposit) bool {
 		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
 
-		err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+		err := keeper.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
 		if err != nil {
 			panic(err)
 		}
@@ -8,8 +8,13 @@ import (
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
 	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	"github.com/atomone-hub/govgen/x/gov/keeper"
+	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -109,3 +114,147 @@ func TestDeposits(t *testing.T) {
 	deposits = app.GovKeeper.GetDeposits(ctx, proposalID)
 	require.Len(t, deposits, 0)
 }
+
+func TestWithdrawDeposit(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := govgenhelpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000000))
+
+	tp := govgenhelpers.TestTextProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	fiveStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5)))
+	invariant := keeper.ModuleAccountInvariant(app.GovKeeper, app.BankKeeper)
+
+	addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+
+	// Unknown proposal and unknown deposit
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposalID+1, TestAddrs[0])
+	require.ErrorIs(t, err, types.ErrUnknownProposal)
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[0])
+	require.ErrorIs(t, err, types.ErrUnknownDeposit)
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	require.NoError(t, err)
+
+	// Withdraw the whole deposit of the first depositor
+	amount, err := app.GovKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, fourStake, amount)
+	_, found := app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
+	require.False(t, found)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourStake, proposal.TotalDeposit)
+	require.Equal(t, types.StatusDepositPeriod, proposal.Status)
+	require.Equal(t, addr0Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// A withdrawn deposit cannot be withdrawn twice
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[0])
+	require.ErrorIs(t, err, types.ErrUnknownDeposit)
+
+	// Deposits cannot be withdrawn once the voting period started
+	votingStarted, err := app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[2], fourStake.Add(fiveStake...))
+	require.NoError(t, err)
+	require.True(t, votingStarted)
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposalID, TestAddrs[1])
+	require.ErrorIs(t, err, types.ErrInactiveProposal)
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourStake.Add(fourStake...).Add(fiveStake...), proposal.TotalDeposit)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestWithdrawProposerDeposit(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	TestAddrs := govgenhelpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+	msgServer := keeper.NewMsgServerImpl(app.GovKeeper)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	msg, err := types.NewMsgSubmitProposal(govgenhelpers.TestTextProposal, fourStake, TestAddrs[0])
+	require.NoError(t, err)
+	res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	proposalID := res.ProposalId
+
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[1], fourStake)
+	require.NoError(t, err)
+
+	// The proposer can't withdraw the initial deposit right after submission
+	_, err = msgServer.WithdrawDeposit(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawDeposit(TestAddrs[0], proposalID))
+	require.ErrorIs(t, err, types.ErrProposerDepositLocked)
+	deposit, found := app.GovKeeper.GetDeposit(ctx, proposalID, TestAddrs[0])
+	require.True(t, found)
+	require.Equal(t, fourStake, deposit.Amount)
+	require.Equal(t, uint64(1), app.GovKeeper.GetProposerOpenProposals(ctx, TestAddrs[0]))
+
+	// The other depositors still can
+	_, err = msgServer.WithdrawDeposit(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawDeposit(TestAddrs[1], proposalID))
+	require.NoError(t, err)
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.Equal(t, fourStake, proposal.TotalDeposit)
+}
+
+func TestVestingAccountDeposits(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+
+	lockedCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+
+	addr := sdk.AccAddress("vesting_depositor___")
+	vacc := vestingtypes.NewContinuousVestingAccount(
+		authtypes.NewBaseAccountWithAddress(addr), lockedCoins,
+		ctx.BlockTime().Unix(), ctx.BlockTime().Add(365*24*time.Hour).Unix(),
+	)
+	app.AccountKeeper.SetAccount(ctx, vacc)
+	require.NoError(t, govgenhelpers.FundAccount(app.BankKeeper, ctx, addr, lockedCoins))
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, addr).IsZero())
+
+	delegatedVesting := func() sdk.Coins {
+		return app.AccountKeeper.GetAccount(ctx, addr).(vestingexported.VestingAccount).GetDelegatedVesting()
+	}
+	invariant := keeper.ModuleAccountInvariant(app.GovKeeper, app.BankKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+
+	// Locked coins can be deposited and are tracked as delegated vesting coins
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addr, fourStake)
+	require.NoError(t, err)
+	require.Equal(t, lockedCoins.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, fourStake, delegatedVesting())
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, addr).IsZero())
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// Refunded deposits return to the locked coins
+	_, err = app.GovKeeper.WithdrawDeposit(ctx, proposalID, addr)
+	require.NoError(t, err)
+	require.Equal(t, lockedCoins, app.BankKeeper.GetAllBalances(ctx, addr))
+	require.True(t, delegatedVesting().IsZero())
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, addr).IsZero())
+
+	// Burned deposits remain tracked, so that no locked coin is unlocked
+	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, addr, fourStake)
+	require.NoError(t, err)
+	app.GovKeeper.DeleteDeposits(ctx, proposalID)
+	require.Equal(t, lockedCoins.Sub(fourStake), app.BankKeeper.GetAllBalances(ctx, addr))
+	require.Equal(t, fourStake, delegatedVesting())
+	require.True(t, app.BankKeeper.SpendableCoins(ctx, addr).IsZero())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
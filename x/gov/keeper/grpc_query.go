@@ -2,13 +2,18 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/atomone-hub/govgen/x/gov/types"
 )
@@ -175,6 +180,10 @@ func (q Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 		tallyParams := q.GetTallyParams(ctx)
 		return &types.QueryParamsResponse{TallyParams: tallyParams}, nil
 
+	case types.ParamParamChange:
+		paramChangePolicy := q.GetParamChangePolicy(ctx)
+		return &types.QueryParamsResponse{ParamChangePolicy: paramChangePolicy}, nil
+
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"%s is not a valid parameter type", req.ParamsType)
@@ -270,8 +279,253 @@ func (q Keeper) TallyResult(c context.Context, req *types.QueryTallyResultReques
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = q.Tally(ctx, proposal)
+		_, _, _, tallyResult = q.Tally(ctx, proposal)
 	}
 
 	return &types.QueryTallyResultResponse{Tally: tallyResult}, nil
 }
+
+// ContentTypes queries the proposal content types allowed by the params along
+// with the content types registered in the application
+func (q Keeper) ContentTypes(c context.Context, req *types.QueryContentTypesRequest) (*types.QueryContentTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	depositParams := q.GetDepositParams(ctx)
+
+	res := &types.QueryContentTypesResponse{
+		AllowedContentTypes: depositParams.AllowedContentTypes,
+		Routes:              q.router.Routes(),
+	}
+
+	// the content types can only be listed from the interface registry of a
+	// proto codec
+	protoCodec, ok := q.cdc.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return res, nil
+	}
+	registry := protoCodec.InterfaceRegistry()
+	typeURLs := registry.ListImplementations("govgen.gov.v1beta1.Content")
+	sort.Strings(typeURLs)
+	for _, typeURL := range typeURLs {
+		msg, err := registry.Resolve(typeURL)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		content, ok := msg.(types.Content)
+		if !ok {
+			continue
+		}
+
+		res.ContentTypes = append(res.ContentTypes, types.ContentTypeInfo{
+			TypeUrl:       typeURL,
+			ProposalRoute: content.ProposalRoute(),
+			Routed:        q.router.HasRoute(content.ProposalRoute()),
+			Allowed:       depositParams.IsContentTypeAllowed(typeURL),
+		})
+	}
+
+	return res, nil
+}
+
+// DryRunParamChanges checks parameter changes the same way they are checked
+// when a parameter change proposal is submitted, by executing them with the
+// parameter change proposal handler, in order, in a discarded branch of the
+// state
+func (q Keeper) DryRunParamChanges(c context.Context, req *types.QueryDryRunParamChangesRequest) (*types.QueryDryRunParamChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Changes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no parameter changes")
+	}
+
+	if !q.router.HasRoute(paramsproposal.RouterKey) {
+		return nil, status.Error(codes.FailedPrecondition, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, paramsproposal.RouterKey).Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// each change is executed on its own so that its error is reported, the
+	// valid changes are kept for the next ones
+	cacheCtx, _ := ctx.CacheContext()
+	res := &types.QueryDryRunParamChangesResponse{Valid: true}
+	for _, change := range req.Changes {
+		result := types.ParamChangeResult{
+			Subspace: change.Subspace,
+			Key:      change.Key,
+		}
+		changeCtx, writeChange := cacheCtx.CacheContext()
+		content := paramsproposal.NewParameterChangeProposal("", "", []paramsproposal.ParamChange{change})
+		if err := q.executeContent(changeCtx, content); err != nil {
+			result.Error = err.Error()
+			res.Valid = false
+		} else {
+			writeChange()
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	return res, nil
+}
+
+// SimulateProposalExecution executes the content of a proposal in a discarded
+// branch of the state at the current height
+func (q Keeper) SimulateProposalExecution(c context.Context, req *types.QuerySimulateProposalExecutionRequest) (res *types.QuerySimulateProposalExecutionResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if !q.router.HasRoute(proposal.ProposalRoute()) {
+		return &types.QuerySimulateProposalExecutionResponse{
+			Error: sdkerrors.Wrap(types.ErrNoProposalHandlerExists, proposal.ProposalRoute()).Error(),
+		}, nil
+	}
+
+	// a panicking handler would halt the chain in EndBlocker, report it as an
+	// execution error
+	defer func() {
+		if r := recover(); r != nil {
+			res = &types.QuerySimulateProposalExecutionResponse{Error: fmt.Sprintf("panic: %v", r)}
+			err = nil
+		}
+	}()
+
+	// the branch of the store is discarded as writeCache is never called
+	cacheCtx, _, execErr := q.ExecuteProposal(ctx, proposal)
+	if execErr != nil {
+		return &types.QuerySimulateProposalExecutionResponse{Error: execErr.Error()}, nil
+	}
+
+	res = &types.QuerySimulateProposalExecutionResponse{Success: true}
+	for _, event := range cacheCtx.EventManager().Events() {
+		executionEvent := types.ExecutionEvent{Type: event.Type}
+		for _, attr := range event.Attributes {
+			executionEvent.Attributes = append(executionEvent.Attributes,
+				types.ExecutionEventAttribute{Key: string(attr.Key), Value: string(attr.Value)})
+		}
+		res.Events = append(res.Events, executionEvent)
+	}
+
+	return res, nil
+}
+
+// TallyByValidator queries the per-validator breakdown of the tally of a proposal
+func (q Keeper) TallyByValidator(c context.Context, req *types.QueryTallyByValidatorRequest) (*types.QueryTallyByValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status == types.StatusVotingPeriod {
+		// compute the breakdown from the votes cast so far and paginate it
+		// from a discarded cache store, like the stored one
+		cacheCtx, _ := ctx.CacheContext()
+		_, _, validatorTallies := q.tallyVotes(cacheCtx, proposal, false)
+		for _, validatorTally := range validatorTallies {
+			q.SetValidatorTally(cacheCtx, validatorTally)
+		}
+		ctx = cacheCtx
+	}
+
+	var validatorTallies types.ValidatorTallies
+	store := ctx.KVStore(q.storeKey)
+	validatorTallyStore := prefix.NewStore(store, types.ValidatorTalliesKey(req.ProposalId))
+
+	pageRes, err := query.Paginate(validatorTallyStore, req.Pagination, func(key []byte, value []byte) error {
+		var validatorTally types.ValidatorTally
+		if err := q.cdc.Unmarshal(value, &validatorTally); err != nil {
+			return err
+		}
+
+		validatorTallies = append(validatorTallies, validatorTally)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTallyByValidatorResponse{ValidatorTallies: validatorTallies, Pagination: pageRes}, nil
+}
+
+// ProposalStatusDetail queries the projected outcome of a proposal in voting period
+func (q Keeper) ProposalStatusDetail(c context.Context, req *types.QueryProposalStatusDetailRequest) (*types.QueryProposalStatusDetailResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, status.Errorf(codes.FailedPrecondition, "proposal %d is not in voting period", req.ProposalId)
+	}
+
+	return &types.QueryProposalStatusDetailResponse{StatusDetail: q.GetProposalStatusDetail(ctx, proposal)}, nil
+}
+
+// ProposersInCooldown queries the proposers which can't submit proposals
+// because one of their proposals was vetoed
+func (q Keeper) ProposersInCooldown(c context.Context, req *types.QueryProposersInCooldownRequest) (*types.QueryProposersInCooldownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var records types.ProposerVetoRecords
+	store := ctx.KVStore(q.storeKey)
+	recordStore := prefix.NewStore(store, types.ProposerVetoRecordsKeyPrefix)
+
+	pageRes, err := query.FilteredPaginate(recordStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var record types.ProposerVetoRecord
+		if err := q.cdc.Unmarshal(value, &record); err != nil {
+			return false, err
+		}
+
+		if !record.InCooldown(ctx.BlockHeader().Time) {
+			return false, nil
+		}
+		if accumulate {
+			records = append(records, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProposersInCooldownResponse{VetoRecords: records, Pagination: pageRes}, nil
+}
@@ -7,6 +7,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
 	"github.com/atomone-hub/govgen/x/gov/types"
@@ -184,7 +186,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 			func() {
 				testProposals[1].Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, testProposals[1])
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, testProposals[1].ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryProposalsRequest{
 					Voter: addrs[0].String(),
@@ -292,7 +294,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			func() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 
 				req = &types.QueryVoteRequest{
 					ProposalId: proposal.ProposalId,
@@ -403,8 +405,8 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 				accAddr2, err2 := sdk.AccAddressFromBech32(votes[1].Voter)
 				suite.Require().NoError(err1)
 				suite.Require().NoError(err2)
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr1, votes[0].Options, ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, accAddr2, votes[1].Options, ""))
 
 				req = &types.QueryVotesRequest{
 					ProposalId: proposal.ProposalId,
@@ -435,6 +437,116 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryContentTypes() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	res, err := queryClient.ContentTypes(gocontext.Background(), &types.QueryContentTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.AllowedContentTypes)
+	suite.Require().Equal([]string{"gov", "params", "upgrade"}, res.Routes)
+	suite.Require().Equal([]types.ContentTypeInfo{
+		{TypeUrl: "/cosmos.params.v1beta1.ParameterChangeProposal", ProposalRoute: "params", Routed: true, Allowed: true},
+		{TypeUrl: "/cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal", ProposalRoute: "upgrade", Routed: true, Allowed: true},
+		{TypeUrl: "/cosmos.upgrade.v1beta1.SoftwareUpgradeProposal", ProposalRoute: "upgrade", Routed: true, Allowed: true},
+		{TypeUrl: "/govgen.gov.v1beta1.FundVoteFeePoolProposal", ProposalRoute: "gov", Routed: true, Allowed: true},
+		{TypeUrl: "/govgen.gov.v1beta1.TextProposal", ProposalRoute: "gov", Routed: true, Allowed: true},
+		{TypeUrl: "/govgen.gov.v1beta1.UpdateParamsProposal", ProposalRoute: "gov", Routed: true, Allowed: true},
+	}, res.ContentTypes)
+
+	depositParams := app.GovKeeper.GetDepositParams(ctx)
+	depositParams.AllowedContentTypes = []string{"/govgen.gov.v1beta1.TextProposal"}
+	app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+	res, err = queryClient.ContentTypes(gocontext.Background(), &types.QueryContentTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(depositParams.AllowedContentTypes, res.AllowedContentTypes)
+	for _, contentType := range res.ContentTypes {
+		suite.Require().Equal(contentType.TypeUrl == "/govgen.gov.v1beta1.TextProposal", contentType.Allowed, contentType.TypeUrl)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryDryRunParamChanges() {
+	queryClient := suite.queryClient
+
+	_, err := queryClient.DryRunParamChanges(gocontext.Background(), &types.QueryDryRunParamChangesRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DryRunParamChanges(gocontext.Background(), &types.QueryDryRunParamChangesRequest{
+		Changes: []paramsproposal.ParamChange{
+			paramsproposal.NewParamChange("staking", "MaxValidators", "105"),
+			paramsproposal.NewParamChange("staking", "BondDenom", `"foo"`),
+			paramsproposal.NewParamChange("staking", "MaxEntries", "0"),
+			paramsproposal.NewParamChange("staking", "Foo", "1"),
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Valid)
+	suite.Require().Len(res.Results, 4)
+	suite.Require().Empty(res.Results[0].Error)
+	suite.Require().Contains(res.Results[1].Error, types.ErrParamChangeNotAllowed.Error())
+	suite.Require().Contains(res.Results[2].Error, paramsproposal.ErrSettingParameter.Error())
+	suite.Require().Contains(res.Results[3].Error, "parameter Foo not registered")
+
+	res, err = queryClient.DryRunParamChanges(gocontext.Background(), &types.QueryDryRunParamChangesRequest{
+		Changes: []paramsproposal.ParamChange{paramsproposal.NewParamChange("staking", "MaxValidators", "105")},
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Valid)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposalExecution() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	_, err := queryClient.SimulateProposalExecution(gocontext.Background(), &types.QuerySimulateProposalExecutionRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.SimulateProposalExecution(gocontext.Background(), &types.QuerySimulateProposalExecutionRequest{ProposalId: 1})
+	suite.Require().Error(err)
+
+	testCases := []struct {
+		msg        string
+		content    types.Content
+		expSuccess bool
+	}{
+		{
+			"text proposal",
+			govgenhelpers.TestTextProposal,
+			true,
+		},
+		{
+			"param change proposal",
+			paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+				paramsproposal.NewParamChange("staking", "MaxValidators", "105"),
+			}),
+			true,
+		},
+		{
+			"software upgrade proposal without plan name",
+			upgradetypes.NewSoftwareUpgradeProposal("title", "description", upgradetypes.Plan{Height: 100}),
+			false,
+		},
+	}
+
+	for i, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			proposalID := uint64(i + 1)
+			proposal, err := types.NewProposal(testCase.content, proposalID, ctx.BlockTime(), ctx.BlockTime())
+			suite.Require().NoError(err)
+			proposal.Status = types.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			res, err := queryClient.SimulateProposalExecution(gocontext.Background(),
+				&types.QuerySimulateProposalExecutionRequest{ProposalId: proposalID})
+			suite.Require().NoError(err)
+			suite.Require().Equal(testCase.expSuccess, res.Success)
+			suite.Require().Equal(testCase.expSuccess, res.Error == "")
+		})
+	}
+
+	// the execution is discarded
+	suite.Require().Equal(uint32(100), app.StakingKeeper.MaxValidators(ctx))
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 	queryClient := suite.queryClient
 
@@ -461,6 +573,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamDeposit}
 				expRes = &types.QueryParamsResponse{
 					DepositParams: types.DefaultDepositParams(),
+					VotingParams:  types.VotingParams{VoteFeeSponsorshipMinBonded: sdk.NewInt(0)},
 					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
 				}
 			},
@@ -471,8 +584,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamVoting}
 				expRes = &types.QueryParamsResponse{
-					VotingParams: types.DefaultVotingParams(),
-					TallyParams:  types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					VotingParams:  types.DefaultVotingParams(),
+					TallyParams:   types.NewTallyParams(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0)),
+					DepositParams: types.DepositParams{VetoMinDepositMultiplier: sdk.NewDec(0), InactiveProposalRefundRatio: sdk.NewDec(0)},
 				}
 			},
 			true,
@@ -482,7 +596,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryParams() {
 			func() {
 				req = &types.QueryParamsRequest{ParamsType: types.ParamTallying}
 				expRes = &types.QueryParamsResponse{
-					TallyParams: types.DefaultTallyParams(),
+					TallyParams:   types.DefaultTallyParams(),
+					DepositParams: types.DepositParams{VetoMinDepositMultiplier: sdk.NewDec(0), InactiveProposalRefundRatio: sdk.NewDec(0)},
+					VotingParams:  types.VotingParams{VoteFeeSponsorshipMinBonded: sdk.NewInt(0)},
 				}
 			},
 			true,
@@ -770,9 +886,9 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 				proposal.Status = types.StatusVotingPeriod
 				app.GovKeeper.SetProposal(ctx, proposal)
 
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 				req = &types.QueryTallyResultRequest{ProposalId: proposal.ProposalId}
 
@@ -817,3 +933,185 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryTallyByValidator() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs, _ := createValidators(suite.T(), ctx, app, []int64{5, 5, 5})
+
+	var (
+		req      *types.QueryTallyByValidatorRequest
+		expRes   *types.QueryTallyByValidatorResponse
+		proposal types.Proposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryTallyByValidatorRequest{}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryTallyByValidatorRequest{ProposalId: 1}
+			},
+			false,
+		},
+		{
+			"query proposal in deposit period",
+			func() {
+				var err error
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+				suite.Require().NoError(err)
+
+				req = &types.QueryTallyByValidatorRequest{ProposalId: proposal.ProposalId}
+				expRes = &types.QueryTallyByValidatorResponse{}
+			},
+			true,
+		},
+		{
+			"query proposal in voting period with pagination",
+			func() {
+				proposal.Status = types.StatusVotingPeriod
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+
+				req = &types.QueryTallyByValidatorRequest{
+					ProposalId: proposal.ProposalId,
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+				expRes = nil
+			},
+			true,
+		},
+		{
+			"query tallied proposal",
+			func() {
+				_, _, _, _ = app.GovKeeper.Tally(ctx, proposal)
+				proposal.Status = types.StatusPassed
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				req = &types.QueryTallyByValidatorRequest{ProposalId: proposal.ProposalId}
+				expRes = &types.QueryTallyByValidatorResponse{
+					ValidatorTallies: app.GovKeeper.GetValidatorTallies(ctx, proposal.ProposalId),
+				}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.TallyByValidator(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				if expRes != nil {
+					suite.Require().Equal(expRes.ValidatorTallies, res.ValidatorTallies)
+					return
+				}
+				suite.Require().Len(res.ValidatorTallies, 1)
+				suite.Require().Equal(uint64(2), res.Pagination.Total)
+				suite.Require().Equal(sdk.NewInt(5*1000000), res.ValidatorTallies[0].VotedPower)
+				// the query doesn't store the breakdown nor remove votes
+				suite.Require().Empty(app.GovKeeper.GetValidatorTallies(ctx, proposal.ProposalId))
+				suite.Require().Len(app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 2)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalStatusDetail() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	addrs, _ := createValidators(suite.T(), ctx, app, []int64{5, 5, 5})
+
+	var (
+		req      *types.QueryProposalStatusDetailRequest
+		proposal types.Proposal
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = &types.QueryProposalStatusDetailRequest{}
+			},
+			false,
+		},
+		{
+			"zero proposal id request",
+			func() {
+				req = &types.QueryProposalStatusDetailRequest{ProposalId: 0}
+			},
+			false,
+		},
+		{
+			"query non existed proposal",
+			func() {
+				req = &types.QueryProposalStatusDetailRequest{ProposalId: 1}
+			},
+			false,
+		},
+		{
+			"query proposal in deposit period",
+			func() {
+				var err error
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+				suite.Require().NoError(err)
+
+				req = &types.QueryProposalStatusDetailRequest{ProposalId: proposal.ProposalId}
+			},
+			false,
+		},
+		{
+			"query proposal in voting period",
+			func() {
+				proposal.Status = types.StatusVotingPeriod
+				app.GovKeeper.SetProposal(ctx, proposal)
+
+				suite.Require().NoError(app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+
+				req = &types.QueryProposalStatusDetailRequest{ProposalId: proposal.ProposalId}
+			},
+			true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", testCase.msg), func() {
+			testCase.malleate()
+
+			res, err := queryClient.ProposalStatusDetail(gocontext.Background(), req)
+
+			if testCase.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(app.GovKeeper.GetProposalStatusDetail(ctx, proposal), res.StatusDetail)
+				suite.Require().Equal(sdk.NewInt(5*1000000), res.StatusDetail.Tally.Yes)
+				// the query doesn't remove votes
+				suite.Require().Len(app.GovKeeper.GetVotes(ctx, proposal.ProposalId), 1)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
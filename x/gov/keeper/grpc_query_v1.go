@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+// queryServerV1 implements the v1 gov QueryServer by converting the results
+// of the v1beta1 one.
+type queryServerV1 struct {
+	k Keeper
+}
+
+// NewQueryServerV1 returns an implementation of the v1 gov QueryServer
+// interface for the provided Keeper.
+func NewQueryServerV1(k Keeper) v1.QueryServer {
+	return queryServerV1{k: k}
+}
+
+var _ v1.QueryServer = queryServerV1{}
+
+// Proposal returns proposal details based on ProposalID
+func (q queryServerV1) Proposal(c context.Context, req *v1.QueryProposalRequest) (*v1.QueryProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Proposal(c, &types.QueryProposalRequest{ProposalId: req.ProposalId})
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := v1.ConvertToV1Proposal(res.Proposal)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
+}
+
+// Proposals implements the Query/Proposals gRPC method
+func (q queryServerV1) Proposals(c context.Context, req *v1.QueryProposalsRequest) (*v1.QueryProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Proposals(c, &types.QueryProposalsRequest{
+		ProposalStatus: types.ProposalStatus(req.ProposalStatus),
+		Voter:          req.Voter,
+		Depositor:      req.Depositor,
+		Pagination:     req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proposals := make([]*v1.Proposal, len(res.Proposals))
+	for i, p := range res.Proposals {
+		proposal, err := v1.ConvertToV1Proposal(p)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		proposals[i] = &proposal
+	}
+
+	return &v1.QueryProposalsResponse{Proposals: proposals, Pagination: res.Pagination}, nil
+}
+
+// Vote returns Voted information based on proposalID, voterAddr
+func (q queryServerV1) Vote(c context.Context, req *v1.QueryVoteRequest) (*v1.QueryVoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Vote(c, &types.QueryVoteRequest{ProposalId: req.ProposalId, Voter: req.Voter})
+	if err != nil {
+		return nil, err
+	}
+	vote := v1.ConvertToV1Vote(res.Vote)
+
+	return &v1.QueryVoteResponse{Vote: &vote}, nil
+}
+
+// Votes returns single proposal's votes
+func (q queryServerV1) Votes(c context.Context, req *v1.QueryVotesRequest) (*v1.QueryVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Votes(c, &types.QueryVotesRequest{ProposalId: req.ProposalId, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]*v1.Vote, len(res.Votes))
+	for i, v := range res.Votes {
+		vote := v1.ConvertToV1Vote(v)
+		votes[i] = &vote
+	}
+
+	return &v1.QueryVotesResponse{Votes: votes, Pagination: res.Pagination}, nil
+}
+
+// Params queries all params
+func (q queryServerV1) Params(c context.Context, req *v1.QueryParamsRequest) (*v1.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := v1.ConvertToV1Params(q.k.GetDepositParams(ctx), q.k.GetVotingParams(ctx), q.k.GetTallyParams(ctx))
+
+	return &v1.QueryParamsResponse{Params: &params}, nil
+}
+
+// Deposit queries single deposit information based proposalID, depositAddr.
+func (q queryServerV1) Deposit(c context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Deposit(c, &types.QueryDepositRequest{ProposalId: req.ProposalId, Depositor: req.Depositor})
+	if err != nil {
+		return nil, err
+	}
+	deposit := v1.ConvertToV1Deposit(res.Deposit)
+
+	return &v1.QueryDepositResponse{Deposit: &deposit}, nil
+}
+
+// Deposits returns single proposal's all deposits
+func (q queryServerV1) Deposits(c context.Context, req *v1.QueryDepositsRequest) (*v1.QueryDepositsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.Deposits(c, &types.QueryDepositsRequest{ProposalId: req.ProposalId, Pagination: req.Pagination})
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*v1.Deposit, len(res.Deposits))
+	for i, d := range res.Deposits {
+		deposit := v1.ConvertToV1Deposit(d)
+		deposits[i] = &deposit
+	}
+
+	return &v1.QueryDepositsResponse{Deposits: deposits, Pagination: res.Pagination}, nil
+}
+
+// TallyResult queries the tally of a proposal vote
+func (q queryServerV1) TallyResult(c context.Context, req *v1.QueryTallyResultRequest) (*v1.QueryTallyResultResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	res, err := q.k.TallyResult(c, &types.QueryTallyResultRequest{ProposalId: req.ProposalId})
+	if err != nil {
+		return nil, err
+	}
+	tally := v1.ConvertToV1TallyResult(res.Tally)
+
+	return &v1.QueryTallyResultResponse{Tally: &tally}, nil
+}
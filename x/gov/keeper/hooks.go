@@ -31,9 +31,9 @@ func (keeper Keeper) AfterProposalVote(ctx sdk.Context, proposalID uint64, voter
 }
 
 // AfterProposalFailedMinDeposit - call hook if registered
-func (keeper Keeper) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+func (keeper Keeper) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64, outcome types.DepositsOutcome) {
 	if keeper.hooks != nil {
-		keeper.hooks.AfterProposalFailedMinDeposit(ctx, proposalID)
+		keeper.hooks.AfterProposalFailedMinDeposit(ctx, proposalID, outcome)
 	}
 }
 
@@ -15,8 +15,9 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
-	paramSpace types.ParamSubspace
+	// The reference to the Paramstore where the gov params were stored before
+	// their migration to the module store
+	legacySubspace types.ParamSubspace
 
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
@@ -35,6 +36,10 @@ type Keeper struct {
 
 	// Proposal router
 	router types.Router
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -46,12 +51,16 @@ type Keeper struct {
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper, rtr types.Router,
+	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	rtr types.Router, authority string,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
+	if addr := authKeeper.GetModuleAddress(types.VoteFeePoolName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.VoteFeePoolName))
+	}
 
 	// It is vital to seal the governance proposal router here as to not allow
 	// further handlers to be registered after the keeper is created since this
@@ -59,16 +68,23 @@ func NewKeeper(
 	rtr.Seal()
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		authKeeper: authKeeper,
-		bankKeeper: bankKeeper,
-		sk:         sk,
-		cdc:        cdc,
-		router:     rtr,
+		storeKey:       key,
+		legacySubspace: paramSpace,
+		authKeeper:     authKeeper,
+		bankKeeper:     bankKeeper,
+		sk:             sk,
+		cdc:            cdc,
+		router:         rtr,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the address capable of executing gov messages that
+// require an authority, like MsgUpdateParams.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // SetHooks sets the hooks for governance
 func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	if keeper.hooks != nil {
@@ -144,6 +160,26 @@ func (keeper Keeper) IterateActiveProposalsQueue(ctx sdk.Context, endTime time.T
 	}
 }
 
+// IterateAllActiveProposalsQueue iterates over all the proposals in the active proposal queue,
+// whatever their voting end time, and performs a callback function
+func (keeper Keeper) IterateAllActiveProposalsQueue(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ActiveProposalQueuePrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		proposalID, _ := types.SplitActiveProposalQueueKey(iterator.Key())
+		proposal, found := keeper.GetProposal(ctx, proposalID)
+		if !found {
+			panic(fmt.Sprintf("proposal %d does not exist", proposalID))
+		}
+
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
 // IterateInactiveProposalsQueue iterates over the proposals in the inactive proposal queue
 // and performs a callback function
 func (keeper Keeper) IterateInactiveProposalsQueue(ctx sdk.Context, endTime time.Time, cb func(proposal types.Proposal) (stop bool)) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/atomone-hub/govgen/x/gov/types"
 )
@@ -27,27 +28,55 @@ var _ types.MsgServer = msgServer{}
 
 func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitProposal) (*types.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	proposal, err := k.Keeper.SubmitProposal(ctx, msg.GetContent())
+	proposalID, err := k.submitProposal(ctx, msg.GetContent(), msg.GetInitialDeposit(), msg.GetProposer(), "")
 	if err != nil {
 		return nil, err
 	}
 
+	return &types.MsgSubmitProposalResponse{
+		ProposalId: proposalID,
+	}, nil
+}
+
+// submitProposal submits a proposal with the given content, records its
+// proposer and metadata, and adds the initial deposit. The proposer can't be
+// in cooldown after a vetoed proposal, nor exceed the maximum number of
+// proposals in deposit or voting period.
+func (k msgServer) submitProposal(
+	ctx sdk.Context, content types.Content, initialDeposit sdk.Coins, proposer sdk.AccAddress, metadata string,
+) (uint64, error) {
+	if err := k.Keeper.checkProposerCooldown(ctx, proposer); err != nil {
+		return 0, err
+	}
+	if err := k.Keeper.checkProposerOpenProposals(ctx, proposer); err != nil {
+		return 0, err
+	}
+
+	proposal, err := k.Keeper.SubmitProposal(ctx, content)
+	if err != nil {
+		return 0, err
+	}
+	proposal.Proposer = proposer.String()
+	proposal.Metadata = metadata
+	k.Keeper.SetProposal(ctx, proposal)
+	k.Keeper.IncreaseProposerOpenProposals(ctx, proposal)
+
 	defer telemetry.IncrCounter(1, types.ModuleName, "proposal")
 
-	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.ProposalId, msg.GetProposer(), msg.GetInitialDeposit())
+	votingStarted, err := k.Keeper.AddDeposit(ctx, proposal.ProposalId, proposer, initialDeposit)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetProposer().String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, proposer.String()),
 		),
 	)
 
-	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, msg.GetContent().ProposalType()))
+	submitEvent := sdk.NewEvent(types.EventTypeSubmitProposal, sdk.NewAttribute(types.AttributeKeyProposalType, content.ProposalType()))
 	if votingStarted {
 		submitEvent = submitEvent.AppendAttributes(
 			sdk.NewAttribute(types.AttributeKeyVotingPeriodStart, fmt.Sprintf("%d", proposal.ProposalId)),
@@ -55,9 +84,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *types.MsgSubmitPro
 	}
 
 	ctx.EventManager().EmitEvent(submitEvent)
-	return &types.MsgSubmitProposalResponse{
-		ProposalId: proposal.ProposalId,
-	}, nil
+	return proposal.ProposalId, nil
 }
 
 func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
@@ -67,7 +94,7 @@ func (k msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVo
 		return nil, err
 	}
 
-	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, types.NewNonSplitVoteOption(msg.Option))
+	err = k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, types.NewNonSplitVoteOption(msg.Option), msg.Rationale)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +124,7 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *types.MsgVoteWeighte
 	if accErr != nil {
 		return nil, accErr
 	}
-	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options)
+	err := k.Keeper.AddVote(ctx, msg.ProposalId, accAddr, msg.Options, msg.Rationale)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +148,40 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *types.MsgVoteWeighte
 	return &types.MsgVoteWeightedResponse{}, nil
 }
 
+func (k msgServer) VoteBatch(goCtx context.Context, msg *types.MsgVoteBatch) (*types.MsgVoteBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, accErr := sdk.AccAddressFromBech32(msg.Voter)
+	if accErr != nil {
+		return nil, accErr
+	}
+	for _, vote := range msg.Votes {
+		err := k.Keeper.AddVote(ctx, vote.ProposalId, accAddr, vote.Options, vote.Rationale)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "proposal %d", vote.ProposalId)
+		}
+	}
+
+	for _, vote := range msg.Votes {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "vote"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel("proposal_id", strconv.Itoa(int(vote.ProposalId))),
+			},
+		)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter),
+		),
+	)
+
+	return &types.MsgVoteBatchResponse{}, nil
+}
+
 func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types.MsgDepositResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	accAddr, err := sdk.AccAddressFromBech32(msg.Depositor)
@@ -159,3 +220,57 @@ func (k msgServer) Deposit(goCtx context.Context, msg *types.MsgDeposit) (*types
 
 	return &types.MsgDepositResponse{}, nil
 }
+
+func (k msgServer) WithdrawDeposit(goCtx context.Context, msg *types.MsgWithdrawDeposit) (*types.MsgWithdrawDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	accAddr, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.WithdrawDeposit(ctx, msg.ProposalId, accAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, "withdraw_deposit"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel("proposal_id", strconv.Itoa(int(msg.ProposalId))),
+		},
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Depositor),
+		),
+	)
+
+	return &types.MsgWithdrawDepositResponse{Amount: amount}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+	if err := types.ValidateParams(msg.DepositParams, msg.VotingParams, msg.TallyParams); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDepositParams(ctx, msg.DepositParams)
+	k.SetVotingParams(ctx, msg.VotingParams)
+	k.SetTallyParams(ctx, msg.TallyParams)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+// msgServerV1 implements the v1 gov MsgServer on top of the v1beta1 one, so
+// that both share the same proposals, votes, deposits and params.
+type msgServerV1 struct {
+	legacy msgServer
+}
+
+// NewMsgServerV1Impl returns an implementation of the v1 gov MsgServer
+// interface for the provided Keeper.
+func NewMsgServerV1Impl(keeper Keeper) v1.MsgServer {
+	return &msgServerV1{legacy: msgServer{Keeper: keeper}}
+}
+
+var _ v1.MsgServer = msgServerV1{}
+
+func (k msgServerV1) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitProposal) (*v1.MsgSubmitProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	content, err := msg.GetLegacyContent()
+	if err != nil {
+		return nil, err
+	}
+
+	// the proposal messages are executed by the gov module account
+	authority := k.legacy.GetAuthority()
+	for _, m := range msg.Messages {
+		signers := m.GetCachedValue().(sdk.Msg).GetSigners()
+		if len(signers) != 1 || signers[0].String() != authority {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "expected %s as only signer of the proposal messages", authority)
+		}
+	}
+
+	proposer, err := sdk.AccAddressFromBech32(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+	proposalID, err := k.legacy.submitProposal(ctx, content, msg.InitialDeposit, proposer, msg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgSubmitProposalResponse{
+		ProposalId: proposalID,
+	}, nil
+}
+
+func (k msgServerV1) ExecLegacyContent(goCtx context.Context, msg *v1.MsgExecLegacyContent) (*v1.MsgExecLegacyContentResponse, error) {
+	if k.legacy.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.legacy.GetAuthority(), msg.Authority)
+	}
+
+	content := msg.GetLegacyContent()
+	if content == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalContent, "missing content")
+	}
+	if !k.legacy.router.HasRoute(content.ProposalRoute()) {
+		return nil, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	handler := k.legacy.router.GetRoute(content.ProposalRoute())
+	if err := handler(ctx, content); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
+	}
+
+	return &v1.MsgExecLegacyContentResponse{}, nil
+}
+
+func (k msgServerV1) Vote(goCtx context.Context, msg *v1.MsgVote) (*v1.MsgVoteResponse, error) {
+	legacyMsg := v1.ConvertToV1beta1MsgVote(*msg)
+	if _, err := k.legacy.Vote(goCtx, &legacyMsg); err != nil {
+		return nil, err
+	}
+	return &v1.MsgVoteResponse{}, nil
+}
+
+func (k msgServerV1) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted) (*v1.MsgVoteWeightedResponse, error) {
+	legacyMsg, err := v1.ConvertToV1beta1MsgVoteWeighted(*msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidVote, err.Error())
+	}
+	if _, err := k.legacy.VoteWeighted(goCtx, &legacyMsg); err != nil {
+		return nil, err
+	}
+	return &v1.MsgVoteWeightedResponse{}, nil
+}
+
+func (k msgServerV1) Deposit(goCtx context.Context, msg *v1.MsgDeposit) (*v1.MsgDepositResponse, error) {
+	legacyMsg := v1.ConvertToV1beta1MsgDeposit(*msg)
+	if _, err := k.legacy.Deposit(goCtx, &legacyMsg); err != nil {
+		return nil, err
+	}
+	return &v1.MsgDepositResponse{}, nil
+}
+
+func (k msgServerV1) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams) (*v1.MsgUpdateParamsResponse, error) {
+	legacyMsg, err := v1.ConvertToV1beta1MsgUpdateParams(*msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if _, err := k.legacy.UpdateParams(goCtx, &legacyMsg); err != nil {
+		return nil, err
+	}
+	return &v1.MsgUpdateParamsResponse{}, nil
+}
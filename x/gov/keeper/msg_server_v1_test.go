@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	"github.com/atomone-hub/govgen/x/gov/keeper"
+	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+func (suite *KeeperTestSuite) TestMsgServerV1SubmitProposal() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerV1Impl(app.GovKeeper)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	initialDeposit := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+
+	exec, err := v1.NewMsgExecLegacyContent(govgenhelpers.TestTextProposal, govAddr)
+	suite.Require().NoError(err)
+	badAuthorityExec, err := v1.NewMsgExecLegacyContent(govgenhelpers.TestTextProposal, suite.addrs[1].String())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		messages []sdk.Msg
+		expErr   string
+	}{
+		{"no message", nil, "a proposal must have a single"},
+		{"unsupported message", []sdk.Msg{v1.NewMsgDeposit(suite.addrs[0], 1, initialDeposit)}, "unsupported proposal message"},
+		{"authority is not gov", []sdk.Msg{badAuthorityExec}, "expected gov account as only signer"},
+		{"legacy content", []sdk.Msg{exec}, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg, err := v1.NewMsgSubmitProposal(tc.messages, initialDeposit, suite.addrs[0], "ipfs://metadata")
+			suite.Require().NoError(err)
+
+			res, err := msgServer.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the proposal is stored as a v1beta1 proposal
+			proposal, found := app.GovKeeper.GetProposal(ctx, res.ProposalId)
+			suite.Require().True(found)
+			suite.Require().Equal(govgenhelpers.TestTextProposal, proposal.GetContent())
+			suite.Require().Equal(types.StatusVotingPeriod, proposal.Status)
+			suite.Require().Equal(suite.addrs[0].String(), proposal.Proposer)
+			suite.Require().Equal("ipfs://metadata", proposal.Metadata)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgServerV1ExecLegacyContent() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerV1Impl(app.GovKeeper)
+	govAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+
+	content := paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		{Subspace: "staking", Key: "MaxValidators", Value: "1"},
+	})
+
+	msg, err := v1.NewMsgExecLegacyContent(content, suite.addrs[0].String())
+	suite.Require().NoError(err)
+	_, err = msgServer.ExecLegacyContent(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	msg, err = v1.NewMsgExecLegacyContent(content, govAddr)
+	suite.Require().NoError(err)
+	_, err = msgServer.ExecLegacyContent(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint32(1), app.StakingKeeper.MaxValidators(ctx))
+}
+
+func (suite *KeeperTestSuite) TestMsgServerV1VoteAndQuery() {
+	app, ctx := suite.app, suite.ctx.WithBlockTime(time.Now())
+	msgServer := keeper.NewMsgServerV1Impl(app.GovKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	v1.RegisterQueryServer(queryHelper, keeper.NewQueryServerV1(app.GovKeeper))
+	queryClient := v1.NewQueryClient(queryHelper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	suite.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	_, err = msgServer.Vote(sdk.WrapSDKContext(ctx), v1.NewMsgVote(suite.addrs[0], proposal.ProposalId, v1.VoteOption_VOTE_OPTION_YES, "rationale"))
+	suite.Require().NoError(err)
+	_, err = msgServer.VoteWeighted(sdk.WrapSDKContext(ctx), v1.NewMsgVoteWeighted(suite.addrs[1], proposal.ProposalId, []*v1.WeightedVoteOption{
+		{Option: v1.VoteOption_VOTE_OPTION_NO, Weight: "0.4"},
+		{Option: v1.VoteOption_VOTE_OPTION_ABSTAIN, Weight: "0.6"},
+	}, ""))
+	suite.Require().NoError(err)
+
+	// the vote metadata is the v1beta1 vote rationale
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal("rationale", vote.Rationale)
+
+	// the metadata is bounded like the rationale
+	_, err = msgServer.Vote(sdk.WrapSDKContext(ctx), v1.NewMsgVote(suite.addrs[0], proposal.ProposalId, v1.VoteOption_VOTE_OPTION_NO, strings.Repeat("a", 1000)))
+	suite.Require().ErrorIs(err, types.ErrVoteRationaleTooLong)
+
+	proposalRes, err := queryClient.Proposal(sdk.WrapSDKContext(ctx), &v1.QueryProposalRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(govgenhelpers.TestTextProposal.GetTitle(), proposalRes.Proposal.Title)
+	suite.Require().Equal(v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposalRes.Proposal.Status)
+	suite.Require().NotNil(proposalRes.Proposal.VotingStartTime)
+
+	votesRes, err := queryClient.Votes(sdk.WrapSDKContext(ctx), &v1.QueryVotesRequest{ProposalId: proposal.ProposalId})
+	suite.Require().NoError(err)
+	suite.Require().Len(votesRes.Votes, 2)
+
+	paramsRes, err := queryClient.Params(sdk.WrapSDKContext(ctx), &v1.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.GovKeeper.GetTallyParams(ctx).Quorum.String(), paramsRes.Params.Quorum)
+}
@@ -1,43 +1,180 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// GetDepositParams returns the current DepositParams from the module store
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) types.DepositParams {
 	var depositParams types.DepositParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	keeper.getParam(ctx, types.DepositParamsKey, &depositParams)
 	return depositParams
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams from the module store
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) types.VotingParams {
 	var votingParams types.VotingParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	keeper.getParam(ctx, types.VotingParamsKey, &votingParams)
 	return votingParams
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParam from the module store
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) types.TallyParams {
 	var tallyParams types.TallyParams
-	keeper.paramSpace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	keeper.getParam(ctx, types.TallyParamsKey, &tallyParams)
 	return tallyParams
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// GetParamChangePolicy returns the current ParamChangePolicy from the module
+// store. All the parameter changes are allowed if it has never been set.
+func (keeper Keeper) GetParamChangePolicy(ctx sdk.Context) types.ParamChangePolicy {
+	var paramChangePolicy types.ParamChangePolicy
+	keeper.getParam(ctx, types.ParamChangePolicyKey, &paramChangePolicy)
+	return paramChangePolicy
+}
+
+// GetParams returns all the current gov params
+func (keeper Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(
+		keeper.GetVotingParams(ctx), keeper.GetTallyParams(ctx),
+		keeper.GetDepositParams(ctx), keeper.GetParamChangePolicy(ctx),
+	)
+}
+
+// SetDepositParams sets DepositParams to the module store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams types.DepositParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyDepositParams, &depositParams)
+	keeper.setParam(ctx, types.DepositParamsKey, &depositParams)
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets VotingParams to the module store
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams types.VotingParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyVotingParams, &votingParams)
+	keeper.setParam(ctx, types.VotingParamsKey, &votingParams)
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets TallyParams to the module store
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams types.TallyParams) {
-	keeper.paramSpace.Set(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	keeper.setParam(ctx, types.TallyParamsKey, &tallyParams)
+}
+
+// SetParamChangePolicy sets ParamChangePolicy to the module store
+func (keeper Keeper) SetParamChangePolicy(ctx sdk.Context, paramChangePolicy types.ParamChangePolicy) {
+	keeper.setParam(ctx, types.ParamChangePolicyKey, &paramChangePolicy)
+}
+
+// SetParams sets all the gov params to the module store
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.SetDepositParams(ctx, params.DepositParams)
+	keeper.SetVotingParams(ctx, params.VotingParams)
+	keeper.SetTallyParams(ctx, params.TallyParams)
+	keeper.SetParamChangePolicy(ctx, params.ParamChangePolicy)
+}
+
+func (keeper Keeper) getParam(ctx sdk.Context, key []byte, param codec.ProtoMarshaler) {
+	bz := ctx.KVStore(keeper.storeKey).Get(key)
+	if bz == nil {
+		return
+	}
+	keeper.cdc.MustUnmarshal(bz, param)
+}
+
+func (keeper Keeper) setParam(ctx sdk.Context, key []byte, param codec.ProtoMarshaler) {
+	ctx.KVStore(keeper.storeKey).Set(key, keeper.cdc.MustMarshal(param))
+}
+
+// updateGovParam sets a gov param to the value of a parameter change targeting
+// the gov subspace. As the gov params are no longer stored in the x/params
+// subspace, such changes are applied to the module store, with the same
+// semantics as x/params: the value is amino JSON and only its non-empty fields
+// are updated.
+func (keeper Keeper) updateGovParam(ctx sdk.Context, key, value string) error {
+	params := keeper.GetParams(ctx)
+
+	var param interface{}
+	switch key {
+	case string(types.ParamStoreKeyDepositParams):
+		param = &params.DepositParams
+	case string(types.ParamStoreKeyVotingParams):
+		param = &params.VotingParams
+	case string(types.ParamStoreKeyTallyParams):
+		param = &params.TallyParams
+	case string(types.ParamStoreKeyParamChangePolicy):
+		param = &params.ParamChangePolicy
+	default:
+		return fmt.Errorf("parameter %s not registered", key)
+	}
+
+	if err := types.ModuleCdc.LegacyAmino.UnmarshalJSON([]byte(value), param); err != nil {
+		return err
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	keeper.SetParams(ctx, params)
+	return nil
+}
+
+// NewParamChangePolicyHandler wraps the proposal handler of parameter change
+// proposals so the ParamChangePolicy of the keeper is checked again before the
+// changes are executed. The changes of the gov params are applied to the
+// module store, while the other changes are forwarded to the wrapped handler.
+// The keeper is passed by reference as the proposal router is built before the
+// keeper.
+func NewParamChangePolicyHandler(keeper *Keeper, handler types.Handler) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		paramChange, ok := content.(*paramsproposal.ParameterChangeProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+		if err := keeper.GetParamChangePolicy(ctx).ValidateChanges(paramChange.Changes); err != nil {
+			return err
+		}
+
+		var changes []paramsproposal.ParamChange
+		for _, change := range paramChange.Changes {
+			if change.Subspace != types.ModuleName {
+				changes = append(changes, change)
+				continue
+			}
+			if err := keeper.updateGovParam(ctx, change.Key, change.Value); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidParamChange, "%s/%s: %s", change.Subspace, change.Key, err)
+			}
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		return handler(ctx, paramsproposal.NewParameterChangeProposal(paramChange.Title, paramChange.Description, changes))
+	}
+}
+
+// NewUpdateParamsProposalHandler returns a proposal handler which executes the
+// MsgUpdateParams of an UpdateParamsProposal with the keeper authority, the
+// gov module account, once the ParamChangePolicy is checked again. Other
+// proposals are forwarded to handler.
+func NewUpdateParamsProposalHandler(keeper *Keeper, handler types.Handler) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		updateParams, ok := content.(*types.UpdateParamsProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+		if err := keeper.GetParamChangePolicy(ctx).ValidateChanges(updateParams.ParamChanges()); err != nil {
+			return err
+		}
+
+		msg := types.NewMsgUpdateParams(
+			sdk.MustAccAddressFromBech32(keeper.GetAuthority()),
+			updateParams.DepositParams,
+			updateParams.VotingParams,
+			updateParams.TallyParams,
+		)
+		_, err := NewMsgServerImpl(*keeper).UpdateParams(sdk.WrapSDKContext(ctx), msg)
+		return err
+	}
 }
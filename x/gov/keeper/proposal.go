@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -19,12 +21,36 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrNoProposalHandlerExists, content.ProposalRoute())
 	}
 
+	if msg, ok := content.(proto.Message); ok {
+		typeURL := "/" + proto.MessageName(msg)
+		if !keeper.GetDepositParams(ctx).IsContentTypeAllowed(typeURL) {
+			return types.Proposal{}, sdkerrors.Wrap(types.ErrContentTypeNotAllowed, typeURL)
+		}
+	}
+
+	if paramChange, ok := content.(*paramsproposal.ParameterChangeProposal); ok {
+		if err := keeper.GetParamChangePolicy(ctx).ValidateChanges(paramChange.Changes); err != nil {
+			return types.Proposal{}, err
+		}
+	}
+
+	if updateParams, ok := content.(*types.UpdateParamsProposal); ok {
+		if err := keeper.GetParamChangePolicy(ctx).ValidateChanges(updateParams.ParamChanges()); err != nil {
+			return types.Proposal{}, err
+		}
+	}
+
+	if upgrade, ok := content.(*upgradetypes.SoftwareUpgradeProposal); ok {
+		if err := keeper.ValidateUpgradePlan(ctx, upgrade.Plan); err != nil {
+			return types.Proposal{}, err
+		}
+	}
+
 	// Execute the proposal content in a new context branch (with branched store)
 	// to validate the actual parameter changes before the proposal proceeds
 	// through the governance process. State is not persisted.
 	cacheCtx, _ := ctx.CacheContext()
-	handler := keeper.router.GetRoute(content.ProposalRoute())
-	if err := handler(cacheCtx, content); err != nil {
+	if err := keeper.executeContent(cacheCtx, content); err != nil {
 		return types.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalContent, err.Error())
 	}
 
@@ -191,7 +217,7 @@ func (keeper Keeper) GetVotingPeriod(ctx sdk.Context, content types.Content) tim
 	switch content.(type) {
 	case *types.TextProposal:
 		return keeper.GetVotingParams(ctx).VotingPeriodText
-	case *paramsproposal.ParameterChangeProposal:
+	case *paramsproposal.ParameterChangeProposal, *types.UpdateParamsProposal:
 		return keeper.GetVotingParams(ctx).VotingPeriodParameterChange
 	case *upgradetypes.SoftwareUpgradeProposal, *upgradetypes.CancelSoftwareUpgradeProposal:
 		return keeper.GetVotingParams(ctx).VotingPeriodSoftwareUpgrade
@@ -199,6 +225,44 @@ func (keeper Keeper) GetVotingPeriod(ctx sdk.Context, content types.Content) tim
 	return keeper.GetVotingParams(ctx).VotingPeriodDefault
 }
 
+// ExecuteProposal executes the content of a proposal with its proposal handler
+// in a branch of the store. The branch is written to the underlying store by
+// calling writeCache, which the caller must only do if no error is returned.
+func (keeper Keeper) ExecuteProposal(ctx sdk.Context, proposal types.Proposal) (cacheCtx sdk.Context, writeCache func(), err error) {
+	handler := keeper.Router().GetRoute(proposal.ProposalRoute())
+	cacheCtx, writeCache = ctx.CacheContext()
+	err = handler(cacheCtx, proposal.GetContent())
+	return cacheCtx, writeCache, err
+}
+
+// executeContent executes the content of a proposal with its handler. A panic
+// of the handler, like the one of x/params on an unregistered parameter key, is
+// returned as an error.
+func (keeper Keeper) executeContent(ctx sdk.Context, content types.Content) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	handler := keeper.router.GetRoute(content.ProposalRoute())
+	return handler(ctx, content)
+}
+
+// GetProposalTallyParams returns the tally params applying to a proposal, with
+// the threshold of parameter change and params update proposals raised
+// according to the ParamChangePolicy.
+func (keeper Keeper) GetProposalTallyParams(ctx sdk.Context, content types.Content) types.TallyParams {
+	tallyParams := keeper.GetTallyParams(ctx)
+	switch c := content.(type) {
+	case *paramsproposal.ParameterChangeProposal:
+		tallyParams.Threshold = keeper.GetParamChangePolicy(ctx).Threshold(c.Changes, tallyParams.Threshold)
+	case *types.UpdateParamsProposal:
+		tallyParams.Threshold = keeper.GetParamChangePolicy(ctx).Threshold(c.ParamChanges(), tallyParams.Threshold)
+	}
+	return tallyParams
+}
+
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Proposal) {
 	proposal.VotingStartTime = ctx.BlockHeader().Time
 	votingPeriod := keeper.GetVotingPeriod(ctx, proposal.GetContent())
@@ -210,6 +274,15 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal types.Propos
 	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
 }
 
+// SetProposalVotingEndTime updates the voting end time of a proposal in voting
+// period and moves it accordingly in the active proposal queue.
+func (keeper Keeper) SetProposalVotingEndTime(ctx sdk.Context, proposal types.Proposal, endTime time.Time) {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	proposal.VotingEndTime = endTime
+	keeper.SetProposal(ctx, proposal)
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+}
+
 func (keeper Keeper) MarshalProposal(proposal types.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
 	if err != nil {
@@ -6,8 +6,13 @@ import (
 	"strings"
 	"time"
 
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
 	"github.com/atomone-hub/govgen/x/gov/types"
@@ -72,6 +77,283 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposalAllowedContentTypes() {
+	depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
+	depositParams.AllowedContentTypes = []string{"/cosmos.params.v1beta1.ParameterChangeProposal"}
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, govgenhelpers.TestTextProposal)
+	suite.Require().ErrorIs(err, types.ErrContentTypeNotAllowed)
+
+	depositParams.AllowedContentTypes = append(depositParams.AllowedContentTypes, "/govgen.gov.v1beta1.TextProposal")
+	suite.app.GovKeeper.SetDepositParams(suite.ctx, depositParams)
+
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, govgenhelpers.TestTextProposal)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalParamChangePolicy() {
+	maxValidators := paramsproposal.NewParamChange("staking", "MaxValidators", "105")
+	bondDenom := paramsproposal.NewParamChange("staking", "BondDenom", `"foo"`)
+	sendEnabled := paramsproposal.NewParamChange("bank", "DefaultSendEnabled", "false")
+	policy := paramsproposal.NewParamChange("gov", "paramchangepolicy", `{"rules":[]}`)
+
+	testCases := []struct {
+		name        string
+		policy      types.ParamChangePolicy
+		changes     []paramsproposal.ParamChange
+		expectedErr error
+	}{
+		{
+			"default policy allows other keys",
+			types.DefaultParamChangePolicy(),
+			[]paramsproposal.ParamChange{maxValidators, sendEnabled},
+			nil,
+		},
+		{
+			"default policy denies bond denom",
+			types.DefaultParamChangePolicy(),
+			[]paramsproposal.ParamChange{maxValidators, bondDenom},
+			types.ErrParamChangeNotAllowed,
+		},
+		{
+			"default policy denies the policy",
+			types.DefaultParamChangePolicy(),
+			[]paramsproposal.ParamChange{policy},
+			types.ErrParamChangeNotAllowed,
+		},
+		{
+			"subspace denied",
+			types.NewParamChangePolicy(false, []types.ParamChangeRule{
+				types.NewParamChangeRule("staking", "", true, sdk.ZeroDec()),
+			}),
+			[]paramsproposal.ParamChange{maxValidators},
+			types.ErrParamChangeNotAllowed,
+		},
+		{
+			"key allowed in denied subspace",
+			types.NewParamChangePolicy(false, []types.ParamChangeRule{
+				types.NewParamChangeRule("staking", "", true, sdk.ZeroDec()),
+				types.NewParamChangeRule("staking", "MaxValidators", false, sdk.ZeroDec()),
+			}),
+			[]paramsproposal.ParamChange{maxValidators},
+			nil,
+		},
+		{
+			"allowlist",
+			types.NewParamChangePolicy(true, []types.ParamChangeRule{
+				types.NewParamChangeRule("staking", "MaxValidators", false, sdk.ZeroDec()),
+			}),
+			[]paramsproposal.ParamChange{maxValidators, sendEnabled},
+			types.ErrParamChangeNotAllowed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.app.GovKeeper.SetParamChangePolicy(suite.ctx, tc.policy)
+			content := paramsproposal.NewParameterChangeProposal("title", "description", tc.changes)
+
+			_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+
+			// the policy is checked again by the proposal handler
+			handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+			cacheCtx, _ := suite.ctx.CacheContext()
+			suite.Require().ErrorIs(handler(cacheCtx, content), tc.expectedErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalInvalidParamChange() {
+	testCases := []struct {
+		name        string
+		changes     []paramsproposal.ParamChange
+		expectedErr error
+	}{
+		{
+			"valid changes",
+			[]paramsproposal.ParamChange{
+				paramsproposal.NewParamChange("staking", "MaxValidators", "105"),
+				paramsproposal.NewParamChange("bank", "DefaultSendEnabled", "false"),
+			},
+			nil,
+		},
+		{
+			"invalid value",
+			[]paramsproposal.ParamChange{paramsproposal.NewParamChange("staking", "MaxValidators", "0")},
+			types.ErrInvalidProposalContent,
+		},
+		{
+			"invalid type",
+			[]paramsproposal.ParamChange{paramsproposal.NewParamChange("staking", "MaxValidators", `"foo"`)},
+			types.ErrInvalidProposalContent,
+		},
+		{
+			"unknown key",
+			[]paramsproposal.ParamChange{paramsproposal.NewParamChange("staking", "Foo", "1")},
+			types.ErrInvalidProposalContent,
+		},
+		{
+			"unknown subspace",
+			[]paramsproposal.ParamChange{paramsproposal.NewParamChange("foo", "MaxValidators", "1")},
+			types.ErrInvalidProposalContent,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			content := paramsproposal.NewParameterChangeProposal("title", "description", tc.changes)
+
+			_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+
+	// the changes are not persisted
+	suite.Require().Equal(uint32(100), suite.app.StakingKeeper.MaxValidators(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestParamChangeProposalGovParams() {
+	tallyParams := suite.app.GovKeeper.GetTallyParams(suite.ctx)
+
+	invalid := paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyTallyParams), `{"quorum":"2.0"}`),
+	})
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, invalid)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalContent)
+
+	content := paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange(types.ModuleName, string(types.ParamStoreKeyTallyParams), `{"quorum":"0.5"}`),
+		paramsproposal.NewParamChange("staking", "MaxValidators", "105"),
+	})
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.Require().NoError(err)
+
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	suite.Require().NoError(handler(suite.ctx, content))
+
+	// only the non-empty fields are updated
+	tallyParams.Quorum = sdk.NewDecWithPrec(5, 1)
+	suite.Require().Equal(tallyParams, suite.app.GovKeeper.GetTallyParams(suite.ctx))
+	suite.Require().Equal(uint32(105), suite.app.StakingKeeper.MaxValidators(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalUpgradePlan() {
+	info := govgenhelpers.TestSoftwareUpgradeProposalWithInfo.(*upgradetypes.SoftwareUpgradeProposal).Plan.Info
+	minHeight := suite.app.GovKeeper.MinUpgradeHeight(suite.ctx)
+
+	testCases := []struct {
+		name        string
+		plan        upgradetypes.Plan
+		expectedErr error
+	}{
+		{
+			"valid plan",
+			upgradetypes.Plan{Name: "v2", Height: minHeight + 1, Info: info},
+			nil,
+		},
+		{
+			"height before the end of the voting period",
+			upgradetypes.Plan{Name: "v2", Height: minHeight, Info: info},
+			types.ErrInvalidUpgradePlan,
+		},
+		{
+			"empty info",
+			upgradetypes.Plan{Name: "v2", Height: minHeight + 1},
+			types.ErrInvalidUpgradePlan,
+		},
+		{
+			"info without checksum",
+			upgradetypes.Plan{Name: "v2", Height: minHeight + 1, Info: `{"binaries":{"any":"https://example.com/govgend"}}`},
+			types.ErrInvalidUpgradePlan,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			content := upgradetypes.NewSoftwareUpgradeProposal("title", "description", tc.plan)
+
+			_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateBlockTime() {
+	ctx := suite.ctx.WithBlockHeight(50)
+	suite.Require().Equal(types.DefaultBlockTimeEstimate, suite.app.GovKeeper.EstimateBlockTime(ctx))
+
+	// historical info of the sampled block is missing
+	ctx = suite.ctx.WithBlockHeight(150)
+	suite.Require().Equal(types.DefaultBlockTimeEstimate, suite.app.GovKeeper.EstimateBlockTime(ctx))
+
+	now := time.Now().UTC()
+	suite.app.StakingKeeper.SetHistoricalInfo(ctx, 50, &stakingtypes.HistoricalInfo{
+		Header: tmproto.Header{Height: 50, Time: now},
+	})
+	ctx = ctx.WithBlockTime(now.Add(100 * 2 * time.Second))
+	suite.Require().Equal(2*time.Second, suite.app.GovKeeper.EstimateBlockTime(ctx))
+
+	// the deposit period may precede the voting period
+	depositPeriod := suite.app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	votingPeriod := suite.app.GovKeeper.GetVotingParams(ctx).VotingPeriodSoftwareUpgrade
+	suite.Require().Equal(150+int64((depositPeriod+votingPeriod)/(2*time.Second)), suite.app.GovKeeper.MinUpgradeHeight(ctx))
+}
+
+func (suite *KeeperTestSuite) TestGetProposalTallyParams() {
+	policy := types.NewParamChangePolicy(false, []types.ParamChangeRule{
+		types.NewParamChangeRule("staking", "", false, sdk.NewDecWithPrec(67, 2)),
+		types.NewParamChangeRule("bank", "", false, sdk.NewDecWithPrec(4, 1)),
+	})
+	suite.app.GovKeeper.SetParamChangePolicy(suite.ctx, policy)
+	threshold := suite.app.GovKeeper.GetTallyParams(suite.ctx).Threshold
+
+	tallyParams := suite.app.GovKeeper.GetProposalTallyParams(suite.ctx, govgenhelpers.TestTextProposal)
+	suite.Require().Equal(threshold, tallyParams.Threshold)
+
+	// a looser threshold doesn't apply
+	content := paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange("bank", "DefaultSendEnabled", "false"),
+	})
+	tallyParams = suite.app.GovKeeper.GetProposalTallyParams(suite.ctx, content)
+	suite.Require().Equal(threshold, tallyParams.Threshold)
+
+	content.Changes = append(content.Changes, paramsproposal.NewParamChange("staking", "MaxValidators", "105"))
+	tallyParams = suite.app.GovKeeper.GetProposalTallyParams(suite.ctx, content)
+	suite.Require().Equal(sdk.NewDecWithPrec(67, 2), tallyParams.Threshold)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParamsProposalParamChangePolicy() {
+	params := suite.app.GovKeeper.GetParams(suite.ctx)
+	content := types.NewUpdateParamsProposal("title", "description", params.DepositParams, params.VotingParams, params.TallyParams)
+	threshold := params.TallyParams.Threshold
+
+	policy := types.NewParamChangePolicy(false, []types.ParamChangeRule{
+		types.NewParamChangeRule(types.ModuleName, string(types.ParamStoreKeyTallyParams), false, sdk.NewDecWithPrec(67, 2)),
+	})
+	suite.app.GovKeeper.SetParamChangePolicy(suite.ctx, policy)
+	suite.Require().Equal(sdk.NewDecWithPrec(67, 2), suite.app.GovKeeper.GetProposalTallyParams(suite.ctx, content).Threshold)
+	suite.Require().Equal(params.VotingParams.VotingPeriodParameterChange, suite.app.GovKeeper.GetVotingPeriod(suite.ctx, content))
+
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.Require().NoError(err)
+
+	policy = types.NewParamChangePolicy(false, []types.ParamChangeRule{
+		types.NewParamChangeRule(types.ModuleName, "", true, sdk.ZeroDec()),
+	})
+	suite.app.GovKeeper.SetParamChangePolicy(suite.ctx, policy)
+	suite.Require().Equal(threshold, suite.app.GovKeeper.GetProposalTallyParams(suite.ctx, content).Threshold)
+
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, content)
+	suite.Require().ErrorIs(err, types.ErrParamChangeNotAllowed)
+
+	// the policy is checked again by the proposal handler
+	handler := suite.app.GovKeeper.Router().GetRoute(content.ProposalRoute())
+	cacheCtx, _ := suite.ctx.CacheContext()
+	suite.Require().ErrorIs(handler(cacheCtx, content), types.ErrParamChangeNotAllowed)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []types.ProposalStatus{types.StatusDepositPeriod, types.StatusVotingPeriod}
@@ -87,7 +369,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 			if i%2 == 0 {
 				d := types.NewDeposit(proposalID, addr1, nil)
-				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes))
+				v := types.NewVote(proposalID, addr1, types.NewNonSplitVoteOption(types.OptionYes), "")
 				suite.app.GovKeeper.SetDeposit(suite.ctx, d)
 				suite.app.GovKeeper.SetVote(suite.ctx, v)
 			}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// GetProposerOpenProposals returns the number of proposals in deposit or
+// voting period submitted by a proposer
+func (keeper Keeper) GetProposerOpenProposals(ctx sdk.Context, proposerAddr sdk.AccAddress) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ProposerOpenProposalsKey(proposerAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setProposerOpenProposals sets the number of proposals in deposit or voting
+// period submitted by a proposer, the entry is deleted when it reaches 0
+func (keeper Keeper) setProposerOpenProposals(ctx sdk.Context, proposerAddr sdk.AccAddress, count uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	if count == 0 {
+		store.Delete(types.ProposerOpenProposalsKey(proposerAddr))
+		return
+	}
+	store.Set(types.ProposerOpenProposalsKey(proposerAddr), sdk.Uint64ToBigEndian(count))
+}
+
+// IncreaseProposerOpenProposals counts a proposal entering the deposit period
+// in the open proposals of its proposer. Proposals without a recorded
+// proposer are not counted.
+func (keeper Keeper) IncreaseProposerOpenProposals(ctx sdk.Context, proposal types.Proposal) {
+	if proposal.Proposer == "" {
+		return
+	}
+	proposer := sdk.MustAccAddressFromBech32(proposal.Proposer)
+	keeper.setProposerOpenProposals(ctx, proposer, keeper.GetProposerOpenProposals(ctx, proposer)+1)
+}
+
+// DecreaseProposerOpenProposals removes a proposal which is no longer in
+// deposit or voting period from the open proposals of its proposer.
+func (keeper Keeper) DecreaseProposerOpenProposals(ctx sdk.Context, proposal types.Proposal) {
+	if proposal.Proposer == "" {
+		return
+	}
+	proposer := sdk.MustAccAddressFromBech32(proposal.Proposer)
+	if count := keeper.GetProposerOpenProposals(ctx, proposer); count > 0 {
+		keeper.setProposerOpenProposals(ctx, proposer, count-1)
+	}
+}
+
+// checkProposerOpenProposals returns an error if the proposer already has the
+// maximum number of proposals in deposit or voting period allowed by the
+// deposit params.
+func (keeper Keeper) checkProposerOpenProposals(ctx sdk.Context, proposerAddr sdk.AccAddress) error {
+	maxProposals := keeper.GetDepositParams(ctx).MaxProposalsPerProposer
+	if maxProposals == 0 {
+		return nil
+	}
+	if count := keeper.GetProposerOpenProposals(ctx, proposerAddr); count >= maxProposals {
+		return types.ErrTooManyProposals.Wrapf("%s has %d proposals in deposit or voting period, max %d", proposerAddr, count, maxProposals)
+	}
+	return nil
+}
+
+// GetProposerVetoRecord gets the veto record of a proposer
+func (keeper Keeper) GetProposerVetoRecord(ctx sdk.Context, proposerAddr sdk.AccAddress) (record types.ProposerVetoRecord, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ProposerVetoRecordKey(proposerAddr))
+	if bz == nil {
+		return record, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// SetProposerVetoRecord sets a ProposerVetoRecord to the gov store
+func (keeper Keeper) SetProposerVetoRecord(ctx sdk.Context, record types.ProposerVetoRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&record)
+	proposer := sdk.MustAccAddressFromBech32(record.Proposer)
+	store.Set(types.ProposerVetoRecordKey(proposer), bz)
+}
+
+// GetProposerVetoRecords returns the veto records of all the proposers
+func (keeper Keeper) GetProposerVetoRecords(ctx sdk.Context) (records types.ProposerVetoRecords) {
+	keeper.IterateProposerVetoRecords(ctx, func(record types.ProposerVetoRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// IterateProposerVetoRecords iterates over the veto records of all the
+// proposers and performs a callback function
+func (keeper Keeper) IterateProposerVetoRecords(ctx sdk.Context, cb func(record types.ProposerVetoRecord) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposerVetoRecordsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ProposerVetoRecord
+		keeper.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// RecordVetoedProposal increments the number of vetoed proposals of the
+// proposer of a vetoed proposal, and starts its cooldown if the deposit params
+// set a cooldown period. Proposals without a recorded proposer are ignored.
+func (keeper Keeper) RecordVetoedProposal(ctx sdk.Context, proposal types.Proposal) {
+	if proposal.Proposer == "" {
+		return
+	}
+	proposer := sdk.MustAccAddressFromBech32(proposal.Proposer)
+
+	record, found := keeper.GetProposerVetoRecord(ctx, proposer)
+	if !found {
+		record = types.NewProposerVetoRecord(proposer, 0, ctx.BlockHeader().Time)
+	}
+	record.VetoedProposals++
+	if cooldown := keeper.GetDepositParams(ctx).VetoCooldownPeriod; cooldown > 0 {
+		record.CooldownEndTime = ctx.BlockHeader().Time.Add(cooldown)
+	}
+	keeper.SetProposerVetoRecord(ctx, record)
+}
+
+// GetProposalMinDeposit returns the minimum deposit for a proposal to enter
+// its voting period, which is increased for the proposers of vetoed proposals
+// by the veto min deposit multiplier of the deposit params.
+func (keeper Keeper) GetProposalMinDeposit(ctx sdk.Context, proposal types.Proposal) sdk.Coins {
+	depositParams := keeper.GetDepositParams(ctx)
+	if proposal.Proposer == "" {
+		return depositParams.MinDeposit
+	}
+
+	record, _ := keeper.GetProposerVetoRecord(ctx, sdk.MustAccAddressFromBech32(proposal.Proposer))
+	return depositParams.ProposerMinDeposit(record.VetoedProposals)
+}
+
+// checkProposerCooldown returns an error if the proposer is in cooldown after
+// one of its proposals was vetoed.
+func (keeper Keeper) checkProposerCooldown(ctx sdk.Context, proposerAddr sdk.AccAddress) error {
+	record, found := keeper.GetProposerVetoRecord(ctx, proposerAddr)
+	if found && record.InCooldown(ctx.BlockHeader().Time) {
+		return types.ErrProposerInCooldown.Wrapf("%s can't submit proposals until %s", proposerAddr, record.CooldownEndTime)
+	}
+	return nil
+}
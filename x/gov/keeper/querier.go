@@ -39,6 +39,9 @@ func NewQuerier(keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier
 		case types.QueryTally:
 			return queryTally(ctx, path[1:], req, keeper, legacyQuerierCdc)
 
+		case types.QueryStatusDetail:
+			return queryStatusDetail(ctx, path[1:], req, keeper, legacyQuerierCdc)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -68,6 +71,13 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 		}
 		return bz, nil
 
+	case types.ParamParamChange:
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetParamChangePolicy(ctx))
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
 	}
@@ -170,7 +180,7 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	default:
 		// proposal is in voting period
-		_, _, tallyResult = keeper.Tally(ctx, proposal)
+		_, _, _, tallyResult = keeper.Tally(ctx, proposal)
 	}
 
 	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, tallyResult)
@@ -181,6 +191,32 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+func queryStatusDetail(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) { //nolint: unparam
+	var params types.QueryProposalParams
+	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	proposalID := params.ProposalID
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Status != types.StatusVotingPeriod {
+		return nil, sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetProposalStatusDetail(ctx, proposal))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) { //nolint: unparam
 	var params types.QueryProposalVotesParams
 	err := legacyQuerierCdc.UnmarshalJSON(req.Data, &params)
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// RecordGovMsgs adds the proposals and votes of count to the governance
+// messages sent by addr at the current height. It returns an error if the
+// messages sent by addr in the window of the GovMsgRateLimit would then exceed
+// the rate limit, in which case nothing is recorded. Nothing is recorded
+// either when the rate limit is disabled.
+func (keeper Keeper) RecordGovMsgs(ctx sdk.Context, addr sdk.AccAddress, count types.GovMsgCount) error {
+	rateLimit := keeper.GetVotingParams(ctx).GovMsgRateLimit
+	if !rateLimit.IsEnabled() || count.IsZero() {
+		return nil
+	}
+
+	total := keeper.GetGovMsgCountInWindow(ctx, addr, rateLimit.Window).Add(count)
+	if err := rateLimit.CheckCount(total, addr); err != nil {
+		return err
+	}
+
+	height := ctx.BlockHeight()
+	keeper.SetGovMsgCount(ctx, addr, height, keeper.GetGovMsgCount(ctx, addr, height).Add(count))
+	return nil
+}
+
+// GetGovMsgCountInWindow returns the number of proposals and votes sent by
+// addr in the window of blocks ending at the current height
+func (keeper Keeper) GetGovMsgCountInWindow(ctx sdk.Context, addr sdk.AccAddress, window uint64) (count types.GovMsgCount) {
+	height := ctx.BlockHeight()
+	start := height - int64(window) + 1
+	if start < 0 {
+		start = 0
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.GovMsgCountKey(addr, start), types.GovMsgCountKey(addr, height+1))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.GovMsgCount
+		keeper.cdc.MustUnmarshal(iterator.Value(), &c)
+		count = count.Add(c)
+	}
+	return count
+}
+
+// GetGovMsgCount gets the number of proposals and votes sent by addr at a
+// height
+func (keeper Keeper) GetGovMsgCount(ctx sdk.Context, addr sdk.AccAddress, height int64) (count types.GovMsgCount) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovMsgCountKey(addr, height))
+	if bz == nil {
+		return count
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &count)
+	return count
+}
+
+// SetGovMsgCount sets the number of proposals and votes sent by addr at a
+// height, along with the index used to prune it
+func (keeper Keeper) SetGovMsgCount(ctx sdk.Context, addr sdk.AccAddress, height int64, count types.GovMsgCount) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GovMsgCountKey(addr, height), keeper.cdc.MustMarshal(&count))
+	store.Set(types.GovMsgCountByHeightKey(height, addr), []byte{})
+}
+
+// PruneGovMsgCounts deletes the governance message counts which are out of the
+// window of the GovMsgRateLimit for the next block. All the counts are deleted
+// when the rate limit is disabled.
+func (keeper Keeper) PruneGovMsgCounts(ctx sdk.Context) {
+	rateLimit := keeper.GetVotingParams(ctx).GovMsgRateLimit
+	window := int64(0)
+	if rateLimit.IsEnabled() {
+		window = int64(rateLimit.Window)
+	}
+	end := ctx.BlockHeight() + 2 - window
+	if end <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.GovMsgCountsByHeightKeyPrefix, types.GovMsgCountsByHeightKey(end))
+
+	var indexKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range indexKeys {
+		height, addr := types.SplitGovMsgCountByHeightKey(key)
+		store.Delete(types.GovMsgCountKey(addr, height))
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordGovMsgs() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+	addr := suite.addrs[0]
+
+	// the rate limit is disabled by default
+	for i := 0; i < 3; i++ {
+		suite.Require().NoError(app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(1, 1)))
+	}
+	suite.Require().True(app.GovKeeper.GetGovMsgCount(ctx, addr, 10).IsZero())
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.GovMsgRateLimit = types.NewGovMsgRateLimit(3, 1, 2)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	suite.Require().NoError(app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(1, 1)))
+	err := app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(1, 0))
+	suite.Require().ErrorIs(err, types.ErrGovMsgRateLimitExceeded)
+	err = app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(0, 2))
+	suite.Require().ErrorIs(err, types.ErrGovMsgRateLimitExceeded)
+	suite.Require().Equal(types.NewGovMsgCount(1, 1), app.GovKeeper.GetGovMsgCount(ctx, addr, 10))
+
+	// other accounts are not limited
+	suite.Require().NoError(app.GovKeeper.RecordGovMsgs(ctx, suite.addrs[1], types.NewGovMsgCount(1, 2)))
+
+	// the counts of the previous blocks of the window are included
+	ctx = ctx.WithBlockHeight(12)
+	suite.Require().NoError(app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(0, 1)))
+	err = app.GovKeeper.RecordGovMsgs(ctx, addr, types.NewGovMsgCount(1, 0))
+	suite.Require().ErrorIs(err, types.ErrGovMsgRateLimitExceeded)
+	suite.Require().Equal(types.NewGovMsgCount(1, 2), app.GovKeeper.GetGovMsgCountInWindow(ctx, addr, 3))
+
+	// the counts out of the window are pruned
+	app.GovKeeper.PruneGovMsgCounts(ctx)
+	suite.Require().Equal(types.NewGovMsgCount(0, 1), app.GovKeeper.GetGovMsgCountInWindow(ctx.WithBlockHeight(13), addr, 3))
+	suite.Require().True(app.GovKeeper.GetGovMsgCount(ctx, addr, 10).IsZero())
+	suite.Require().True(app.GovKeeper.GetGovMsgCount(ctx, suite.addrs[1], 10).IsZero())
+	suite.Require().NoError(app.GovKeeper.RecordGovMsgs(ctx.WithBlockHeight(13), addr, types.NewGovMsgCount(1, 1)))
+
+	// all the counts are pruned once the rate limit is disabled
+	votingParams.GovMsgRateLimit = types.GovMsgRateLimit{}
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	app.GovKeeper.PruneGovMsgCounts(ctx.WithBlockHeight(13))
+	suite.Require().True(app.GovKeeper.GetGovMsgCountInWindow(ctx.WithBlockHeight(13), addr, 3).IsZero())
+}
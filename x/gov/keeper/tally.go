@@ -10,19 +10,191 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
-func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, tallyResults types.TallyResult) {
-	results := make(map[types.VoteOption]sdk.Dec)
+// voters. The deposits are burnt if the quorum is not reached or if the proposal is vetoed.
+func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes bool, burnDeposits bool, vetoed bool, tallyResults types.TallyResult) {
+	results, totalVotingPower, validatorTallies := keeper.tallyVotes(ctx, proposal, true)
+	for _, validatorTally := range validatorTallies {
+		keeper.SetValidatorTally(ctx, validatorTally)
+	}
+	passes, burnDeposits, vetoed = tallyOutcome(results, totalVotingPower, keeper.sk.TotalBondedTokens(ctx).ToDec(), keeper.GetProposalTallyParams(ctx, proposal.GetContent()))
+	return passes, burnDeposits, vetoed, types.NewTallyResultFromMap(results)
+}
+
+// GetProposalStatusDetail returns the projected outcome of a proposal in voting
+// period as if its voting period ended at the current block. Unlike Tally,
+// votes are not removed from the store.
+func (keeper Keeper) GetProposalStatusDetail(ctx sdk.Context, proposal types.Proposal) types.ProposalStatusDetail {
+	results, totalVotingPower, _ := keeper.tallyVotes(ctx, proposal, false)
+	totalBonded := keeper.sk.TotalBondedTokens(ctx)
+	tallyParams := keeper.GetProposalTallyParams(ctx, proposal.GetContent())
+
+	detail := types.ProposalStatusDetail{
+		ProposalId:  proposal.ProposalId,
+		Tally:       types.NewTallyResultFromMap(results),
+		TotalBonded: totalBonded,
+		Turnout:     sdk.ZeroDec(),
+		PowerToFlip: sdk.ZeroInt(),
+	}
+	if proposal.VotingEndTime.After(ctx.BlockHeader().Time) {
+		detail.TimeRemaining = proposal.VotingEndTime.Sub(ctx.BlockHeader().Time)
+	}
+	if totalBonded.IsZero() {
+		return detail
+	}
+
+	nonAbstainPower := totalVotingPower.Sub(results[types.OptionAbstain])
+	detail.Turnout = totalVotingPower.QuoInt(totalBonded)
+	detail.QuorumReached = detail.Turnout.GTE(tallyParams.Quorum)
+	if totalVotingPower.IsPositive() {
+		detail.VetoReached = results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold)
+	}
+	if nonAbstainPower.IsPositive() {
+		detail.ThresholdReached = results[types.OptionYes].Quo(nonAbstainPower).GT(tallyParams.Threshold)
+	}
+	detail.Passes, _, _ = tallyOutcome(results, totalVotingPower, totalBonded.ToDec(), tallyParams)
+
+	if detail.Passes {
+		// the outcome flips with enough NoWithVeto votes to exceed the veto
+		// threshold, or with enough No votes to fall to the threshold
+		detail.PowerToFlip = atLeast(results[types.OptionYes].Quo(tallyParams.Threshold).Sub(nonAbstainPower))
+		if tallyParams.VetoThreshold.LT(sdk.OneDec()) {
+			vetoPower := strictlyAbove(tallyParams.VetoThreshold.Mul(totalVotingPower).Sub(results[types.OptionNoWithVeto]).
+				Quo(sdk.OneDec().Sub(tallyParams.VetoThreshold)))
+			detail.PowerToFlip = sdk.MinInt(detail.PowerToFlip, vetoPower)
+		}
+		return detail
+	}
+
+	// the outcome flips with enough Yes votes to reach the quorum, fall to the
+	// veto threshold and exceed the threshold
+	if tallyParams.Threshold.Equal(sdk.OneDec()) {
+		// Yes votes can never exceed a threshold of 1
+		return detail
+	}
+	quorumPower := atLeast(tallyParams.Quorum.Mul(totalBonded.ToDec()).Sub(totalVotingPower))
+	vetoPower := atLeast(results[types.OptionNoWithVeto].Quo(tallyParams.VetoThreshold).Sub(totalVotingPower))
+	yesPower := strictlyAbove(tallyParams.Threshold.Mul(nonAbstainPower).Sub(results[types.OptionYes]).
+		Quo(sdk.OneDec().Sub(tallyParams.Threshold)))
+	detail.PowerToFlip = sdk.MaxInt(quorumPower, sdk.MaxInt(vetoPower, yesPower))
+	return detail
+}
+
+// tallyOutcome returns whether a proposal passes, whether its deposits must be
+// burnt and whether it is vetoed given the voting power of each option and the
+// total voting power of the voters.
+func tallyOutcome(results map[types.VoteOption]sdk.Dec, totalVotingPower, totalBonded sdk.Dec, tallyParams types.TallyParams) (passes bool, burnDeposits bool, vetoed bool) {
+	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
+	// If there is no staked coins, the proposal fails
+	if totalBonded.IsZero() {
+		return false, false, false
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(totalBonded)
+	if percentVoting.LT(tallyParams.Quorum) {
+		return false, true, false
+	}
+
+	// If no one votes (everyone abstains), proposal fails
+	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
+		return false, false, false
+	}
+
+	// If more than 1/3 of voters veto, proposal fails
+	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
+		return false, true, true
+	}
+
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
+	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
+		return true, false, false
+	}
+
+	// If more than 1/2 of non-abstaining voters vote No, proposal fails
+	return false, false, false
+}
+
+// atLeast returns the smallest non-negative integer power greater than or
+// equal to power.
+func atLeast(power sdk.Dec) sdk.Int {
+	if !power.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return power.Ceil().TruncateInt()
+}
+
+// strictlyAbove returns the smallest non-negative integer power strictly
+// greater than power.
+func strictlyAbove(power sdk.Dec) sdk.Int {
+	if power.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return power.TruncateInt().AddRaw(1)
+}
+
+// IsOutcomeDecided returns true if the outcome of an active proposal can no
+// longer be changed before the end of its voting period. Votes are final while
+// early termination is enabled, so the outcome can only be changed by the power
+// which has not voted yet. Any holder of the bond denom can bond and vote
+// until the end of the voting period, so this power is bounded by the supply of
+// the bond denom minus the voting power of the votes already cast.
+//
+// A passing proposal is decided if it still passes when all of this power is
+// bonded without voting, for the quorum, or votes NoWithVeto, for the veto
+// threshold, or votes No, for the threshold. A rejected proposal is decided if
+// it is still rejected when all of this power votes Yes.
+//
+// The voting power of the votes already cast is the one of the current block.
+// Unlike Tally, votes are not removed from the store.
+func (keeper Keeper) IsOutcomeDecided(ctx sdk.Context, proposal types.Proposal) bool {
+	totalBonded := keeper.sk.TotalBondedTokens(ctx).ToDec()
+	if totalBonded.IsZero() {
+		return false
+	}
+
+	results, totalVotingPower, _ := keeper.tallyVotes(ctx, proposal, false)
+	tallyParams := keeper.GetProposalTallyParams(ctx, proposal.GetContent())
+
+	// the total voting power at the end of the voting period can't exceed the
+	// supply of the bond denom
+	maxVotingPower := sdk.MaxDec(keeper.bankKeeper.GetSupply(ctx, keeper.sk.BondDenom(ctx)).Amount.ToDec(), totalBonded)
+	remainingPower := maxVotingPower.Sub(totalVotingPower)
+
+	if passes, _, _ := tallyOutcome(results, totalVotingPower, totalBonded, tallyParams); passes {
+		return totalVotingPower.Quo(maxVotingPower).GTE(tallyParams.Quorum) &&
+			results[types.OptionNoWithVeto].Add(remainingPower).Quo(maxVotingPower).LTE(tallyParams.VetoThreshold) &&
+			results[types.OptionYes].Quo(maxVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold)
+	}
+
+	results[types.OptionYes] = results[types.OptionYes].Add(remainingPower)
+	passes, _, _ := tallyOutcome(results, maxVotingPower, maxVotingPower, tallyParams)
+	return !passes
+}
+
+// tallyVotes iterates over the votes of a proposal and returns the voting
+// power of each option along with the total voting power of the voters and
+// its breakdown by validator, in validator power order. Votes are removed from
+// the store if deleteVotes is true.
+func (keeper Keeper) tallyVotes(ctx sdk.Context, proposal types.Proposal, deleteVotes bool) (
+	results map[types.VoteOption]sdk.Dec, totalVotingPower sdk.Dec, validatorTallies []types.ValidatorTally,
+) {
+	results = make(map[types.VoteOption]sdk.Dec)
 	results[types.OptionYes] = sdk.ZeroDec()
 	results[types.OptionAbstain] = sdk.ZeroDec()
 	results[types.OptionNo] = sdk.ZeroDec()
 	results[types.OptionNoWithVeto] = sdk.ZeroDec()
 
-	totalVotingPower := sdk.ZeroDec()
+	totalVotingPower = sdk.ZeroDec()
 	currValidators := make(map[string]types.ValidatorGovInfo)
+	// valResults holds the voting power of each option per validator, keyed
+	// like currValidators, and valOrder keeps the iteration order of the
+	// validators so the breakdown is deterministic
+	valResults := make(map[string]map[types.VoteOption]sdk.Dec)
+	var valOrder []string
 
 	// fetch all the bonded validators, insert them into currValidators
 	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		valOrder = append(valOrder, validator.GetOperator().String())
 		currValidators[validator.GetOperator().String()] = types.NewValidatorGovInfo(
 			validator.GetOperator(),
 			validator.GetBondedTokens(),
@@ -57,9 +229,18 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
+				if _, ok := valResults[valAddrStr]; !ok {
+					valResults[valAddrStr] = map[types.VoteOption]sdk.Dec{
+						types.OptionYes:        sdk.ZeroDec(),
+						types.OptionAbstain:    sdk.ZeroDec(),
+						types.OptionNo:         sdk.ZeroDec(),
+						types.OptionNoWithVeto: sdk.ZeroDec(),
+					}
+				}
 				for _, option := range vote.Options {
 					subPower := votingPower.Mul(option.Weight)
 					results[option.Option] = results[option.Option].Add(subPower)
+					valResults[valAddrStr][option.Option] = valResults[valAddrStr][option.Option].Add(subPower)
 				}
 				totalVotingPower = totalVotingPower.Add(votingPower)
 			}
@@ -67,7 +248,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
+		if deleteVotes {
+			keeper.deleteVote(ctx, vote.ProposalId, voter)
+		}
 		return false
 	})
 
@@ -91,36 +274,53 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal types.Proposal) (passes boo
 
 	*/
 
-	tallyParams := keeper.GetTallyParams(ctx)
-	tallyResults = types.NewTallyResultFromMap(results)
-
-	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
-	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
-		return false, false, tallyResults
+	for _, valAddrStr := range valOrder {
+		optionResults, ok := valResults[valAddrStr]
+		if !ok {
+			continue
+		}
+		val := currValidators[valAddrStr]
+		validatorTallies = append(validatorTallies,
+			types.NewValidatorTally(proposal.ProposalId, val.Address, val.BondedTokens, optionResults))
 	}
 
-	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(keeper.sk.TotalBondedTokens(ctx).ToDec())
-	if percentVoting.LT(tallyParams.Quorum) {
-		return false, true, tallyResults
-	}
+	return results, totalVotingPower, validatorTallies
+}
 
-	// If no one votes (everyone abstains), proposal fails
-	if totalVotingPower.Sub(results[types.OptionAbstain]).Equal(sdk.ZeroDec()) {
-		return false, false, tallyResults
+// SetValidatorTally sets a ValidatorTally to the gov store
+func (keeper Keeper) SetValidatorTally(ctx sdk.Context, validatorTally types.ValidatorTally) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&validatorTally)
+	valAddr, err := sdk.ValAddressFromBech32(validatorTally.ValidatorAddress)
+	if err != nil {
+		panic(err)
 	}
+	store.Set(types.ValidatorTallyKey(validatorTally.ProposalId, valAddr), bz)
+}
 
-	// If more than 1/3 of voters veto, proposal fails
-	if results[types.OptionNoWithVeto].Quo(totalVotingPower).GT(tallyParams.VetoThreshold) {
-		return false, true, tallyResults
-	}
+// GetValidatorTallies returns the per-validator breakdown stored with the
+// final tally of a proposal
+func (keeper Keeper) GetValidatorTallies(ctx sdk.Context, proposalID uint64) (validatorTallies types.ValidatorTallies) {
+	keeper.IterateValidatorTallies(ctx, proposalID, func(validatorTally types.ValidatorTally) bool {
+		validatorTallies = append(validatorTallies, validatorTally)
+		return false
+	})
+	return
+}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if results[types.OptionYes].Quo(totalVotingPower.Sub(results[types.OptionAbstain])).GT(tallyParams.Threshold) {
-		return true, false, tallyResults
-	}
+// IterateValidatorTallies iterates over the stored validator tallies of a
+// proposal and performs a callback function
+func (keeper Keeper) IterateValidatorTallies(ctx sdk.Context, proposalID uint64, cb func(validatorTally types.ValidatorTally) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorTalliesKey(proposalID))
 
-	// If more than 1/2 of non-abstaining voters vote No, proposal fails
-	return false, false, tallyResults
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var validatorTally types.ValidatorTally
+		keeper.cdc.MustUnmarshal(iterator.Value(), &validatorTally)
+
+		if cb(validatorTally) {
+			break
+		}
+	}
 }
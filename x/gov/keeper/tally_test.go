@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -29,7 +30,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.True(t, burnDeposits)
@@ -50,14 +51,15 @@ func TestTallyNoQuorum(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes))
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), "")
 	require.Nil(t, err)
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, vetoed, _ := app.GovKeeper.Tally(ctx, proposal)
 	require.False(t, passes)
 	require.True(t, burnDeposits)
+	require.False(t, vetoed)
 }
 
 func TestTallyOnlyValidatorsAllYes(t *testing.T) {
@@ -74,13 +76,13 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 	require.False(t, burnDeposits)
@@ -100,12 +102,12 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, _ := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -124,12 +126,12 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 	require.False(t, burnDeposits)
@@ -149,16 +151,17 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionNoWithVeto)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionNoWithVeto), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, vetoed, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.True(t, burnDeposits)
+	require.True(t, vetoed)
 	require.False(t, tallyResults.Equals(types.EmptyTallyResult()))
 }
 
@@ -175,13 +178,13 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 	require.False(t, burnDeposits)
@@ -201,13 +204,13 @@ func TestTallyAbstainFails(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[2], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -227,12 +230,12 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -261,14 +264,14 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[4], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -300,13 +303,13 @@ func TestTallyDelegatorInherit(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -350,14 +353,14 @@ func TestTallyDelegatorMultipleOverride(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -403,13 +406,13 @@ func TestTallyDelegatorMultipleInherit(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.False(t, passes)
 	require.False(t, burnDeposits)
@@ -456,13 +459,13 @@ func TestTallyJailedValidator(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionNo)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionNo), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 	require.False(t, burnDeposits)
@@ -489,13 +492,13 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo)))
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], types.NewNonSplitVoteOption(types.OptionYes), ""))
 
 	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
 	require.True(t, ok)
-	passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+	passes, burnDeposits, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
 
 	require.True(t, passes)
 	require.False(t, burnDeposits)
@@ -508,3 +511,200 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestIsOutcomeDecided(t *testing.T) {
+	// each of the 3 validators bonds a third of the bonded power, and 2
+	// accounts hold as many liquid tokens as the bonded power, unless burnt
+	testCases := []struct {
+		name       string
+		votes      []types.VoteOption
+		burnLiquid bool
+		decided    bool
+	}{
+		{"no votes", []types.VoteOption{}, true, false},
+		{"quorum not reached", []types.VoteOption{types.OptionYes}, true, false},
+		{"yes passes whatever the remaining power votes", []types.VoteOption{types.OptionYes, types.OptionYes}, true, true},
+		{"liquid supply can still veto", []types.VoteOption{types.OptionYes, types.OptionYes}, false, false},
+		{"all power voted", []types.VoteOption{types.OptionYes, types.OptionYes, types.OptionNo}, true, true},
+		{"remaining power can still pass", []types.VoteOption{types.OptionYes, types.OptionNo}, true, false},
+		{"vetoed whatever the remaining power votes", []types.VoteOption{types.OptionNoWithVeto, types.OptionNoWithVeto}, false, true},
+		{"remaining power can still lift the veto", []types.VoteOption{types.OptionNoWithVeto, types.OptionYes}, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := govgenhelpers.SetupNoValset(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{30, 30, 30})
+			if tc.burnLiquid {
+				for _, addr := range valAccAddrs[3:] {
+					balance := app.BankKeeper.GetAllBalances(ctx, addr)
+					require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, balance))
+					require.NoError(t, app.BankKeeper.BurnCoins(ctx, types.ModuleName, balance))
+				}
+				require.Equal(t, app.StakingKeeper.TotalBondedTokens(ctx), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+			require.NoError(t, err)
+			proposal.Status = types.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, valAccAddrs[i], types.NewNonSplitVoteOption(option), ""))
+			}
+
+			require.Equal(t, tc.decided, app.GovKeeper.IsOutcomeDecided(ctx, proposal))
+			// votes are kept in store
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), len(tc.votes))
+		})
+	}
+}
+
+func TestGetProposalStatusDetail(t *testing.T) {
+	testCases := []struct {
+		name             string
+		votes            []types.VoteOption
+		quorumReached    bool
+		thresholdReached bool
+		vetoReached      bool
+		passes           bool
+		powerToFlip      sdk.Int
+	}{
+		{
+			name:        "no votes",
+			votes:       []types.VoteOption{},
+			powerToFlip: sdk.NewInt(1002000),
+		},
+		{
+			name:             "quorum not reached",
+			votes:            []types.VoteOption{types.OptionYes},
+			thresholdReached: true,
+			powerToFlip:      sdk.NewInt(2000),
+		},
+		{
+			name:             "passes",
+			votes:            []types.VoteOption{types.OptionYes, types.OptionYes},
+			quorumReached:    true,
+			thresholdReached: true,
+			passes:           true,
+			powerToFlip:      sdk.NewInt(1003004),
+		},
+		{
+			name:          "threshold not reached",
+			votes:         []types.VoteOption{types.OptionYes, types.OptionNo},
+			quorumReached: true,
+			powerToFlip:   sdk.NewInt(1),
+		},
+		{
+			name:          "vetoed",
+			votes:         []types.VoteOption{types.OptionYes, types.OptionNoWithVeto},
+			quorumReached: true,
+			vetoReached:   true,
+			powerToFlip:   sdk.NewInt(994012),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := govgenhelpers.SetupNoValset(false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{1, 1, 1})
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+			require.NoError(t, err)
+			proposal.Status = types.StatusVotingPeriod
+			proposal.VotingEndTime = ctx.BlockHeader().Time.Add(time.Hour)
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, valAccAddrs[i], types.NewNonSplitVoteOption(option), ""))
+			}
+
+			detail := app.GovKeeper.GetProposalStatusDetail(ctx, proposal)
+			require.Equal(t, proposal.ProposalId, detail.ProposalId)
+			require.Equal(t, sdk.NewInt(3000000), detail.TotalBonded)
+			require.Equal(t, sdk.NewDec(int64(len(tc.votes))).QuoInt64(3), detail.Turnout)
+			require.Equal(t, tc.quorumReached, detail.QuorumReached)
+			require.Equal(t, tc.thresholdReached, detail.ThresholdReached)
+			require.Equal(t, tc.vetoReached, detail.VetoReached)
+			require.Equal(t, tc.passes, detail.Passes)
+			require.Equal(t, tc.powerToFlip, detail.PowerToFlip)
+			require.Equal(t, time.Hour, detail.TimeRemaining)
+			// votes are kept in store
+			require.Len(t, app.GovKeeper.GetVotes(ctx, proposal.ProposalId), len(tc.votes))
+		})
+	}
+}
+
+func TestTallyValidatorTallies(t *testing.T) {
+	app := govgenhelpers.SetupNoValset(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val3, found := app.StakingKeeper.GetValidator(ctx, valAddrs[2])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, addrs[3], delTokens, stakingtypes.Unbonded, val3, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	tp := govgenhelpers.TestTextProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[3], types.WeightedVoteOptions{
+		types.WeightedVoteOption{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(5, 1)},
+		types.WeightedVoteOption{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 1)},
+	}, ""))
+
+	// no breakdown is stored until the proposal is tallied
+	require.Empty(t, app.GovKeeper.GetValidatorTallies(ctx, proposalID))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	_, _, _, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+	validatorTallies := app.GovKeeper.GetValidatorTallies(ctx, proposalID)
+	require.Len(t, validatorTallies, 2)
+
+	tokens := app.StakingKeeper.TokensFromConsensusPower
+	expected := map[string]types.ValidatorTally{
+		valAddrs[0].String(): {
+			ProposalId:       proposalID,
+			ValidatorAddress: valAddrs[0].String(),
+			BondedTokens:     tokens(ctx, 5),
+			VotedPower:       tokens(ctx, 5),
+			Tally:            types.NewTallyResult(sdk.ZeroInt(), sdk.ZeroInt(), tokens(ctx, 5), sdk.ZeroInt()),
+		},
+		valAddrs[2].String(): {
+			ProposalId:       proposalID,
+			ValidatorAddress: valAddrs[2].String(),
+			BondedTokens:     tokens(ctx, 37),
+			VotedPower:       tokens(ctx, 30),
+			Tally:            types.NewTallyResult(tokens(ctx, 15), tokens(ctx, 15), sdk.ZeroInt(), sdk.ZeroInt()),
+		},
+	}
+	for _, validatorTally := range validatorTallies {
+		require.Equal(t, expected[validatorTally.ValidatorAddress], validatorTally)
+	}
+
+	// the breakdown adds up to the final tally
+	sum := types.EmptyTallyResult()
+	for _, validatorTally := range validatorTallies {
+		sum.Yes = sum.Yes.Add(validatorTally.Tally.Yes)
+		sum.Abstain = sum.Abstain.Add(validatorTally.Tally.Abstain)
+		sum.No = sum.No.Add(validatorTally.Tally.No)
+		sum.NoWithVeto = sum.NoWithVeto.Add(validatorTally.Tally.NoWithVeto)
+	}
+	require.True(t, sum.Equals(tallyResults))
+}
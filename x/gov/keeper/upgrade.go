@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// blockTimeSampleSize is the number of past blocks used to estimate the
+// average block time.
+const blockTimeSampleSize = 100
+
+// ValidateUpgradePlan performs the pre-flight checks of a software upgrade
+// plan: the upgrade height must come after the latest expected end of the
+// voting period and the plan info must list the upgrade binaries along with their
+// checksums.
+func (keeper Keeper) ValidateUpgradePlan(ctx sdk.Context, plan upgradetypes.Plan) error {
+	if minHeight := keeper.MinUpgradeHeight(ctx); plan.Height <= minHeight {
+		return sdkerrors.Wrapf(types.ErrInvalidUpgradePlan,
+			"upgrade height %d must be greater than the latest expected height %d at the end of the voting period",
+			plan.Height, minHeight)
+	}
+	return types.ValidatePlanInfo(plan.Info)
+}
+
+// MinUpgradeHeight returns the latest height at which the voting period of a
+// software upgrade proposal submitted in the current block is expected to
+// end, based on the estimated block time. As the voting period starts once
+// the min deposit is reached, the proposal may stay up to MaxDepositPeriod in
+// deposit period before.
+func (keeper Keeper) MinUpgradeHeight(ctx sdk.Context) int64 {
+	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
+	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriodSoftwareUpgrade
+	return ctx.BlockHeight() + int64((depositPeriod+votingPeriod)/keeper.EstimateBlockTime(ctx))
+}
+
+// EstimateBlockTime returns the average block time over the last
+// blockTimeSampleSize blocks, using the headers stored in the staking
+// historical info. It falls back to DefaultBlockTimeEstimate when the
+// historical info of the sampled block is not available.
+func (keeper Keeper) EstimateBlockTime(ctx sdk.Context) time.Duration {
+	sampleHeight := ctx.BlockHeight() - blockTimeSampleSize
+	if sampleHeight <= 0 {
+		return types.DefaultBlockTimeEstimate
+	}
+	histInfo, found := keeper.sk.GetHistoricalInfo(ctx, sampleHeight)
+	if !found {
+		return types.DefaultBlockTimeEstimate
+	}
+	blockTime := ctx.BlockTime().Sub(histInfo.Header.Time) / blockTimeSampleSize
+	if blockTime <= 0 {
+		return types.DefaultBlockTimeEstimate
+	}
+	return blockTime
+}
@@ -9,8 +9,9 @@ import (
 	"github.com/atomone-hub/govgen/x/gov/types"
 )
 
-// AddVote adds a vote on a specific proposal
-func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions) error {
+// AddVote adds a vote on a specific proposal. A vote can be changed by voting
+// again, unless early termination is enabled.
+func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions, rationale string) error {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -25,7 +26,20 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 		}
 	}
 
-	vote := types.NewVote(proposalID, voterAddr, options)
+	votingParams := keeper.GetVotingParams(ctx)
+	if uint64(len(rationale)) > votingParams.MaxVoteRationaleLength {
+		return sdkerrors.Wrapf(types.ErrVoteRationaleTooLong, "got %d, max %d", len(rationale), votingParams.MaxVoteRationaleLength)
+	}
+
+	// votes can't be changed while early termination is enabled, so that the
+	// outcome decided by the votes already cast can't be reversed
+	if votingParams.EarlyTerminationCheckInterval > 0 {
+		if _, found := keeper.GetVote(ctx, proposalID, voterAddr); found {
+			return sdkerrors.Wrapf(types.ErrVoteFinal, "voter %s already voted on proposal %d", voterAddr, proposalID)
+		}
+	}
+
+	vote := types.NewVote(proposalID, voterAddr, options, rationale)
 	keeper.SetVote(ctx, vote)
 
 	// called after a vote on a proposal is cast
@@ -36,6 +50,7 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.A
 			types.EventTypeProposalVote,
 			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyRationale, rationale),
 		),
 	)
 
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// GetVoteFeePoolBalance returns the coins held by the vote fee pool
+func (keeper Keeper) GetVoteFeePoolBalance(ctx sdk.Context) sdk.Coins {
+	return keeper.bankKeeper.GetAllBalances(ctx, keeper.authKeeper.GetModuleAddress(types.VoteFeePoolName))
+}
+
+// SponsorVoteFee pays the fee of a tx which only contains votes of voterAddr
+// on a proposal from the vote fee pool, by sending the fee to the voter before
+// it is deducted. The fee is only sponsored if the proposal is in voting
+// period, the voter has bonded at least the VoteFeeSponsorshipMinBonded, the
+// fee fits in the remaining budget of the voter on the proposal, and the pool
+// can afford it. It returns whether the fee was sponsored.
+func (keeper Keeper) SponsorVoteFee(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, fee sdk.Coins) (bool, error) {
+	votingParams := keeper.GetVotingParams(ctx)
+	budget := votingParams.VoteFeeSponsorshipBudget
+	if budget.Empty() || fee.IsZero() {
+		return false, nil
+	}
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok || proposal.Status != types.StatusVotingPeriod {
+		return false, nil
+	}
+
+	sponsorship, found := keeper.GetVoteFeeSponsorship(ctx, proposalID, voterAddr)
+	if !found {
+		sponsorship = types.NewVoteFeeSponsorship(proposalID, voterAddr, sdk.NewCoins())
+	}
+	spent := sponsorship.Spent.Add(fee...)
+	if !spent.IsAllLTE(budget) || !fee.IsAllLTE(keeper.GetVoteFeePoolBalance(ctx)) {
+		return false, nil
+	}
+
+	if !votingParams.IsVoteFeeSponsorshipEligible(keeper.bondedStake(ctx, voterAddr)) {
+		return false, nil
+	}
+
+	if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.VoteFeePoolName, voterAddr, fee); err != nil {
+		return false, err
+	}
+
+	sponsorship.Spent = spent
+	keeper.SetVoteFeeSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteFeeSponsored,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voterAddr.String()),
+		),
+	)
+
+	return true, nil
+}
+
+// bondedStake returns the tokens the address delegates to bonded validators.
+func (keeper Keeper) bondedStake(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroInt()
+	keeper.sk.IterateDelegations(ctx, addr, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		validator := keeper.sk.Validator(ctx, delegation.GetValidatorAddr())
+		if validator != nil && validator.IsBonded() {
+			bonded = bonded.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		}
+		return false
+	})
+	return bonded
+}
+
+// GetVoteFeeSponsorship gets the vote fees paid by the vote fee pool for a
+// voter on a proposal
+func (keeper Keeper) GetVoteFeeSponsorship(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) (sponsorship types.VoteFeeSponsorship, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.VoteFeeSponsorshipKey(proposalID, voterAddr))
+	if bz == nil {
+		return sponsorship, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &sponsorship)
+	return sponsorship, true
+}
+
+// SetVoteFeeSponsorship sets a VoteFeeSponsorship to the gov store
+func (keeper Keeper) SetVoteFeeSponsorship(ctx sdk.Context, sponsorship types.VoteFeeSponsorship) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshal(&sponsorship)
+	voter := sdk.MustAccAddressFromBech32(sponsorship.Voter)
+	store.Set(types.VoteFeeSponsorshipKey(sponsorship.ProposalId, voter), bz)
+}
+
+// GetVoteFeeSponsorships returns all the vote fee sponsorships of a proposal
+func (keeper Keeper) GetVoteFeeSponsorships(ctx sdk.Context, proposalID uint64) (sponsorships types.VoteFeeSponsorships) {
+	keeper.IterateVoteFeeSponsorships(ctx, proposalID, func(sponsorship types.VoteFeeSponsorship) bool {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+	return
+}
+
+// IterateVoteFeeSponsorships iterates over the all the vote fee sponsorships
+// of a proposal and performs a callback function
+func (keeper Keeper) IterateVoteFeeSponsorships(ctx sdk.Context, proposalID uint64, cb func(sponsorship types.VoteFeeSponsorship) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteFeeSponsorshipsKey(proposalID))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.VoteFeeSponsorship
+		keeper.cdc.MustUnmarshal(iterator.Value(), &sponsorship)
+
+		if cb(sponsorship) {
+			break
+		}
+	}
+}
+
+// DeleteVoteFeeSponsorships deletes all the vote fee sponsorships of a
+// proposal, once its voting period has ended.
+func (keeper Keeper) DeleteVoteFeeSponsorships(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	keeper.IterateVoteFeeSponsorships(ctx, proposalID, func(sponsorship types.VoteFeeSponsorship) bool {
+		voter := sdk.MustAccAddressFromBech32(sponsorship.Voter)
+		store.Delete(types.VoteFeeSponsorshipKey(proposalID, voter))
+		return false
+	})
+}
+
+// NewFundVoteFeePoolProposalHandler returns a proposal handler which transfers
+// the amount of a FundVoteFeePoolProposal from the community pool to the vote
+// fee pool. Other proposals are forwarded to handler.
+func NewFundVoteFeePoolProposalHandler(keeper *Keeper, distrKeeper types.DistributionKeeper, handler types.Handler) types.Handler {
+	return func(ctx sdk.Context, content types.Content) error {
+		fundProposal, ok := content.(*types.FundVoteFeePoolProposal)
+		if !ok {
+			return handler(ctx, content)
+		}
+
+		feePool := distrKeeper.GetFeePool(ctx)
+		amount := sdk.NewDecCoinsFromCoins(fundProposal.Amount...)
+		newPool, negative := feePool.CommunityPool.SafeSub(amount)
+		if negative {
+			return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds,
+				"community pool %s is smaller than %s", feePool.CommunityPool, fundProposal.Amount)
+		}
+		feePool.CommunityPool = newPool
+
+		err := keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, types.VoteFeePoolName, fundProposal.Amount)
+		if err != nil {
+			return err
+		}
+		distrKeeper.SetFeePool(ctx, feePool)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFundVoteFeePool,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, fundProposal.Amount.String()),
+			),
+		)
+		return nil
+	}
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	govgenhelpers "github.com/atomone-hub/govgen/app/helpers"
+	"github.com/atomone-hub/govgen/x/gov/keeper"
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func (suite *KeeperTestSuite) TestSponsorVoteFee() {
+	app, ctx := suite.app, suite.ctx
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)) }
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	suite.Require().NotEmpty(delegations)
+	staker := delegations[0].GetDelegatorAddr()
+	nonStaker := suite.addrs[0]
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	suite.Require().NoError(err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+	proposalID := proposal.ProposalId
+
+	// the sponsorship is disabled by default
+	sponsored, err := app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(600))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.VoteFeeSponsorshipBudget = coins(1000)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	// the pool is empty
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(600))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	suite.Require().NoError(govgenhelpers.FundModuleAccount(app.BankKeeper, ctx, types.VoteFeePoolName, coins(1500)))
+
+	balance := app.BankKeeper.GetAllBalances(ctx, staker)
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(600))
+	suite.Require().NoError(err)
+	suite.Require().True(sponsored)
+	suite.Require().Equal(balance.Add(coins(600)...), app.BankKeeper.GetAllBalances(ctx, staker))
+	suite.Require().Equal(coins(900), app.GovKeeper.GetVoteFeePoolBalance(ctx))
+	sponsorship, found := app.GovKeeper.GetVoteFeeSponsorship(ctx, proposalID, staker)
+	suite.Require().True(found)
+	suite.Require().Equal(coins(600), sponsorship.Spent)
+
+	// the budget of the voter on the proposal is exceeded
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(600))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	// the voter has bonded less than the minimum stake
+	validator, found := app.StakingKeeper.GetValidator(ctx, delegations[0].GetValidatorAddr())
+	suite.Require().True(found)
+	bonded := validator.TokensFromShares(delegations[0].GetShares()).TruncateInt()
+	votingParams.VoteFeeSponsorshipMinBonded = bonded.AddRaw(1)
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(100))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	votingParams.VoteFeeSponsorshipMinBonded = bonded
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, staker, coins(100))
+	suite.Require().NoError(err)
+	suite.Require().True(sponsored)
+
+	// the voter has no bonded stake
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID, nonStaker, coins(100))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	// the proposal is not in voting period
+	sponsored, err = app.GovKeeper.SponsorVoteFee(ctx, proposalID+1, staker, coins(100))
+	suite.Require().NoError(err)
+	suite.Require().False(sponsored)
+
+	suite.Require().Len(app.GovKeeper.GetVoteFeeSponsorships(ctx, proposalID), 1)
+	app.GovKeeper.DeleteVoteFeeSponsorships(ctx, proposalID)
+	suite.Require().Empty(app.GovKeeper.GetVoteFeeSponsorships(ctx, proposalID))
+}
+
+func (suite *KeeperTestSuite) TestFundVoteFeePoolProposalHandler() {
+	app, ctx := suite.app, suite.ctx
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(bondDenom, amount)) }
+
+	handler := keeper.NewFundVoteFeePoolProposalHandler(&app.GovKeeper, app.DistrKeeper, types.ProposalHandler)
+	suite.Require().NoError(handler(ctx, govgenhelpers.TestTextProposal))
+
+	suite.Require().NoError(govgenhelpers.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, coins(1000)))
+	feePool := app.DistrKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins(1000)...)...)
+	app.DistrKeeper.SetFeePool(ctx, feePool)
+	communityPool := app.DistrKeeper.GetFeePool(ctx).CommunityPool
+	poolBalance := app.GovKeeper.GetVoteFeePoolBalance(ctx)
+
+	// the community pool can't afford the amount
+	tooMuch := coins(communityPool.AmountOf(bondDenom).TruncateInt().Int64() + 1)
+	err := handler(ctx, types.NewFundVoteFeePoolProposal("title", "description", tooMuch))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	suite.Require().NoError(handler(ctx, types.NewFundVoteFeePoolProposal("title", "description", coins(400))))
+	suite.Require().Equal(poolBalance.Add(coins(400)...), app.GovKeeper.GetVoteFeePoolBalance(ctx))
+	suite.Require().Equal(communityPool.Sub(sdk.NewDecCoinsFromCoins(coins(400)...)), app.DistrKeeper.GetFeePool(ctx).CommunityPool)
+}
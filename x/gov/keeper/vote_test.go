@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -25,16 +26,16 @@ func TestVotes(t *testing.T) {
 
 	var invalidOption types.VoteOption = 0x10
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "proposal not on voting period")
-	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)), "invalid proposal ID")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""), "proposal not on voting period")
+	require.Error(t, app.GovKeeper.AddVote(ctx, 10, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""), "invalid proposal ID")
 
 	proposal.Status = types.StatusVotingPeriod
 	app.GovKeeper.SetProposal(ctx, proposal)
 
-	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption)), "invalid option")
+	require.Error(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(invalidOption), ""), "invalid option")
 
 	// Test first vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionAbstain), ""))
 	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0].String(), vote.Voter)
@@ -44,7 +45,7 @@ func TestVotes(t *testing.T) {
 	require.Equal(t, types.OptionAbstain, vote.Option) //nolint: staticcheck
 
 	// Test change of vote
-	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes)))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
 	require.True(t, found)
 	require.Equal(t, addrs[0].String(), vote.Voter)
@@ -59,7 +60,7 @@ func TestVotes(t *testing.T) {
 		types.WeightedVoteOption{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(30, 2)},
 		types.WeightedVoteOption{Option: types.OptionAbstain, Weight: sdk.NewDecWithPrec(5, 2)},
 		types.WeightedVoteOption{Option: types.OptionNoWithVeto, Weight: sdk.NewDecWithPrec(5, 2)},
-	}))
+	}, ""))
 	vote, found = app.GovKeeper.GetVote(ctx, proposalID, addrs[1])
 	require.True(t, found)
 	require.Equal(t, addrs[1].String(), vote.Voter)
@@ -93,3 +94,64 @@ func TestVotes(t *testing.T) {
 	require.True(t, votes[1].Options[3].Weight.Equal(sdk.NewDecWithPrec(5, 2)))
 	require.Equal(t, types.OptionEmpty, vote.Option) //nolint: staticcheck
 }
+
+func TestVoteRationale(t *testing.T) {
+	app := govgenhelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := govgenhelpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+
+	tp := govgenhelpers.TestTextProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp)
+	require.NoError(t, err)
+	proposalID := proposal.ProposalId
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	maxLength := app.GovKeeper.GetVotingParams(ctx).MaxVoteRationaleLength
+
+	// rationale exceeding the max length is rejected
+	tooLong := strings.Repeat("a", int(maxLength)+1)
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), tooLong)
+	require.ErrorIs(t, err, types.ErrVoteRationaleTooLong)
+	_, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.False(t, found)
+
+	// rationale at the max length is stored along with the vote
+	rationale := strings.Repeat("a", int(maxLength))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), rationale))
+	vote, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, rationale, vote.Rationale)
+
+	// a zero max length disables rationales
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.MaxVoteRationaleLength = 0
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+	err = app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), "because")
+	require.ErrorIs(t, err, types.ErrVoteRationaleTooLong)
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], types.NewNonSplitVoteOption(types.OptionNo), ""))
+}
+
+func TestVotesFinalWithEarlyTermination(t *testing.T) {
+	app := govgenhelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := govgenhelpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(30000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, govgenhelpers.TestTextProposal)
+	require.NoError(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	votingParams := app.GovKeeper.GetVotingParams(ctx)
+	votingParams.EarlyTerminationCheckInterval = 10
+	app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionYes), ""))
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], types.NewNonSplitVoteOption(types.OptionNo), "")
+	require.ErrorIs(t, err, types.ErrVoteFinal)
+	vote, found := app.GovKeeper.GetVote(ctx, proposal.ProposalId, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.OptionYes, vote.Options[0].Option)
+}
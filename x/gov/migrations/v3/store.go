@@ -0,0 +1,48 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration includes:
+//
+// - Moving the gov params from the x/params subspace to the gov module store.
+// The fields which don't exist in the v2 params are set to their default. The
+// ParamChangePolicy, which doesn't exist in v2 either, is moved if it has been
+// set in the subspace and set to its default otherwise.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, legacySubspace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var legacyDepositParams types.DepositParams
+	legacySubspace.Get(ctx, types.ParamStoreKeyDepositParams, &legacyDepositParams)
+	depositParams := types.DefaultDepositParams()
+	depositParams.MinDeposit = legacyDepositParams.MinDeposit
+	depositParams.MaxDepositPeriod = legacyDepositParams.MaxDepositPeriod
+	store.Set(types.DepositParamsKey, cdc.MustMarshal(&depositParams))
+
+	var legacyVotingParams types.VotingParams
+	legacySubspace.Get(ctx, types.ParamStoreKeyVotingParams, &legacyVotingParams)
+	votingParams := types.DefaultVotingParams()
+	votingParams.VotingPeriodDefault = legacyVotingParams.VotingPeriodDefault
+	votingParams.VotingPeriodParameterChange = legacyVotingParams.VotingPeriodParameterChange
+	votingParams.VotingPeriodSoftwareUpgrade = legacyVotingParams.VotingPeriodSoftwareUpgrade
+	votingParams.VotingPeriodText = legacyVotingParams.VotingPeriodText
+	store.Set(types.VotingParamsKey, cdc.MustMarshal(&votingParams))
+
+	var tallyParams types.TallyParams
+	legacySubspace.Get(ctx, types.ParamStoreKeyTallyParams, &tallyParams)
+	store.Set(types.TallyParamsKey, cdc.MustMarshal(&tallyParams))
+
+	paramChangePolicy := types.DefaultParamChangePolicy()
+	if legacySubspace.Has(ctx, types.ParamStoreKeyParamChangePolicy) {
+		paramChangePolicy = types.ParamChangePolicy{}
+		legacySubspace.Get(ctx, types.ParamStoreKeyParamChangePolicy, &paramChangePolicy)
+	}
+	store.Set(types.ParamChangePolicyKey, cdc.MustMarshal(&paramChangePolicy))
+
+	return nil
+}
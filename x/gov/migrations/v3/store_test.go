@@ -0,0 +1,117 @@
+package v3_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	govgenapp "github.com/atomone-hub/govgen/app"
+	v3 "github.com/atomone-hub/govgen/x/gov/migrations/v3"
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// loadFixtureStore returns a context where the v2 gov params store (the
+// x/params gov subspace) is loaded from the testdata fixture, along with the
+// extra keys.
+func loadFixtureStore(t *testing.T, govKey sdk.StoreKey, extra map[string]string) (sdk.Context, paramstypes.Subspace) {
+	t.Helper()
+
+	encCfg := govgenapp.MakeTestEncodingConfig()
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(govKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	cms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	bz, err := os.ReadFile("testdata/v2_params.json")
+	require.NoError(t, err)
+	var fixture map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+	for key, value := range extra {
+		fixture[key] = json.RawMessage(value)
+	}
+
+	subspaceStore := prefix.NewStore(ctx.KVStore(paramsKey), []byte(types.ModuleName+"/"))
+	for key, value := range fixture {
+		subspaceStore.Set([]byte(key), value)
+	}
+
+	subspace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	return ctx, subspace
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := govgenapp.MakeTestEncodingConfig().Codec
+	govKey := sdk.NewKVStoreKey(types.StoreKey)
+
+	// the fields added after v2 are set to their default
+	expDepositParams := types.DefaultDepositParams()
+	expDepositParams.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("ugovgen", 5000000))
+	expDepositParams.MaxDepositPeriod = 72 * time.Hour
+	expVotingParams := types.DefaultVotingParams()
+	expVotingParams.VotingPeriodDefault = 72 * time.Hour
+	expVotingParams.VotingPeriodParameterChange = 7 * 24 * time.Hour
+	expVotingParams.VotingPeriodSoftwareUpgrade = 14 * 24 * time.Hour
+	expVotingParams.VotingPeriodText = 30 * 24 * time.Hour
+	expTallyParams := types.NewTallyParams(sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(3, 1))
+
+	tests := []struct {
+		name                 string
+		extra                map[string]string
+		expParamChangePolicy types.ParamChangePolicy
+	}{
+		{
+			name:                 "v2 params",
+			expParamChangePolicy: types.DefaultParamChangePolicy(),
+		},
+		{
+			name: "param change policy set in subspace",
+			extra: map[string]string{
+				string(types.ParamStoreKeyParamChangePolicy): `{"allowlist":true}`,
+			},
+			expParamChangePolicy: types.NewParamChangePolicy(true, nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, subspace := loadFixtureStore(t, govKey, tt.extra)
+
+			require.NoError(t, v3.MigrateStore(ctx, govKey, subspace, cdc))
+
+			store := ctx.KVStore(govKey)
+			var depositParams types.DepositParams
+			cdc.MustUnmarshal(store.Get(types.DepositParamsKey), &depositParams)
+			require.True(t, expDepositParams.Equal(depositParams), depositParams)
+
+			var votingParams types.VotingParams
+			cdc.MustUnmarshal(store.Get(types.VotingParamsKey), &votingParams)
+			require.True(t, expVotingParams.Equal(votingParams), votingParams)
+			require.Equal(t, types.DefaultMaxVoteRationaleLength, votingParams.MaxVoteRationaleLength)
+
+			var tallyParams types.TallyParams
+			cdc.MustUnmarshal(store.Get(types.TallyParamsKey), &tallyParams)
+			require.True(t, expTallyParams.Equal(tallyParams), tallyParams)
+
+			var paramChangePolicy types.ParamChangePolicy
+			cdc.MustUnmarshal(store.Get(types.ParamChangePolicyKey), &paramChangePolicy)
+			require.True(t, tt.expParamChangePolicy.Equal(paramChangePolicy), paramChangePolicy)
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/atomone-hub/govgen/x/gov/keeper"
 	"github.com/atomone-hub/govgen/x/gov/simulation"
 	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
 )
 
 var (
@@ -55,6 +56,7 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the gov module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	v1.RegisterLegacyAminoCodec(cdc)
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the gov
@@ -86,6 +88,7 @@ func (a AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Ro
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the gov module.
 func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
 	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)) //nolint: errcheck
+	v1.RegisterQueryHandlerClient(context.Background(), mux, v1.NewQueryClient(clientCtx))       //nolint: errcheck
 }
 
 // GetTxCmd returns the root tx command for the gov module.
@@ -106,6 +109,7 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 // RegisterInterfaces implements InterfaceModule.RegisterInterfaces
 func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	v1.RegisterInterfaces(registry)
 }
 
 // AppModule implements an application module for the gov module.
@@ -156,6 +160,13 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+	v1.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerV1Impl(am.keeper))
+	v1.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerV1(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -175,7 +186,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // EndBlock returns the end blocker for the gov module. It returns no validator
 // updates.
@@ -48,6 +48,61 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &voteB)
 			return fmt.Sprintf("%v\n%v", voteA, voteB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorTalliesKeyPrefix):
+			var validatorTallyA, validatorTallyB types.ValidatorTally
+			cdc.MustUnmarshal(kvA.Value, &validatorTallyA)
+			cdc.MustUnmarshal(kvB.Value, &validatorTallyB)
+			return fmt.Sprintf("%v\n%v", validatorTallyA, validatorTallyB)
+
+		case bytes.Equal(kvA.Key, types.DepositParamsKey):
+			var depositParamsA, depositParamsB types.DepositParams
+			cdc.MustUnmarshal(kvA.Value, &depositParamsA)
+			cdc.MustUnmarshal(kvB.Value, &depositParamsB)
+			return fmt.Sprintf("%v\n%v", depositParamsA, depositParamsB)
+
+		case bytes.Equal(kvA.Key, types.VotingParamsKey):
+			var votingParamsA, votingParamsB types.VotingParams
+			cdc.MustUnmarshal(kvA.Value, &votingParamsA)
+			cdc.MustUnmarshal(kvB.Value, &votingParamsB)
+			return fmt.Sprintf("%v\n%v", votingParamsA, votingParamsB)
+
+		case bytes.Equal(kvA.Key, types.TallyParamsKey):
+			var tallyParamsA, tallyParamsB types.TallyParams
+			cdc.MustUnmarshal(kvA.Value, &tallyParamsA)
+			cdc.MustUnmarshal(kvB.Value, &tallyParamsB)
+			return fmt.Sprintf("%v\n%v", tallyParamsA, tallyParamsB)
+
+		case bytes.Equal(kvA.Key, types.ParamChangePolicyKey):
+			var paramChangePolicyA, paramChangePolicyB types.ParamChangePolicy
+			cdc.MustUnmarshal(kvA.Value, &paramChangePolicyA)
+			cdc.MustUnmarshal(kvB.Value, &paramChangePolicyB)
+			return fmt.Sprintf("%v\n%v", paramChangePolicyA, paramChangePolicyB)
+
+		case bytes.Equal(kvA.Key[:1], types.VoteFeeSponsorshipsKeyPrefix):
+			var sponsorshipA, sponsorshipB types.VoteFeeSponsorship
+			cdc.MustUnmarshal(kvA.Value, &sponsorshipA)
+			cdc.MustUnmarshal(kvB.Value, &sponsorshipB)
+			return fmt.Sprintf("%v\n%v", sponsorshipA, sponsorshipB)
+
+		case bytes.Equal(kvA.Key[:1], types.GovMsgCountsKeyPrefix):
+			var countA, countB types.GovMsgCount
+			cdc.MustUnmarshal(kvA.Value, &countA)
+			cdc.MustUnmarshal(kvB.Value, &countB)
+			return fmt.Sprintf("%v\n%v", countA, countB)
+
+		case bytes.Equal(kvA.Key[:1], types.GovMsgCountsByHeightKeyPrefix):
+			height, addr := types.SplitGovMsgCountByHeightKey(kvA.Key)
+			return fmt.Sprintf("%d\n%s", height, addr)
+
+		case bytes.Equal(kvA.Key[:1], types.ProposerVetoRecordsKeyPrefix):
+			var recordA, recordB types.ProposerVetoRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.ProposerOpenProposalsKeyPrefix):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid governance key prefix %X", kvA.Key[:1]))
 		}
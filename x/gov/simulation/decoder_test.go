@@ -36,7 +36,18 @@ func TestDecodeStore(t *testing.T) {
 	proposalIDBz := make([]byte, 8)
 	binary.LittleEndian.PutUint64(proposalIDBz, 1)
 	deposit := types.NewDeposit(1, delAddr1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())))
-	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes))
+	vote := types.NewVote(1, delAddr1, types.NewNonSplitVoteOption(types.OptionYes), "")
+	validatorTally := types.ValidatorTally{
+		ProposalId:       1,
+		ValidatorAddress: sdk.ValAddress(delAddr1).String(),
+		BondedTokens:     sdk.OneInt(),
+		VotedPower:       sdk.OneInt(),
+		Tally:            types.NewTallyResult(sdk.OneInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()),
+	}
+	depositParams := types.DefaultDepositParams()
+	votingParams := types.DefaultVotingParams()
+	tallyParams := types.DefaultTallyParams()
+	paramChangePolicy := types.DefaultParamChangePolicy()
 
 	proposalBzA, err := cdc.Marshal(&proposalA)
 	require.NoError(t, err)
@@ -73,6 +84,36 @@ func TestDecodeStore(t *testing.T) {
 			kv.Pair{Key: types.VoteKey(1, delAddr1), Value: cdc.MustMarshal(&vote)},
 			fmt.Sprintf("%v\n%v", vote, vote), false,
 		},
+		{
+			"validator tallies",
+			kv.Pair{Key: types.ValidatorTallyKey(1, sdk.ValAddress(delAddr1)), Value: cdc.MustMarshal(&validatorTally)},
+			kv.Pair{Key: types.ValidatorTallyKey(1, sdk.ValAddress(delAddr1)), Value: cdc.MustMarshal(&validatorTally)},
+			fmt.Sprintf("%v\n%v", validatorTally, validatorTally), false,
+		},
+		{
+			"deposit params",
+			kv.Pair{Key: types.DepositParamsKey, Value: cdc.MustMarshal(&depositParams)},
+			kv.Pair{Key: types.DepositParamsKey, Value: cdc.MustMarshal(&depositParams)},
+			fmt.Sprintf("%v\n%v", depositParams, depositParams), false,
+		},
+		{
+			"voting params",
+			kv.Pair{Key: types.VotingParamsKey, Value: cdc.MustMarshal(&votingParams)},
+			kv.Pair{Key: types.VotingParamsKey, Value: cdc.MustMarshal(&votingParams)},
+			fmt.Sprintf("%v\n%v", votingParams, votingParams), false,
+		},
+		{
+			"tally params",
+			kv.Pair{Key: types.TallyParamsKey, Value: cdc.MustMarshal(&tallyParams)},
+			kv.Pair{Key: types.TallyParamsKey, Value: cdc.MustMarshal(&tallyParams)},
+			fmt.Sprintf("%v\n%v", tallyParams, tallyParams), false,
+		},
+		{
+			"param change policy",
+			kv.Pair{Key: types.ParamChangePolicyKey, Value: cdc.MustMarshal(&paramChangePolicy)},
+			kv.Pair{Key: types.ParamChangePolicyKey, Value: cdc.MustMarshal(&paramChangePolicy)},
+			fmt.Sprintf("%v\n%v", paramChangePolicy, paramChangePolicy), false,
+		},
 		{
 			"other",
 			kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
@@ -23,6 +23,11 @@ const (
 	VotingParamsVotingPeriodParameterChange = "voting_params_voting_period_parameter_change"
 	VotingParamsVotingPeriodSoftwareUpgrade = "voting_params_voting_period_software_upgrade"
 	VotingParamsVotingPeriodText            = "voting_params_voting_period_text"
+	VotingParamsMaxVoteRationaleLength      = "voting_params_max_vote_rationale_length"
+	VotingParamsEarlyTerminationInterval    = "voting_params_early_termination_check_interval"
+	VotingParamsVoteFeeSponsorshipBudget    = "voting_params_vote_fee_sponsorship_budget"
+	VotingParamsVoteFeeSponsorshipMinBonded = "voting_params_vote_fee_sponsorship_min_bonded"
+	VotingParamsGovMsgRateLimit             = "voting_params_gov_msg_rate_limit"
 	TallyParamsQuorum                       = "tally_params_quorum"
 	TallyParamsThreshold                    = "tally_params_threshold"
 	TallyParamsVeto                         = "tally_params_veto"
@@ -43,6 +48,35 @@ func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
 }
 
+// GenVotingParamsMaxVoteRationaleLength randomized VotingParamsMaxVoteRationaleLength
+func GenVotingParamsMaxVoteRationaleLength(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 1000))
+}
+
+// GenVotingParamsEarlyTerminationCheckInterval randomized VotingParamsEarlyTerminationCheckInterval
+func GenVotingParamsEarlyTerminationCheckInterval(r *rand.Rand) uint64 {
+	return uint64(simulation.RandIntBetween(r, 0, 100))
+}
+
+// GenVotingParamsVoteFeeSponsorshipBudget randomized VotingParamsVoteFeeSponsorshipBudget
+func GenVotingParamsVoteFeeSponsorshipBudget(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 0, 1e3))))
+}
+
+// GenVotingParamsVoteFeeSponsorshipMinBonded randomized VotingParamsVoteFeeSponsorshipMinBonded
+func GenVotingParamsVoteFeeSponsorshipMinBonded(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 1e6)))
+}
+
+// GenVotingParamsGovMsgRateLimit randomized VotingParamsGovMsgRateLimit
+func GenVotingParamsGovMsgRateLimit(r *rand.Rand) types.GovMsgRateLimit {
+	return types.NewGovMsgRateLimit(
+		uint64(simulation.RandIntBetween(r, 0, 100)),
+		uint64(simulation.RandIntBetween(r, 1e3, 1e4)),
+		uint64(simulation.RandIntBetween(r, 1e3, 1e4)),
+	)
+}
+
 // GenTallyParamsQuorum randomized TallyParamsQuorum
 func GenTallyParamsQuorum(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 334, 500)), 3)
@@ -113,12 +147,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var maxVoteRationaleLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsMaxVoteRationaleLength, &maxVoteRationaleLength, simState.Rand,
+		func(r *rand.Rand) { maxVoteRationaleLength = GenVotingParamsMaxVoteRationaleLength(r) },
+	)
+
+	var earlyTerminationCheckInterval uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsEarlyTerminationInterval, &earlyTerminationCheckInterval, simState.Rand,
+		func(r *rand.Rand) { earlyTerminationCheckInterval = GenVotingParamsEarlyTerminationCheckInterval(r) },
+	)
+
+	var voteFeeSponsorshipBudget sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsVoteFeeSponsorshipBudget, &voteFeeSponsorshipBudget, simState.Rand,
+		func(r *rand.Rand) { voteFeeSponsorshipBudget = GenVotingParamsVoteFeeSponsorshipBudget(r) },
+	)
+
+	var voteFeeSponsorshipMinBonded sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsVoteFeeSponsorshipMinBonded, &voteFeeSponsorshipMinBonded, simState.Rand,
+		func(r *rand.Rand) { voteFeeSponsorshipMinBonded = GenVotingParamsVoteFeeSponsorshipMinBonded(r) },
+	)
+
+	var govMsgRateLimit types.GovMsgRateLimit
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsGovMsgRateLimit, &govMsgRateLimit, simState.Rand,
+		func(r *rand.Rand) { govMsgRateLimit = GenVotingParamsGovMsgRateLimit(r) },
+	)
+
 	govGenesis := types.NewGenesisState(
 		startingProposalID,
-		types.NewDepositParams(minDeposit, depositPeriod),
+		types.NewDepositParams(minDeposit, depositPeriod, nil, 0, 0, sdk.ZeroDec(), sdk.ZeroDec()),
 		types.NewVotingParams(votingPeriodDefault, votingPeriodParameterChange,
-			votingPeriodSoftwareUpgrade, votingPeriodText),
+			votingPeriodSoftwareUpgrade, votingPeriodText, maxVoteRationaleLength, earlyTerminationCheckInterval,
+			voteFeeSponsorshipBudget, voteFeeSponsorshipMinBonded, govMsgRateLimit),
 		types.NewTallyParams(quorum, threshold, veto),
+		types.DefaultParamChangePolicy(),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -290,8 +290,15 @@ func operationSimulateMsgVote(ak types.AccountKeeper, bk types.BankKeeper,
 			proposalID = uint64(proposalIDInt)
 		}
 
+		// votes can't be changed while early termination is enabled
+		if k.GetVotingParams(ctx).EarlyTerminationCheckInterval > 0 {
+			if _, found := k.GetVote(ctx, proposalID, simAccount.Address); found {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVote, "vote is final"), nil, nil
+			}
+		}
+
 		option := randomVotingOption(r)
-		msg := types.NewMsgVote(simAccount.Address, proposalID, option)
+		msg := types.NewMsgVote(simAccount.Address, proposalID, option, "")
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -344,8 +351,15 @@ func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeepe
 			proposalID = uint64(proposalIDInt)
 		}
 
+		// votes can't be changed while early termination is enabled
+		if k.GetVotingParams(ctx).EarlyTerminationCheckInterval > 0 {
+			if _, found := k.GetVote(ctx, proposalID, simAccount.Address); found {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgVoteWeighted, "vote is final"), nil, nil
+			}
+		}
+
 		options := randomWeightedVotingOptions(r)
-		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options)
+		msg := types.NewMsgVoteWeighted(simAccount.Address, proposalID, options, "")
 
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -18,7 +18,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(&MsgVote{}, "cosmos-sdk/MsgVote", nil)
 	cdc.RegisterConcrete(&MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgVoteBatch{}, "govgen/MsgVoteBatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "govgen/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDeposit{}, "govgen/MsgWithdrawDeposit", nil)
 	cdc.RegisterConcrete(&TextProposal{}, "cosmos-sdk/TextProposal", nil)
+	cdc.RegisterConcrete(&FundVoteFeePoolProposal{}, "govgen/FundVoteFeePoolProposal", nil)
+	cdc.RegisterConcrete(&UpdateParamsProposal{}, "govgen/UpdateParamsProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -26,12 +31,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSubmitProposal{},
 		&MsgVote{},
 		&MsgVoteWeighted{},
+		&MsgVoteBatch{},
 		&MsgDeposit{},
+		&MsgUpdateParams{},
+		&MsgWithdrawDeposit{},
 	)
 	registry.RegisterInterface(
 		"govgen.gov.v1beta1.Content",
 		(*Content)(nil),
 		&TextProposal{},
+		&FundVoteFeePoolProposal{},
+		&UpdateParamsProposal{},
 	)
 
 	// Register proposal types (this is actually done in related modules, but
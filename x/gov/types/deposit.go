@@ -53,3 +53,22 @@ func (d Deposits) String() string {
 func (d Deposit) Empty() bool {
 	return d.String() == Deposit{}.String()
 }
+
+// DepositsOutcome is the outcome of the deposits of a deleted proposal, split
+// between the coins refunded to the depositors and the burned coins.
+type DepositsOutcome struct {
+	Refunded sdk.Coins
+	Burned   sdk.Coins
+}
+
+// SplitInactiveProposalDeposit splits the deposit amount of a proposal which
+// failed to reach the minimum deposit between the coins refunded at the
+// InactiveProposalRefundRatio, rounded down, and the burned rest.
+func (dp DepositParams) SplitInactiveProposalDeposit(amount sdk.Coins) (refunded, burned sdk.Coins) {
+	refundRatio := dp.inactiveProposalRefundRatio()
+	refunded = sdk.NewCoins()
+	for _, coin := range amount {
+		refunded = refunded.Add(sdk.NewCoin(coin.Denom, refundRatio.MulInt(coin.Amount).TruncateInt()))
+	}
+	return refunded, amount.Sub(refunded)
+}
@@ -14,4 +14,16 @@ var (
 	ErrInvalidVote             = sdkerrors.Register(ModuleName, 70, "invalid vote option")
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 80, "invalid genesis state")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 90, "no handler exists for proposal type")
+	ErrVoteRationaleTooLong    = sdkerrors.Register(ModuleName, 100, "vote rationale too long")
+	ErrContentTypeNotAllowed   = sdkerrors.Register(ModuleName, 110, "proposal content type not allowed")
+	ErrParamChangeNotAllowed   = sdkerrors.Register(ModuleName, 120, "parameter change not allowed")
+	ErrInvalidParamChange      = sdkerrors.Register(ModuleName, 130, "invalid parameter change")
+	ErrInvalidUpgradePlan      = sdkerrors.Register(ModuleName, 140, "invalid software upgrade plan")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 150, "expected gov account as only signer for gov message")
+	ErrGovMsgRateLimitExceeded = sdkerrors.Register(ModuleName, 160, "governance message rate limit exceeded")
+	ErrTooManyProposals        = sdkerrors.Register(ModuleName, 170, "too many proposals in deposit or voting period for proposer")
+	ErrProposerInCooldown      = sdkerrors.Register(ModuleName, 180, "proposer in cooldown after a vetoed proposal")
+	ErrUnknownDeposit          = sdkerrors.Register(ModuleName, 190, "unknown deposit")
+	ErrProposerDepositLocked   = sdkerrors.Register(ModuleName, 200, "the proposer's deposit cannot be withdrawn")
+	ErrVoteFinal               = sdkerrors.Register(ModuleName, 210, "votes are final while early termination is enabled")
 )
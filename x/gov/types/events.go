@@ -4,12 +4,17 @@ package types
 const (
 	EventTypeSubmitProposal   = "submit_proposal"
 	EventTypeProposalDeposit  = "proposal_deposit"
+	EventTypeWithdrawDeposit  = "withdraw_deposit"
 	EventTypeProposalVote     = "proposal_vote"
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
+	EventTypeEarlyTermination = "proposal_early_termination"
+	EventTypeVoteFeeSponsored = "vote_fee_sponsored"
+	EventTypeFundVoteFeePool  = "fund_vote_fee_pool"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
+	AttributeKeyRationale          = "rationale"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
 	AttributeValueCategory         = "governance"
@@ -18,4 +23,8 @@ const (
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
 	AttributeKeyProposalType       = "proposal_type"
+	AttributeKeyVoter              = "voter"
+	AttributeKeyDepositor          = "depositor"
+	AttributeKeyRefundedDeposits   = "refunded_deposits"
+	AttributeKeyBurnedDeposits     = "burned_deposits"
 )
@@ -3,12 +3,14 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
 type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
+	Has(ctx sdk.Context, key []byte) bool
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
 
@@ -20,10 +22,21 @@ type StakingKeeper interface {
 	)
 
 	TotalBondedTokens(sdk.Context) sdk.Int // total bonded tokens within the validator set
+	BondDenom(sdk.Context) string
 	IterateDelegations(
 		ctx sdk.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
 	)
+
+	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI // get a particular validator by operator address
+}
+
+// DistributionKeeper defines the expected distribution keeper to fund the vote
+// fee pool from the community pool (noalias)
+type DistributionKeeper interface {
+	GetFeePool(ctx sdk.Context) distrtypes.FeePool
+	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -43,10 +56,14 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
-	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+
+	DelegateCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // Event Hooks
@@ -55,9 +72,9 @@ type BankKeeper interface {
 
 // GovHooks event hooks for governance proposal object (noalias)
 type GovHooks interface {
-	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                            // Must be called after proposal is submitted
-	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress) // Must be called after a deposit is made
-	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
-	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
-	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalSubmission(ctx sdk.Context, proposalID uint64)                                // Must be called after proposal is submitted
+	AfterProposalDeposit(ctx sdk.Context, proposalID uint64, depositorAddr sdk.AccAddress)     // Must be called after a deposit is made
+	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)            // Must be called after a vote on a proposal is cast
+	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64, outcome DepositsOutcome) // Must be called when proposal fails to reach min deposit
+	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                         // Must be called when proposal's finishes it's voting period
 }
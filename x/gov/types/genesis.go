@@ -8,12 +8,13 @@ import (
 )
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams, pcp ParamChangePolicy) *GenesisState {
 	return &GenesisState{
 		StartingProposalId: startingProposalID,
 		DepositParams:      dp,
 		VotingParams:       vp,
 		TallyParams:        tp,
+		ParamChangePolicy:  pcp,
 	}
 }
 
@@ -24,6 +25,7 @@ func DefaultGenesisState() *GenesisState {
 		DefaultDepositParams(),
 		DefaultVotingParams(),
 		DefaultTallyParams(),
+		DefaultParamChangePolicy(),
 	)
 }
 
@@ -34,7 +36,11 @@ func (data GenesisState) Equal(other GenesisState) bool {
 		data.Proposals.Equal(other.Proposals) &&
 		data.DepositParams.Equal(other.DepositParams) &&
 		data.TallyParams.Equal(other.TallyParams) &&
-		data.VotingParams.Equal(other.VotingParams)
+		data.VotingParams.Equal(other.VotingParams) &&
+		data.ValidatorTallies.Equal(other.ValidatorTallies) &&
+		data.ParamChangePolicy.Equal(other.ParamChangePolicy) &&
+		data.VoteFeeSponsorships.Equal(other.VoteFeeSponsorships) &&
+		data.ProposerVetoRecords.Equal(other.ProposerVetoRecords)
 }
 
 // Empty returns true if a GenesisState is empty
@@ -61,6 +67,80 @@ func ValidateGenesis(data *GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if !data.VotingParams.VoteFeeSponsorshipBudget.IsValid() {
+		return fmt.Errorf("governance vote fee sponsorship budget must be a valid sdk.Coins amount, is %s",
+			data.VotingParams.VoteFeeSponsorshipBudget.String())
+	}
+	if data.VotingParams.MaxVoteRationaleLength > MaxVoteRationaleLen {
+		return fmt.Errorf("governance max vote rationale length cannot exceed %d, is %d",
+			MaxVoteRationaleLen, data.VotingParams.MaxVoteRationaleLength)
+	}
+	if minBonded := data.VotingParams.VoteFeeSponsorshipMinBonded; !minBonded.IsNil() && minBonded.IsNegative() {
+		return fmt.Errorf("governance vote fee sponsorship min bonded cannot be negative, is %s", minBonded)
+	}
+
+	for _, sponsorship := range data.VoteFeeSponsorships {
+		if _, err := sdk.AccAddressFromBech32(sponsorship.Voter); err != nil {
+			return fmt.Errorf("invalid vote fee sponsorship voter %s: %w", sponsorship.Voter, err)
+		}
+		if !sponsorship.Spent.IsValid() {
+			return fmt.Errorf("vote fee sponsorship spent must be a valid sdk.Coins amount, is %s",
+				sponsorship.Spent.String())
+		}
+	}
+
+	if data.DepositParams.VetoCooldownPeriod < 0 {
+		return fmt.Errorf("governance veto cooldown period cannot be negative, is %s",
+			data.DepositParams.VetoCooldownPeriod)
+	}
+	if !data.DepositParams.VetoMinDepositMultiplier.IsNil() && data.DepositParams.VetoMinDepositMultiplier.IsNegative() {
+		return fmt.Errorf("governance veto min deposit multiplier cannot be negative, is %s",
+			data.DepositParams.VetoMinDepositMultiplier)
+	}
+	if refundRatio := data.DepositParams.InactiveProposalRefundRatio; !refundRatio.IsNil() &&
+		(refundRatio.IsNegative() || refundRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("governance inactive proposal refund ratio must be between 0 and 1, is %s", refundRatio)
+	}
+
+	proposalIDs := make(map[uint64]bool, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		proposalIDs[proposal.ProposalId] = true
+	}
+	seenTallies := make(map[string]bool, len(data.ValidatorTallies))
+	for _, tally := range data.ValidatorTallies {
+		if _, err := sdk.ValAddressFromBech32(tally.ValidatorAddress); err != nil {
+			return fmt.Errorf("invalid validator tally validator %s: %w", tally.ValidatorAddress, err)
+		}
+		if !proposalIDs[tally.ProposalId] {
+			return fmt.Errorf("validator tally of %s for unknown proposal %d", tally.ValidatorAddress, tally.ProposalId)
+		}
+		tallyKey := fmt.Sprintf("%d/%s", tally.ProposalId, tally.ValidatorAddress)
+		if seenTallies[tallyKey] {
+			return fmt.Errorf("duplicate validator tally of %s for proposal %d", tally.ValidatorAddress, tally.ProposalId)
+		}
+		seenTallies[tallyKey] = true
+		if tally.BondedTokens.IsNil() || tally.BondedTokens.IsNegative() ||
+			tally.VotedPower.IsNil() || tally.VotedPower.IsNegative() {
+			return fmt.Errorf("validator tally of %s for proposal %d must have non-negative bonded tokens and voted power",
+				tally.ValidatorAddress, tally.ProposalId)
+		}
+	}
+
+	seenProposers := make(map[string]bool, len(data.ProposerVetoRecords))
+	for _, record := range data.ProposerVetoRecords {
+		if _, err := sdk.AccAddressFromBech32(record.Proposer); err != nil {
+			return fmt.Errorf("invalid proposer veto record proposer %s: %w", record.Proposer, err)
+		}
+		if seenProposers[record.Proposer] {
+			return fmt.Errorf("duplicate proposer veto record for %s", record.Proposer)
+		}
+		seenProposers[record.Proposer] = true
+	}
+
+	if err := validateParamChangePolicy(data.ParamChangePolicy); err != nil {
+		return fmt.Errorf("invalid governance param change policy: %w", err)
+	}
+
 	return nil
 }
 
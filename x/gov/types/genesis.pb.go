@@ -39,6 +39,18 @@ type GenesisState struct {
 	VotingParams VotingParams `protobuf:"bytes,6,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
 	// params defines all the paramaters of related to tally.
 	TallyParams TallyParams `protobuf:"bytes,7,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+	// validator_tallies defines the per-validator breakdowns of the final tallies
+	// present at genesis.
+	ValidatorTallies ValidatorTallies `protobuf:"bytes,8,rep,name=validator_tallies,json=validatorTallies,proto3,castrepeated=ValidatorTallies" json:"validator_tallies" yaml:"validator_tallies"`
+	// param_change_policy defines the restrictions on the parameters that can be
+	// changed by a parameter change proposal.
+	ParamChangePolicy ParamChangePolicy `protobuf:"bytes,9,opt,name=param_change_policy,json=paramChangePolicy,proto3" json:"param_change_policy" yaml:"param_change_policy"`
+	// vote_fee_sponsorships defines the vote fees paid by the vote fee pool on
+	// the proposals in voting period present at genesis.
+	VoteFeeSponsorships VoteFeeSponsorships `protobuf:"bytes,10,rep,name=vote_fee_sponsorships,json=voteFeeSponsorships,proto3,castrepeated=VoteFeeSponsorships" json:"vote_fee_sponsorships" yaml:"vote_fee_sponsorships"`
+	// proposer_veto_records defines the vetoed proposals and cooldowns of the
+	// proposers present at genesis.
+	ProposerVetoRecords ProposerVetoRecords `protobuf:"bytes,11,rep,name=proposer_veto_records,json=proposerVetoRecords,proto3,castrepeated=ProposerVetoRecords" json:"proposer_veto_records" yaml:"proposer_veto_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +135,34 @@ func (m *GenesisState) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *GenesisState) GetValidatorTallies() ValidatorTallies {
+	if m != nil {
+		return m.ValidatorTallies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamChangePolicy() ParamChangePolicy {
+	if m != nil {
+		return m.ParamChangePolicy
+	}
+	return ParamChangePolicy{}
+}
+
+func (m *GenesisState) GetVoteFeeSponsorships() VoteFeeSponsorships {
+	if m != nil {
+		return m.VoteFeeSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerVetoRecords() ProposerVetoRecords {
+	if m != nil {
+		return m.ProposerVetoRecords
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "govgen.gov.v1beta1.GenesisState")
 }
@@ -130,35 +170,46 @@ func init() {
 func init() { proto.RegisterFile("govgen/gov/v1beta1/genesis.proto", fileDescriptor_a91b467d4af42338) }
 
 var fileDescriptor_a91b467d4af42338 = []byte{
-	// 434 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0xd6, 0x8e, 0xcd, 0x6d, 0x11, 0x98, 0x22, 0x45, 0x6b, 0x49, 0x42, 0x4e, 0xe5,
-	0x40, 0xa2, 0x8d, 0x1b, 0x12, 0x97, 0x80, 0x84, 0x76, 0x40, 0x1a, 0x01, 0x71, 0xe0, 0x52, 0x39,
-	0x8b, 0xe5, 0x45, 0x4a, 0xf2, 0xa2, 0xd8, 0xb3, 0xe8, 0xb7, 0xe0, 0x73, 0xf0, 0x49, 0x76, 0xdc,
-	0x91, 0x53, 0x41, 0xed, 0x89, 0xeb, 0x3e, 0x01, 0x8a, 0xed, 0x40, 0x26, 0x02, 0xb7, 0xe4, 0xf9,
-	0xef, 0xdf, 0xef, 0xf9, 0xe9, 0x21, 0x8f, 0x81, 0x64, 0xb4, 0x0c, 0x19, 0xc8, 0x50, 0x1e, 0x27,
-	0x54, 0x90, 0xe3, 0x90, 0xd1, 0x92, 0xf2, 0x8c, 0x07, 0x55, 0x0d, 0x02, 0x30, 0xd6, 0x89, 0x80,
-	0x81, 0x0c, 0x4c, 0xe2, 0x68, 0xc6, 0x80, 0x81, 0x3a, 0x0e, 0x9b, 0x2f, 0x9d, 0x3c, 0x5a, 0xf4,
-	0xb1, 0x40, 0xea, 0x53, 0xff, 0xe7, 0x10, 0x4d, 0xde, 0x68, 0xf2, 0x7b, 0x41, 0x04, 0xc5, 0xef,
-	0xd0, 0x8c, 0x0b, 0x52, 0x8b, 0xac, 0x64, 0xab, 0xaa, 0x86, 0x0a, 0x38, 0xc9, 0x57, 0x59, 0x6a,
-	0x5b, 0x9e, 0xb5, 0x1c, 0x46, 0xee, 0xcd, 0xc6, 0x9d, 0xaf, 0x49, 0x91, 0xbf, 0xf0, 0xfb, 0x52,
-	0x7e, 0x8c, 0xdb, 0xf2, 0x99, 0xa9, 0x9e, 0xa6, 0xf8, 0x14, 0x1d, 0xa4, 0xb4, 0x02, 0x9e, 0x09,
-	0x6e, 0xdf, 0xf1, 0xf6, 0x96, 0xe3, 0x93, 0x79, 0xf0, 0x77, 0xfb, 0xc1, 0x6b, 0x9d, 0x89, 0xee,
-	0x5f, 0x6d, 0xdc, 0xc1, 0xd7, 0xef, 0xee, 0x81, 0x29, 0xf0, 0xf8, 0xf7, 0x75, 0xfc, 0x12, 0x8d,
-	0x24, 0x08, 0xca, 0xed, 0x3d, 0xc5, 0xb1, 0xfb, 0x38, 0x1f, 0x41, 0xd0, 0x68, 0x6a, 0x20, 0xa3,
-	0xe6, 0x8f, 0xc7, 0xfa, 0x16, 0x7e, 0x8b, 0x0e, 0xdb, 0x6e, 0xb9, 0x3d, 0x54, 0x88, 0x45, 0x1f,
-	0xa2, 0x6d, 0x3e, 0x7a, 0x60, 0x30, 0x87, 0x6d, 0x85, 0xc7, 0x7f, 0x08, 0x98, 0xa1, 0x7b, 0xa6,
-	0xb3, 0x55, 0x45, 0x6a, 0x52, 0x70, 0x7b, 0xe4, 0x59, 0xcb, 0xf1, 0xc9, 0x93, 0xff, 0x3c, 0xef,
-	0x4c, 0x05, 0xa3, 0xc7, 0x0d, 0xf8, 0x66, 0xe3, 0x3e, 0xd2, 0xc3, 0xbc, 0x8d, 0xf1, 0xe3, 0x69,
-	0xda, 0x4d, 0xe3, 0x73, 0x34, 0x95, 0xa0, 0x87, 0xad, 0x3d, 0xfb, 0xca, 0xe3, 0xfd, 0xe3, 0xf9,
-	0xcd, 0xf8, 0xb5, 0x66, 0x61, 0x34, 0x33, 0xad, 0xb9, 0x05, 0xf1, 0xe3, 0x89, 0xec, 0x64, 0xf1,
-	0x0a, 0x4d, 0x04, 0xc9, 0xf3, 0x75, 0xeb, 0xb8, 0xab, 0x1c, 0x6e, 0x9f, 0xe3, 0x43, 0x93, 0x33,
-	0x8a, 0xb9, 0x51, 0x3c, 0xd4, 0x8a, 0x2e, 0xc2, 0x8f, 0xc7, 0xa2, 0x93, 0x7c, 0x75, 0xb5, 0x75,
-	0xac, 0xeb, 0xad, 0x63, 0xfd, 0xd8, 0x3a, 0xd6, 0x97, 0x9d, 0x33, 0xb8, 0xde, 0x39, 0x83, 0x6f,
-	0x3b, 0x67, 0xf0, 0xe9, 0x29, 0xcb, 0xc4, 0xc5, 0x65, 0x12, 0x9c, 0x43, 0x11, 0x12, 0x01, 0x05,
-	0x94, 0xf4, 0xd9, 0xc5, 0x65, 0x12, 0x9a, 0xd5, 0xfd, 0xac, 0x96, 0x57, 0xac, 0x2b, 0xca, 0x93,
-	0x7d, 0xb5, 0xb7, 0xcf, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0a, 0xfb, 0x26, 0x23, 0x03,
-	0x00, 0x00,
+	// 619 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7f, 0x3f, 0xfe, 0xcd, 0xa4, 0x45, 0xed, 0xa4, 0x95, 0xac, 0x36, 0xc4, 0xc1,
+	0x12, 0xa8, 0x2c, 0x88, 0xd5, 0xc2, 0x0a, 0xc4, 0xc6, 0x45, 0xa0, 0x2e, 0x90, 0xc2, 0x14, 0x75,
+	0xc1, 0xc6, 0x9a, 0xc4, 0x83, 0x63, 0xc9, 0xf1, 0xb5, 0x3c, 0xd3, 0x11, 0x79, 0x00, 0xf6, 0xec,
+	0xd8, 0xb2, 0xee, 0x93, 0x74, 0xd9, 0x25, 0xab, 0x16, 0xb5, 0x6f, 0xd0, 0x27, 0x40, 0x9e, 0x19,
+	0xf7, 0x23, 0x76, 0xd8, 0xd9, 0x77, 0xce, 0xfd, 0x9d, 0x73, 0xaf, 0x47, 0x46, 0xbd, 0x08, 0x64,
+	0xc4, 0x52, 0x2f, 0x02, 0xe9, 0xc9, 0xbd, 0x21, 0x13, 0x74, 0xcf, 0x8b, 0x58, 0xca, 0x78, 0xcc,
+	0xfb, 0x59, 0x0e, 0x02, 0x30, 0xd6, 0x8a, 0x7e, 0x04, 0xb2, 0x6f, 0x14, 0xdb, 0x9b, 0x11, 0x44,
+	0xa0, 0x8e, 0xbd, 0xe2, 0x49, 0x2b, 0xb7, 0x3b, 0x75, 0x2c, 0x90, 0xfa, 0xd4, 0xfd, 0xd5, 0x44,
+	0xab, 0x1f, 0x34, 0xf9, 0x48, 0x50, 0xc1, 0xf0, 0x27, 0xb4, 0xc9, 0x05, 0xcd, 0x45, 0x9c, 0x46,
+	0x41, 0x96, 0x43, 0x06, 0x9c, 0x26, 0x41, 0x1c, 0xda, 0x56, 0xcf, 0xda, 0x5d, 0xf4, 0x9d, 0x9b,
+	0x0b, 0x67, 0x67, 0x4a, 0x27, 0xc9, 0x6b, 0xb7, 0x4e, 0xe5, 0x12, 0x5c, 0x96, 0x07, 0xa6, 0x7a,
+	0x18, 0xe2, 0x43, 0xb4, 0x12, 0xb2, 0x0c, 0x78, 0x2c, 0xb8, 0xfd, 0x5f, 0x6f, 0x61, 0xb7, 0xb5,
+	0xbf, 0xd3, 0xaf, 0xc6, 0xef, 0xbf, 0xd3, 0x1a, 0x7f, 0xfd, 0xec, 0xc2, 0x69, 0x9c, 0x5e, 0x3a,
+	0x2b, 0xa6, 0xc0, 0xc9, 0x6d, 0x3b, 0x7e, 0x8b, 0x96, 0x24, 0x08, 0xc6, 0xed, 0x05, 0xc5, 0xb1,
+	0xeb, 0x38, 0xc7, 0x20, 0x98, 0xbf, 0x66, 0x20, 0x4b, 0xc5, 0x1b, 0x27, 0xba, 0x0b, 0x7f, 0x44,
+	0xcd, 0x32, 0x2d, 0xb7, 0x17, 0x15, 0xa2, 0x53, 0x87, 0x28, 0xc3, 0xfb, 0x1b, 0x06, 0xd3, 0x2c,
+	0x2b, 0x9c, 0xdc, 0x11, 0x70, 0x84, 0x1e, 0x99, 0x64, 0x41, 0x46, 0x73, 0x3a, 0xe1, 0xf6, 0x52,
+	0xcf, 0xda, 0x6d, 0xed, 0x3f, 0xf9, 0xc7, 0x78, 0x03, 0x25, 0xf4, 0x1f, 0x17, 0xe0, 0x9b, 0x0b,
+	0x67, 0x4b, 0x2f, 0xf3, 0x21, 0xc6, 0x25, 0x6b, 0xe1, 0x7d, 0x35, 0x1e, 0xa1, 0x35, 0x09, 0x7a,
+	0xd9, 0xda, 0x67, 0x59, 0xf9, 0xf4, 0xe6, 0x8c, 0x5f, 0xac, 0x5f, 0xdb, 0x74, 0x8c, 0xcd, 0xa6,
+	0xb6, 0x79, 0x00, 0x71, 0xc9, 0xaa, 0xbc, 0xa7, 0xc5, 0x01, 0x5a, 0x15, 0x34, 0x49, 0xa6, 0xa5,
+	0xc7, 0xff, 0xca, 0xc3, 0xa9, 0xf3, 0xf8, 0x5c, 0xe8, 0x8c, 0xc5, 0x8e, 0xb1, 0x68, 0x6b, 0x8b,
+	0xfb, 0x08, 0x97, 0xb4, 0xc4, 0x9d, 0x12, 0x7f, 0xb7, 0xd0, 0x86, 0xa4, 0x49, 0x1c, 0x52, 0x01,
+	0x79, 0x50, 0x9c, 0xc4, 0x8c, 0xdb, 0x2b, 0xea, 0x33, 0xb8, 0xb5, 0xa3, 0x94, 0x62, 0xe5, 0xe7,
+	0xbf, 0x32, 0x4e, 0xb6, 0x19, 0x66, 0x16, 0xe5, 0x9e, 0x5e, 0x3a, 0xeb, 0x0f, 0x7a, 0x62, 0xc6,
+	0xc9, 0xba, 0x9c, 0xa9, 0xe0, 0x29, 0x6a, 0xab, 0x7c, 0xc1, 0x68, 0x4c, 0xd3, 0x88, 0x05, 0x19,
+	0x24, 0xf1, 0x68, 0x6a, 0x37, 0xd5, 0xbc, 0x4f, 0x6b, 0xef, 0x43, 0x21, 0x3f, 0x50, 0xea, 0x81,
+	0x12, 0xfb, 0xae, 0xc9, 0xb2, 0xad, 0xb3, 0xd4, 0xf0, 0x5c, 0xb2, 0x91, 0xcd, 0xb6, 0xe1, 0x9f,
+	0x16, 0xda, 0x2a, 0xae, 0x62, 0xf0, 0x95, 0xb1, 0x80, 0x67, 0x90, 0x72, 0xc8, 0xf9, 0x38, 0xce,
+	0xb8, 0x8d, 0xd4, 0x1a, 0x9e, 0xcd, 0xbb, 0xd0, 0xef, 0x19, 0x3b, 0xba, 0x93, 0xfb, 0x6f, 0x8c,
+	0x7d, 0xe7, 0xf6, 0xbb, 0x56, 0x91, 0xc5, 0x3a, 0xda, 0xd5, 0x5e, 0x4e, 0xda, 0xb2, 0x5a, 0x54,
+	0xc9, 0xf4, 0xcd, 0x66, 0x79, 0x20, 0x99, 0x80, 0x20, 0x67, 0x23, 0xc8, 0x43, 0x6e, 0xb7, 0xe6,
+	0x27, 0x1b, 0x98, 0x86, 0x63, 0x26, 0x80, 0x28, 0xf9, 0x6c, 0xb2, 0x5a, 0xa4, 0x4a, 0x56, 0xed,
+	0xe5, 0xa4, 0x9d, 0x55, 0x8b, 0xfe, 0xc1, 0xd9, 0x55, 0xd7, 0x3a, 0xbf, 0xea, 0x5a, 0x7f, 0xae,
+	0xba, 0xd6, 0x8f, 0xeb, 0x6e, 0xe3, 0xfc, 0xba, 0xdb, 0xf8, 0x7d, 0xdd, 0x6d, 0x7c, 0x79, 0x1e,
+	0xc5, 0x62, 0x7c, 0x32, 0xec, 0x8f, 0x60, 0xe2, 0x51, 0x01, 0x13, 0x48, 0xd9, 0x8b, 0xf1, 0xc9,
+	0xd0, 0x33, 0x7f, 0xbc, 0x6f, 0xc5, 0x83, 0x27, 0xa6, 0x19, 0xe3, 0xc3, 0x65, 0xf5, 0xbb, 0x7b,
+	0xf9, 0x77, 0x00, 0x5b, 0x78, 0xdb, 0xfe, 0x5a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -181,6 +232,58 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposerVetoRecords) > 0 {
+		for iNdEx := len(m.ProposerVetoRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerVetoRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VoteFeeSponsorships) > 0 {
+		for iNdEx := len(m.VoteFeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteFeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.ParamChangePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.ValidatorTallies) > 0 {
+		for iNdEx := len(m.ValidatorTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -305,6 +408,26 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.TallyParams.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ValidatorTallies) > 0 {
+		for _, e := range m.ValidatorTallies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ParamChangePolicy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.VoteFeeSponsorships) > 0 {
+		for _, e := range m.VoteFeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposerVetoRecords) > 0 {
+		for _, e := range m.ProposerVetoRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -563,6 +686,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTallies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorTallies = append(m.ValidatorTallies, ValidatorTally{})
+			if err := m.ValidatorTallies[len(m.ValidatorTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChangePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParamChangePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteFeeSponsorships = append(m.VoteFeeSponsorships, VoteFeeSponsorship{})
+			if err := m.VoteFeeSponsorships[len(m.VoteFeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerVetoRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerVetoRecords = append(m.ProposerVetoRecords, ProposerVetoRecord{})
+			if err := m.ProposerVetoRecords[len(m.ProposerVetoRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
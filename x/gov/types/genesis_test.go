@@ -2,8 +2,11 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualProposalID(t *testing.T) {
@@ -20,3 +23,47 @@ func TestEqualProposalID(t *testing.T) {
 	require.Equal(t, state1, state2)
 	require.True(t, state1.Equal(state2))
 }
+
+func TestValidateGenesisValidatorTallies(t *testing.T) {
+	proposal, err := NewProposal(NewTextProposal("title", "description"), 1, time.Now(), time.Now())
+	require.NoError(t, err)
+	valAddr := sdk.ValAddress(addrs[0]).String()
+	newTally := func(proposalID uint64, valAddr string) ValidatorTally {
+		return ValidatorTally{
+			ProposalId:       proposalID,
+			ValidatorAddress: valAddr,
+			BondedTokens:     sdk.OneInt(),
+			VotedPower:       sdk.OneInt(),
+			Tally:            NewTallyResult(sdk.OneInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()),
+		}
+	}
+	negativeTally := newTally(1, valAddr)
+	negativeTally.VotedPower = sdk.NewInt(-1)
+
+	tests := []struct {
+		name      string
+		tallies   ValidatorTallies
+		expectErr bool
+	}{
+		{"valid", ValidatorTallies{newTally(1, valAddr)}, false},
+		{"invalid validator address", ValidatorTallies{newTally(1, addrs[0].String())}, true},
+		{"unknown proposal", ValidatorTallies{newTally(2, valAddr)}, true},
+		{"duplicate", ValidatorTallies{newTally(1, valAddr), newTally(1, valAddr)}, true},
+		{"negative voted power", ValidatorTallies{negativeTally}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := DefaultGenesisState()
+			genesis.Proposals = Proposals{proposal}
+			genesis.ValidatorTallies = tt.tallies
+			err := ValidateGenesis(genesis)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -200,6 +200,89 @@ func (m *TextProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TextProposal proto.InternalMessageInfo
 
+// FundVoteFeePoolProposal defines a proposal to transfer coins from the
+// community pool to the vote fee pool, which pays the fees of the votes.
+type FundVoteFeePoolProposal struct {
+	Title       string                                   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string                                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *FundVoteFeePoolProposal) Reset()      { *m = FundVoteFeePoolProposal{} }
+func (*FundVoteFeePoolProposal) ProtoMessage() {}
+func (*FundVoteFeePoolProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{2}
+}
+func (m *FundVoteFeePoolProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundVoteFeePoolProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundVoteFeePoolProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundVoteFeePoolProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundVoteFeePoolProposal.Merge(m, src)
+}
+func (m *FundVoteFeePoolProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundVoteFeePoolProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundVoteFeePoolProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundVoteFeePoolProposal proto.InternalMessageInfo
+
+// UpdateParamsProposal defines a proposal which updates the deposit, voting
+// and tally params by executing a MsgUpdateParams with the gov module account
+// as authority.
+type UpdateParamsProposal struct {
+	Title         string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	DepositParams DepositParams `protobuf:"bytes,3,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params" yaml:"deposit_params"`
+	VotingParams  VotingParams  `protobuf:"bytes,4,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
+	TallyParams   TallyParams   `protobuf:"bytes,5,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+}
+
+func (m *UpdateParamsProposal) Reset()      { *m = UpdateParamsProposal{} }
+func (*UpdateParamsProposal) ProtoMessage() {}
+func (*UpdateParamsProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{3}
+}
+func (m *UpdateParamsProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateParamsProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateParamsProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateParamsProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateParamsProposal.Merge(m, src)
+}
+func (m *UpdateParamsProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateParamsProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateParamsProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateParamsProposal proto.InternalMessageInfo
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -211,7 +294,7 @@ type Deposit struct {
 func (m *Deposit) Reset()      { *m = Deposit{} }
 func (*Deposit) ProtoMessage() {}
 func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{2}
+	return fileDescriptor_ad71a474b39c2291, []int{4}
 }
 func (m *Deposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,12 +334,19 @@ type Proposal struct {
 	TotalDeposit     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_deposit" yaml:"total_deposit"`
 	VotingStartTime  time.Time                                `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time" yaml:"voting_start_time"`
 	VotingEndTime    time.Time                                `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time" yaml:"voting_end_time"`
+	// proposer is the address of the account that submitted the proposal, it
+	// is empty for the proposals submitted before it was recorded.
+	Proposer string `protobuf:"bytes,10,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// metadata is an optional free text attached to the proposal, usually a
+	// link to an off-chain document. It can only be set through the
+	// govgen.gov.v1 Msg service.
+	Metadata string `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Proposal) Reset()      { *m = Proposal{} }
 func (*Proposal) ProtoMessage() {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{3}
+	return fileDescriptor_ad71a474b39c2291, []int{5}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +386,7 @@ type TallyResult struct {
 func (m *TallyResult) Reset()      { *m = TallyResult{} }
 func (*TallyResult) ProtoMessage() {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{4}
+	return fileDescriptor_ad71a474b39c2291, []int{6}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,6 +415,149 @@ func (m *TallyResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyResult proto.InternalMessageInfo
 
+// ValidatorTally defines the voting power of the delegations to a validator
+// that voted on a proposal, split by vote option.
+type ValidatorTally struct {
+	ProposalId       uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty" yaml:"validator_address"`
+	// bonded_tokens is the amount of tokens bonded to the validator at tally
+	// time.
+	BondedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bonded_tokens" yaml:"bonded_tokens"`
+	// voted_power is the voting power of the delegations to the validator whose
+	// delegator voted.
+	VotedPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voted_power,json=votedPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voted_power" yaml:"voted_power"`
+	// tally is the split of voted_power by vote option.
+	Tally TallyResult `protobuf:"bytes,5,opt,name=tally,proto3" json:"tally"`
+}
+
+func (m *ValidatorTally) Reset()      { *m = ValidatorTally{} }
+func (*ValidatorTally) ProtoMessage() {}
+func (*ValidatorTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{7}
+}
+func (m *ValidatorTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorTally.Merge(m, src)
+}
+func (m *ValidatorTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorTally proto.InternalMessageInfo
+
+// VoteFeeSponsorship defines the fees of the votes of an account on a proposal
+// which were paid by the vote fee pool.
+type VoteFeeSponsorship struct {
+	ProposalId uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	Voter      string                                   `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Spent      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *VoteFeeSponsorship) Reset()      { *m = VoteFeeSponsorship{} }
+func (*VoteFeeSponsorship) ProtoMessage() {}
+func (*VoteFeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{8}
+}
+func (m *VoteFeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteFeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteFeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteFeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteFeeSponsorship.Merge(m, src)
+}
+func (m *VoteFeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteFeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteFeeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteFeeSponsorship proto.InternalMessageInfo
+
+// ProposalStatusDetail defines the projected outcome of a proposal in voting
+// period, computed from the votes cast so far against the current total
+// bonded power.
+type ProposalStatusDetail struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
+	// tally is the current tally of the votes.
+	Tally TallyResult `protobuf:"bytes,2,opt,name=tally,proto3" json:"tally"`
+	// total_bonded is the current total bonded power.
+	TotalBonded github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_bonded,json=totalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_bonded" yaml:"total_bonded"`
+	// turnout is the share of the total bonded power that voted.
+	Turnout          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=turnout,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"turnout"`
+	QuorumReached    bool                                   `protobuf:"varint,5,opt,name=quorum_reached,json=quorumReached,proto3" json:"quorum_reached,omitempty" yaml:"quorum_reached"`
+	ThresholdReached bool                                   `protobuf:"varint,6,opt,name=threshold_reached,json=thresholdReached,proto3" json:"threshold_reached,omitempty" yaml:"threshold_reached"`
+	VetoReached      bool                                   `protobuf:"varint,7,opt,name=veto_reached,json=vetoReached,proto3" json:"veto_reached,omitempty" yaml:"veto_reached"`
+	// passes is the outcome of the proposal if the voting period ended now.
+	Passes bool `protobuf:"varint,8,opt,name=passes,proto3" json:"passes,omitempty"`
+	// power_to_flip is the voting power that would have to be added to flip the
+	// projected outcome, in Yes votes if the proposal doesn't pass and in No or
+	// NoWithVeto votes otherwise.
+	PowerToFlip github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=power_to_flip,json=powerToFlip,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"power_to_flip" yaml:"power_to_flip"`
+	// time_remaining is the time left before the end of the voting period.
+	TimeRemaining time.Duration `protobuf:"bytes,10,opt,name=time_remaining,json=timeRemaining,proto3,stdduration" json:"time_remaining" yaml:"time_remaining"`
+}
+
+func (m *ProposalStatusDetail) Reset()      { *m = ProposalStatusDetail{} }
+func (*ProposalStatusDetail) ProtoMessage() {}
+func (*ProposalStatusDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{9}
+}
+func (m *ProposalStatusDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalStatusDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalStatusDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalStatusDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalStatusDetail.Merge(m, src)
+}
+func (m *ProposalStatusDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalStatusDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalStatusDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalStatusDetail proto.InternalMessageInfo
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -336,12 +569,15 @@ type Vote struct {
 	Option VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=govgen.gov.v1beta1.VoteOption" json:"option,omitempty"` // Deprecated: Do not use.
 	// Since: cosmos-sdk 0.43
 	Options []WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options"`
+	// rationale is an optional free text explaining the vote, its length is
+	// bounded by the max_vote_rationale_length voting param.
+	Rationale string `protobuf:"bytes,5,opt,name=rationale,proto3" json:"rationale,omitempty"`
 }
 
 func (m *Vote) Reset()      { *m = Vote{} }
 func (*Vote) ProtoMessage() {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{5}
+	return fileDescriptor_ad71a474b39c2291, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -377,12 +613,31 @@ type DepositParams struct {
 	//  Maximum period for GOVGEN holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty" yaml:"max_deposit_period"`
+	// Type URLs of the proposal contents that can be submitted. All the contents
+	// with a registered proposal handler can be submitted if empty.
+	AllowedContentTypes []string `protobuf:"bytes,3,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty" yaml:"allowed_content_types"`
+	// Maximum number of proposals in deposit or voting period that a single
+	// proposer can have at once. A value of 0 means no limit.
+	MaxProposalsPerProposer uint64 `protobuf:"varint,4,opt,name=max_proposals_per_proposer,json=maxProposalsPerProposer,proto3" json:"max_proposals_per_proposer,omitempty" yaml:"max_proposals_per_proposer"`
+	// Period during which a proposer whose proposal was vetoed can't submit new
+	// proposals. A value of 0 disables the cooldown.
+	VetoCooldownPeriod time.Duration `protobuf:"bytes,5,opt,name=veto_cooldown_period,json=vetoCooldownPeriod,proto3,stdduration" json:"veto_cooldown_period,omitempty" yaml:"veto_cooldown_period"`
+	// Increase of the minimum deposit of the proposals of a proposer for each of
+	// its vetoed proposals. The minimum deposit is multiplied by
+	// 1 + veto_min_deposit_multiplier * vetoed_proposals. A value of 0 disables
+	// the increase.
+	VetoMinDepositMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=veto_min_deposit_multiplier,json=vetoMinDepositMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"veto_min_deposit_multiplier,omitempty" yaml:"veto_min_deposit_multiplier"`
+	// Ratio of the deposits of a proposal which fails to reach the minimum
+	// deposit before the end of its deposit period that is refunded to the
+	// depositors, the rest being burned. A value of 0 burns the deposits, 1
+	// refunds them.
+	InactiveProposalRefundRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=inactive_proposal_refund_ratio,json=inactiveProposalRefundRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inactive_proposal_refund_ratio,omitempty" yaml:"inactive_proposal_refund_ratio"`
 }
 
 func (m *DepositParams) Reset()      { *m = DepositParams{} }
 func (*DepositParams) ProtoMessage() {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{6}
+	return fileDescriptor_ad71a474b39c2291, []int{11}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,12 +677,30 @@ type VotingParams struct {
 	VotingPeriodSoftwareUpgrade time.Duration `protobuf:"bytes,3,opt,name=voting_period_software_upgrade,json=votingPeriodSoftwareUpgrade,proto3,stdduration" json:"voting_period_software_upgrade,omitempty" yaml:"voting_period_software_upgrade"`
 	// Length of the voting period for text proposal.
 	VotingPeriodText time.Duration `protobuf:"bytes,4,opt,name=voting_period_text,json=votingPeriodText,proto3,stdduration" json:"voting_period_text,omitempty" yaml:"voting_period_text"`
+	// Maximum length in bytes of the rationale attached to a vote. A value of 0
+	// means that votes can't carry a rationale.
+	MaxVoteRationaleLength uint64 `protobuf:"varint,5,opt,name=max_vote_rationale_length,json=maxVoteRationaleLength,proto3" json:"max_vote_rationale_length,omitempty" yaml:"max_vote_rationale_length"`
+	// Number of blocks between two checks for active proposals whose outcome
+	// can no longer be changed by the remaining voting power. Such proposals
+	// have their voting period ended early. A value of 0 disables early
+	// termination.
+	EarlyTerminationCheckInterval uint64 `protobuf:"varint,6,opt,name=early_termination_check_interval,json=earlyTerminationCheckInterval,proto3" json:"early_termination_check_interval,omitempty" yaml:"early_termination_check_interval"`
+	// Maximum fees paid by the vote fee pool for the votes of an account with
+	// bonded stake on a proposal. An empty budget disables the sponsorship.
+	VoteFeeSponsorshipBudget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=vote_fee_sponsorship_budget,json=voteFeeSponsorshipBudget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vote_fee_sponsorship_budget,omitempty" yaml:"vote_fee_sponsorship_budget"`
+	// Maximum number of proposals and votes an account can submit in a sliding
+	// window of blocks.
+	GovMsgRateLimit GovMsgRateLimit `protobuf:"bytes,8,opt,name=gov_msg_rate_limit,json=govMsgRateLimit,proto3" json:"gov_msg_rate_limit,omitempty" yaml:"gov_msg_rate_limit"`
+	// Minimum amount of stake an account must have bonded for the vote fee pool
+	// to pay the fees of its votes, so the pool can't be drained by splitting
+	// stake across many accounts.
+	VoteFeeSponsorshipMinBonded github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=vote_fee_sponsorship_min_bonded,json=voteFeeSponsorshipMinBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vote_fee_sponsorship_min_bonded,omitempty" yaml:"vote_fee_sponsorship_min_bonded"`
 }
 
 func (m *VotingParams) Reset()      { *m = VotingParams{} }
 func (*VotingParams) ProtoMessage() {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{7}
+	return fileDescriptor_ad71a474b39c2291, []int{12}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,6 +729,131 @@ func (m *VotingParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VotingParams proto.InternalMessageInfo
 
+// GovMsgRateLimit defines the maximum number of governance messages an account
+// can send in a sliding window of blocks, including the messages executed on
+// its behalf through authz.
+type GovMsgRateLimit struct {
+	// Number of blocks of the sliding window. A value of 0 disables the rate
+	// limit.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// Maximum number of proposals submitted in the window. A value of 0 means
+	// no limit.
+	MaxProposals uint64 `protobuf:"varint,2,opt,name=max_proposals,json=maxProposals,proto3" json:"max_proposals,omitempty" yaml:"max_proposals"`
+	// Maximum number of votes cast in the window. A value of 0 means no limit.
+	MaxVotes uint64 `protobuf:"varint,3,opt,name=max_votes,json=maxVotes,proto3" json:"max_votes,omitempty" yaml:"max_votes"`
+}
+
+func (m *GovMsgRateLimit) Reset()      { *m = GovMsgRateLimit{} }
+func (*GovMsgRateLimit) ProtoMessage() {}
+func (*GovMsgRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{13}
+}
+func (m *GovMsgRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovMsgRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovMsgRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovMsgRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovMsgRateLimit.Merge(m, src)
+}
+func (m *GovMsgRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovMsgRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovMsgRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovMsgRateLimit proto.InternalMessageInfo
+
+// ProposerVetoRecord defines the number of vetoed proposals of a proposer and
+// the end of its cooldown, during which it can't submit new proposals.
+type ProposerVetoRecord struct {
+	Proposer        string    `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	VetoedProposals uint64    `protobuf:"varint,2,opt,name=vetoed_proposals,json=vetoedProposals,proto3" json:"vetoed_proposals,omitempty" yaml:"vetoed_proposals"`
+	CooldownEndTime time.Time `protobuf:"bytes,3,opt,name=cooldown_end_time,json=cooldownEndTime,proto3,stdtime" json:"cooldown_end_time" yaml:"cooldown_end_time"`
+}
+
+func (m *ProposerVetoRecord) Reset()      { *m = ProposerVetoRecord{} }
+func (*ProposerVetoRecord) ProtoMessage() {}
+func (*ProposerVetoRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{14}
+}
+func (m *ProposerVetoRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerVetoRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerVetoRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerVetoRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerVetoRecord.Merge(m, src)
+}
+func (m *ProposerVetoRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerVetoRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerVetoRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerVetoRecord proto.InternalMessageInfo
+
+// GovMsgCount defines the number of proposals and votes sent by an account at
+// a block height, used to enforce the GovMsgRateLimit.
+type GovMsgCount struct {
+	Proposals uint64 `protobuf:"varint,1,opt,name=proposals,proto3" json:"proposals,omitempty"`
+	Votes     uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *GovMsgCount) Reset()      { *m = GovMsgCount{} }
+func (*GovMsgCount) ProtoMessage() {}
+func (*GovMsgCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{15}
+}
+func (m *GovMsgCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovMsgCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovMsgCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovMsgCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovMsgCount.Merge(m, src)
+}
+func (m *GovMsgCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovMsgCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovMsgCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovMsgCount proto.InternalMessageInfo
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -471,7 +869,7 @@ type TallyParams struct {
 func (m *TallyParams) Reset()      { *m = TallyParams{} }
 func (*TallyParams) ProtoMessage() {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad71a474b39c2291, []int{8}
+	return fileDescriptor_ad71a474b39c2291, []int{16}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,124 +898,305 @@ func (m *TallyParams) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TallyParams proto.InternalMessageInfo
 
+// ParamChangePolicy defines the restrictions on the parameters that can be
+// changed by a parameter change proposal.
+type ParamChangePolicy struct {
+	// If true, only the parameters matched by a rule that doesn't deny them can
+	// be changed, otherwise all the parameters not denied by a rule can be
+	// changed.
+	Allowlist bool `protobuf:"varint,1,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// Rules applying to the parameters. A rule for a key takes precedence over a
+	// rule for its whole subspace.
+	Rules []ParamChangeRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (m *ParamChangePolicy) Reset()      { *m = ParamChangePolicy{} }
+func (*ParamChangePolicy) ProtoMessage() {}
+func (*ParamChangePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{17}
+}
+func (m *ParamChangePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangePolicy.Merge(m, src)
+}
+func (m *ParamChangePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangePolicy proto.InternalMessageInfo
+
+// ParamChangeRule defines the rule applying to a parameter, or to all the
+// parameters of a subspace.
+type ParamChangeRule struct {
+	// Subspace of the parameters.
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	// Key of the parameter, the rule applies to all the parameters of the
+	// subspace if empty.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// If true, the parameters can't be changed.
+	Denied bool `protobuf:"varint,3,opt,name=denied,proto3" json:"denied,omitempty"`
+	// Minimum proportion of Yes votes for a proposal changing the parameters to
+	// pass. It only applies when stricter than the tally threshold, zero means
+	// the tally threshold.
+	Threshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=threshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"threshold,omitempty"`
+}
+
+func (m *ParamChangeRule) Reset()      { *m = ParamChangeRule{} }
+func (*ParamChangeRule) ProtoMessage() {}
+func (*ParamChangeRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad71a474b39c2291, []int{18}
+}
+func (m *ParamChangeRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeRule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamChangeRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeRule.Merge(m, src)
+}
+func (m *ParamChangeRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeRule proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("govgen.gov.v1beta1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("govgen.gov.v1beta1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "govgen.gov.v1beta1.WeightedVoteOption")
 	proto.RegisterType((*TextProposal)(nil), "govgen.gov.v1beta1.TextProposal")
+	proto.RegisterType((*FundVoteFeePoolProposal)(nil), "govgen.gov.v1beta1.FundVoteFeePoolProposal")
+	proto.RegisterType((*UpdateParamsProposal)(nil), "govgen.gov.v1beta1.UpdateParamsProposal")
 	proto.RegisterType((*Deposit)(nil), "govgen.gov.v1beta1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "govgen.gov.v1beta1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "govgen.gov.v1beta1.TallyResult")
+	proto.RegisterType((*ValidatorTally)(nil), "govgen.gov.v1beta1.ValidatorTally")
+	proto.RegisterType((*VoteFeeSponsorship)(nil), "govgen.gov.v1beta1.VoteFeeSponsorship")
+	proto.RegisterType((*ProposalStatusDetail)(nil), "govgen.gov.v1beta1.ProposalStatusDetail")
 	proto.RegisterType((*Vote)(nil), "govgen.gov.v1beta1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "govgen.gov.v1beta1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "govgen.gov.v1beta1.VotingParams")
+	proto.RegisterType((*GovMsgRateLimit)(nil), "govgen.gov.v1beta1.GovMsgRateLimit")
+	proto.RegisterType((*ProposerVetoRecord)(nil), "govgen.gov.v1beta1.ProposerVetoRecord")
+	proto.RegisterType((*GovMsgCount)(nil), "govgen.gov.v1beta1.GovMsgCount")
 	proto.RegisterType((*TallyParams)(nil), "govgen.gov.v1beta1.TallyParams")
+	proto.RegisterType((*ParamChangePolicy)(nil), "govgen.gov.v1beta1.ParamChangePolicy")
+	proto.RegisterType((*ParamChangeRule)(nil), "govgen.gov.v1beta1.ParamChangeRule")
 }
 
 func init() { proto.RegisterFile("govgen/gov/v1beta1/gov.proto", fileDescriptor_ad71a474b39c2291) }
 
 var fileDescriptor_ad71a474b39c2291 = []byte{
-	// 1593 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x41, 0x6c, 0x1a, 0xc7,
-	0x1a, 0x66, 0x01, 0x63, 0x33, 0x60, 0x9b, 0x8c, 0x1d, 0x07, 0x13, 0xbf, 0x5d, 0xde, 0xbe, 0xf7,
-	0x22, 0xbf, 0x28, 0x81, 0xc4, 0xef, 0xa9, 0x55, 0x1d, 0xa9, 0x2d, 0x18, 0xdc, 0xd0, 0x46, 0x06,
-	0x2d, 0x04, 0x2b, 0xa9, 0xaa, 0xd5, 0x02, 0x63, 0xd8, 0x96, 0xdd, 0xa1, 0xec, 0xe0, 0xd8, 0xb7,
-	0x1e, 0x23, 0x0e, 0x55, 0x8e, 0x51, 0x2b, 0xa4, 0x48, 0x55, 0x2f, 0x3d, 0xf7, 0xd8, 0xf6, 0x6c,
-	0x55, 0x95, 0x1a, 0xf5, 0x14, 0xb5, 0x12, 0x69, 0x6c, 0xa9, 0x8a, 0x7c, 0xf4, 0xa1, 0xe7, 0x6a,
-	0x77, 0x66, 0xcd, 0x2e, 0xa0, 0x12, 0x72, 0xca, 0xee, 0xcc, 0xf7, 0x7d, 0xff, 0x37, 0xbf, 0xff,
-	0xff, 0x9f, 0x0d, 0x60, 0xad, 0x8e, 0xf7, 0xeb, 0x48, 0x4f, 0xd6, 0xf1, 0x7e, 0x72, 0xff, 0x66,
-	0x05, 0x11, 0xe5, 0xa6, 0xf9, 0x9c, 0x68, 0xb5, 0x31, 0xc1, 0x10, 0xd2, 0xdd, 0x84, 0xb9, 0xc2,
-	0x76, 0x63, 0x7c, 0x15, 0x1b, 0x1a, 0x36, 0x92, 0x15, 0xc5, 0x40, 0xe7, 0x94, 0x2a, 0x56, 0x75,
-	0xca, 0x89, 0x2d, 0xd7, 0x71, 0x1d, 0x5b, 0x8f, 0x49, 0xf3, 0x89, 0xad, 0xae, 0x52, 0x96, 0x4c,
-	0x37, 0xe8, 0x0b, 0xdb, 0x12, 0xea, 0x18, 0xd7, 0x9b, 0x28, 0x69, 0xbd, 0x55, 0x3a, 0x7b, 0x49,
-	0xa2, 0x6a, 0xc8, 0x20, 0x8a, 0xd6, 0xb2, 0xb9, 0xc3, 0x00, 0x45, 0x3f, 0x64, 0x5b, 0xfc, 0xf0,
-	0x56, 0xad, 0xd3, 0x56, 0x88, 0x8a, 0x99, 0x19, 0xf1, 0x6b, 0x0e, 0xc0, 0x5d, 0xa4, 0xd6, 0x1b,
-	0x04, 0xd5, 0xca, 0x98, 0xa0, 0x7c, 0xcb, 0xdc, 0x84, 0x6f, 0x80, 0x00, 0xb6, 0x9e, 0xa2, 0x5c,
-	0x9c, 0x5b, 0x5f, 0xd8, 0xe0, 0x13, 0xa3, 0x07, 0x4d, 0x0c, 0xf0, 0x12, 0x43, 0xc3, 0x5d, 0x10,
-	0x78, 0x60, 0xa9, 0x45, 0xbd, 0x71, 0x6e, 0x3d, 0x98, 0x7e, 0xe7, 0xa8, 0x2f, 0x78, 0x7e, 0xed,
-	0x0b, 0x57, 0xea, 0x2a, 0x69, 0x74, 0x2a, 0x89, 0x2a, 0xd6, 0xd8, 0xd9, 0xd8, 0x3f, 0xd7, 0x8d,
-	0xda, 0x27, 0x49, 0x72, 0xd8, 0x42, 0x46, 0x22, 0x83, 0xaa, 0x67, 0x7d, 0x61, 0xfe, 0x50, 0xd1,
-	0x9a, 0x9b, 0x22, 0x55, 0x11, 0x25, 0x26, 0x27, 0xee, 0x82, 0x70, 0x09, 0x1d, 0x90, 0x42, 0x1b,
-	0xb7, 0xb0, 0xa1, 0x34, 0xe1, 0x32, 0x98, 0x21, 0x2a, 0x69, 0x22, 0xcb, 0x5f, 0x50, 0xa2, 0x2f,
-	0x30, 0x0e, 0x42, 0x35, 0x64, 0x54, 0xdb, 0x2a, 0xf5, 0x6e, 0x79, 0x90, 0x9c, 0x4b, 0x9b, 0x8b,
-	0x2f, 0x9f, 0x08, 0xdc, 0x2f, 0xdf, 0x5e, 0x9f, 0xdd, 0xc2, 0x3a, 0x41, 0x3a, 0x11, 0x7f, 0xe6,
-	0xc0, 0x6c, 0x06, 0xb5, 0xb0, 0xa1, 0x12, 0xf8, 0x26, 0x08, 0xb5, 0x58, 0x00, 0x59, 0xad, 0x59,
-	0xd2, 0xfe, 0xf4, 0xca, 0x59, 0x5f, 0x80, 0xd4, 0x94, 0x63, 0x53, 0x94, 0x80, 0xfd, 0x96, 0xab,
-	0xc1, 0x35, 0x10, 0xac, 0x51, 0x0d, 0xdc, 0x66, 0x51, 0x07, 0x0b, 0xb0, 0x0a, 0x02, 0x8a, 0x86,
-	0x3b, 0x3a, 0x89, 0xfa, 0xe2, 0xbe, 0xf5, 0xd0, 0xc6, 0x6a, 0x82, 0xfd, 0x79, 0xcd, 0x0a, 0x39,
-	0xcf, 0xe6, 0x16, 0x56, 0xf5, 0xf4, 0x0d, 0x33, 0x5f, 0xdf, 0x3c, 0x17, 0xd6, 0x5f, 0x21, 0x5f,
-	0x26, 0xc1, 0x90, 0x98, 0xf4, 0xe6, 0xdc, 0xc3, 0x27, 0x82, 0xe7, 0xe5, 0x13, 0xc1, 0x23, 0xfe,
-	0x19, 0x00, 0x73, 0xe7, 0x79, 0xfa, 0xff, 0xb8, 0x23, 0x2d, 0x9d, 0xf6, 0x05, 0xaf, 0x5a, 0x3b,
-	0xeb, 0x0b, 0x41, 0x7a, 0xb0, 0xe1, 0xf3, 0xdc, 0x02, 0xb3, 0x55, 0x9a, 0x1f, 0xeb, 0x34, 0xa1,
-	0x8d, 0xe5, 0x04, 0xad, 0xa3, 0x84, 0x5d, 0x47, 0x89, 0x94, 0x7e, 0x98, 0x0e, 0xfd, 0x38, 0x48,
-	0xa4, 0x64, 0x33, 0x60, 0x19, 0x04, 0x0c, 0xa2, 0x90, 0x8e, 0x11, 0xf5, 0x59, 0xb5, 0x23, 0x8e,
-	0xab, 0x1d, 0xdb, 0x60, 0xd1, 0x42, 0xa6, 0x63, 0x67, 0x7d, 0x61, 0x65, 0x28, 0xc9, 0x54, 0x44,
-	0x94, 0x98, 0x1a, 0x6c, 0x01, 0xb8, 0xa7, 0xea, 0x4a, 0x53, 0x26, 0x4a, 0xb3, 0x79, 0x28, 0xb7,
-	0x91, 0xd1, 0x69, 0x92, 0xa8, 0xdf, 0xf2, 0x27, 0x8c, 0x8b, 0x51, 0x32, 0x71, 0x92, 0x05, 0x4b,
-	0xff, 0xd3, 0x4c, 0xec, 0x59, 0x5f, 0x58, 0xa5, 0x41, 0x46, 0x85, 0x44, 0x29, 0x62, 0x2d, 0x3a,
-	0x48, 0xf0, 0x43, 0x10, 0x32, 0x3a, 0x15, 0x4d, 0x25, 0xb2, 0xd9, 0x71, 0xd1, 0x19, 0x2b, 0x54,
-	0x6c, 0x24, 0x15, 0x25, 0xbb, 0x1d, 0xd3, 0x3c, 0x8b, 0xc2, 0xea, 0xc5, 0x41, 0x16, 0x1f, 0x3d,
-	0x17, 0x38, 0x09, 0xd0, 0x15, 0x93, 0x00, 0x55, 0x10, 0x61, 0x25, 0x22, 0x23, 0xbd, 0x46, 0x23,
-	0x04, 0x26, 0x46, 0xf8, 0x17, 0x8b, 0x70, 0x89, 0x46, 0x18, 0x56, 0xa0, 0x61, 0x16, 0xd8, 0x72,
-	0x56, 0xaf, 0x59, 0xa1, 0x1e, 0x72, 0x60, 0x9e, 0x60, 0xa2, 0x34, 0x65, 0xb6, 0x11, 0x9d, 0x9d,
-	0x54, 0x88, 0xb7, 0x59, 0x9c, 0x65, 0x1a, 0xc7, 0xc5, 0x16, 0xa7, 0x2a, 0xd0, 0xb0, 0xc5, 0xb5,
-	0x5b, 0xac, 0x09, 0x2e, 0xec, 0x63, 0xa2, 0xea, 0x75, 0xf3, 0xcf, 0xdb, 0x66, 0x89, 0x9d, 0x9b,
-	0x78, 0xec, 0x7f, 0x33, 0x3b, 0x51, 0x6a, 0x67, 0x44, 0x82, 0x9e, 0x7b, 0x91, 0xae, 0x17, 0xcd,
-	0x65, 0xeb, 0xe0, 0x7b, 0x80, 0x2d, 0x0d, 0x52, 0x1c, 0x9c, 0x18, 0x4b, 0x64, 0xb1, 0x56, 0x5c,
-	0xb1, 0xdc, 0x19, 0x9e, 0xa7, 0xab, 0x2c, 0xc1, 0x9b, 0x7e, 0x73, 0xaa, 0x88, 0x47, 0x5e, 0x10,
-	0x72, 0x96, 0xcf, 0xbb, 0xc0, 0x77, 0x88, 0x0c, 0x3a, 0xa1, 0xd2, 0x89, 0x29, 0x26, 0x61, 0x4e,
-	0x27, 0x92, 0x49, 0x85, 0xb7, 0xc1, 0xac, 0x52, 0x31, 0x88, 0xa2, 0xb2, 0x59, 0x36, 0xb5, 0x8a,
-	0x4d, 0x87, 0x6f, 0x03, 0xaf, 0x8e, 0xad, 0x86, 0x9c, 0x5e, 0xc4, 0xab, 0x63, 0x58, 0x07, 0x61,
-	0x1d, 0xcb, 0x0f, 0x54, 0xd2, 0x90, 0xf7, 0x11, 0xc1, 0x56, 0xdb, 0x05, 0xd3, 0xd9, 0xe9, 0x94,
-	0xce, 0xfa, 0xc2, 0x12, 0x4d, 0xaa, 0x53, 0x4b, 0x94, 0x80, 0x8e, 0x77, 0x55, 0xd2, 0x28, 0x23,
-	0x82, 0x59, 0x2a, 0x4f, 0x38, 0xe0, 0x37, 0xaf, 0x97, 0xd7, 0x1f, 0xc9, 0xcb, 0x60, 0x66, 0x1f,
-	0x13, 0x64, 0x8f, 0x63, 0xfa, 0x02, 0x37, 0xcf, 0xef, 0x35, 0xdf, 0xab, 0xdc, 0x6b, 0x69, 0x6f,
-	0x94, 0x3b, 0xbf, 0xdb, 0xb6, 0xc1, 0x2c, 0x7d, 0x32, 0xa2, 0x7e, 0xab, 0x7d, 0xae, 0x8c, 0x23,
-	0x8f, 0x5e, 0xa6, 0x69, 0xbf, 0x99, 0x25, 0xc9, 0x26, 0x6f, 0xce, 0x3d, 0xb6, 0x27, 0xf5, 0x0f,
-	0x5e, 0x30, 0xcf, 0x1a, 0xa3, 0xa0, 0xb4, 0x15, 0xcd, 0x80, 0x5f, 0x72, 0x20, 0xa4, 0xa9, 0xfa,
-	0x79, 0x9f, 0x72, 0x93, 0xfa, 0x54, 0x36, 0xb5, 0x4f, 0xfb, 0xc2, 0x45, 0x07, 0xeb, 0x1a, 0xd6,
-	0x54, 0x82, 0xb4, 0x16, 0x39, 0x1c, 0xe4, 0xc9, 0xb1, 0x3d, 0x5d, 0xfb, 0x02, 0x4d, 0xd5, 0xed,
-	0xe6, 0xfd, 0x9c, 0x03, 0x50, 0x53, 0x0e, 0x6c, 0x21, 0xb9, 0x85, 0xda, 0x2a, 0xae, 0xb1, 0x2b,
-	0x62, 0x75, 0xa4, 0xa5, 0x32, 0xec, 0x53, 0x83, 0x96, 0xc9, 0x69, 0x5f, 0x58, 0x1b, 0x25, 0xbb,
-	0xbc, 0xb2, 0xe1, 0x3c, 0x8a, 0x12, 0x1f, 0x9b, 0x4d, 0x17, 0xd1, 0x94, 0x03, 0x3b, 0x5d, 0x74,
-	0xf9, 0xbb, 0x19, 0x10, 0x2e, 0x5b, 0x9d, 0xc8, 0xf2, 0xf7, 0x05, 0x07, 0x2e, 0xb2, 0x86, 0xa5,
-	0x4c, 0xb9, 0x86, 0xf6, 0x14, 0xf3, 0x9e, 0xe0, 0x26, 0x99, 0xfc, 0x80, 0x99, 0x14, 0xc6, 0xf2,
-	0x5d, 0x3e, 0xd7, 0x5c, 0x93, 0xc1, 0x0d, 0xa4, 0x56, 0x97, 0xe8, 0x1e, 0xb5, 0x99, 0xa1, 0x3b,
-	0xf0, 0x7b, 0x0e, 0xf0, 0x6e, 0x4e, 0xcb, 0x74, 0x8d, 0x08, 0x6a, 0xcb, 0xd5, 0x86, 0xa2, 0xd7,
-	0xd1, 0xe4, 0x54, 0x7e, 0xc4, 0x5c, 0xae, 0xff, 0xbd, 0x90, 0xcb, 0xee, 0x7f, 0xc6, 0xd9, 0x1d,
-	0x66, 0x50, 0xdf, 0x97, 0x9d, 0xbe, 0x0b, 0x36, 0x64, 0xcb, 0x42, 0x8c, 0xf1, 0x6f, 0xe0, 0x3d,
-	0xf2, 0x40, 0x69, 0x23, 0xb9, 0xd3, 0xaa, 0xb7, 0x95, 0x1a, 0xb2, 0xba, 0xea, 0x75, 0xfc, 0x0f,
-	0x0b, 0x4d, 0xf6, 0x3f, 0xcc, 0x18, 0xe3, 0xbf, 0xc8, 0x20, 0x77, 0x29, 0xc2, 0x2a, 0x5f, 0xb7,
-	0x08, 0x41, 0x07, 0xf6, 0x17, 0xc4, 0xab, 0x94, 0xef, 0x28, 0x79, 0x5c, 0xf9, 0x8e, 0xa2, 0x58,
-	0xf9, 0x3a, 0xbd, 0x99, 0x1f, 0xb3, 0xe2, 0x6f, 0xf6, 0x85, 0xc1, 0xaa, 0xf7, 0x3e, 0x08, 0x7c,
-	0xda, 0xc1, 0xed, 0x8e, 0x66, 0x55, 0x6b, 0x38, 0x9d, 0x9e, 0xee, 0xeb, 0xf9, 0xb4, 0x2f, 0x44,
-	0x28, 0x7f, 0x60, 0x4b, 0x62, 0x8a, 0xb0, 0x0a, 0x82, 0xa4, 0xd1, 0x46, 0x46, 0x03, 0x37, 0x69,
-	0xc7, 0x86, 0xa7, 0x9a, 0xde, 0x54, 0x7e, 0xe9, 0x5c, 0xc2, 0x11, 0x61, 0xa0, 0x0b, 0xbb, 0x1c,
-	0x58, 0x30, 0x47, 0xba, 0x3c, 0x08, 0xe5, 0xb3, 0x42, 0x55, 0xa7, 0x0e, 0x15, 0x75, 0xeb, 0xb8,
-	0x12, 0x7d, 0x91, 0x25, 0xda, 0x85, 0x10, 0xa5, 0x79, 0x73, 0xa1, 0x64, 0xbf, 0x5f, 0xfd, 0x83,
-	0x03, 0xc0, 0xf1, 0x5f, 0x9a, 0x6b, 0xe0, 0x52, 0x39, 0x5f, 0xca, 0xca, 0xf9, 0x42, 0x29, 0x97,
-	0xdf, 0x91, 0xef, 0xee, 0x14, 0x0b, 0xd9, 0xad, 0xdc, 0x76, 0x2e, 0x9b, 0x89, 0x78, 0x62, 0x8b,
-	0xdd, 0x5e, 0x3c, 0x44, 0x81, 0x59, 0x33, 0x08, 0x14, 0xc1, 0xa2, 0x13, 0x7d, 0x2f, 0x5b, 0x8c,
-	0x70, 0xb1, 0xf9, 0x6e, 0x2f, 0x1e, 0xa4, 0xa8, 0x7b, 0xc8, 0x80, 0x57, 0xc1, 0x92, 0x13, 0x93,
-	0x4a, 0x17, 0x4b, 0xa9, 0xdc, 0x4e, 0xc4, 0x1b, 0xbb, 0xd0, 0xed, 0xc5, 0xe7, 0x29, 0x2e, 0xc5,
-	0xee, 0xdf, 0x38, 0x58, 0x70, 0x62, 0x77, 0xf2, 0x11, 0x5f, 0x2c, 0xdc, 0xed, 0xc5, 0xe7, 0x28,
-	0x6c, 0x07, 0xc3, 0x0d, 0x10, 0x75, 0x23, 0xe4, 0xdd, 0x5c, 0xe9, 0xb6, 0x5c, 0xce, 0x96, 0xf2,
-	0x11, 0x7f, 0x6c, 0xb9, 0xdb, 0x8b, 0x47, 0x6c, 0xac, 0x7d, 0x59, 0xc6, 0xfc, 0x0f, 0xbf, 0xe2,
-	0x3d, 0x57, 0x7f, 0xf2, 0x82, 0x05, 0xf7, 0xf7, 0x34, 0x4c, 0x80, 0xcb, 0x05, 0x29, 0x5f, 0xc8,
-	0x17, 0x53, 0x77, 0xe4, 0x62, 0x29, 0x55, 0xba, 0x5b, 0x1c, 0x3a, 0xb0, 0x75, 0x14, 0x0a, 0xde,
-	0x51, 0x9b, 0xf0, 0x16, 0xe0, 0x87, 0xf1, 0x99, 0x6c, 0x21, 0x5f, 0xcc, 0x95, 0xe4, 0x42, 0x56,
-	0xca, 0xe5, 0x33, 0x11, 0x2e, 0x76, 0xa9, 0xdb, 0x8b, 0x2f, 0x51, 0x8a, 0x6b, 0x0a, 0xc3, 0xb7,
-	0xc0, 0x3f, 0x86, 0xc9, 0xe5, 0x7c, 0x29, 0xb7, 0xf3, 0x9e, 0xcd, 0xf5, 0xc6, 0x56, 0xba, 0xbd,
-	0x38, 0xa4, 0xdc, 0xb2, 0xa3, 0x0b, 0xe0, 0x35, 0xb0, 0x32, 0x4c, 0x2d, 0xa4, 0x8a, 0xc5, 0x6c,
-	0x26, 0xe2, 0x8b, 0x45, 0xba, 0xbd, 0x78, 0x98, 0x72, 0x0a, 0x8a, 0x61, 0xa0, 0x1a, 0xbc, 0x01,
-	0xa2, 0xc3, 0x68, 0x29, 0xfb, 0x7e, 0x76, 0xab, 0x94, 0xcd, 0x44, 0xfc, 0x31, 0xd8, 0xed, 0xc5,
-	0x17, 0x28, 0x5e, 0x42, 0x1f, 0xa3, 0x2a, 0x41, 0x63, 0xf5, 0xb7, 0x53, 0xb9, 0x3b, 0xd9, 0x4c,
-	0x64, 0xc6, 0xa9, 0xbf, 0xad, 0xa8, 0x4d, 0x54, 0xa3, 0xe9, 0x4c, 0xe7, 0x8f, 0x5e, 0xf0, 0x9e,
-	0x67, 0x2f, 0x78, 0xcf, 0x67, 0xc7, 0xbc, 0xe7, 0xe8, 0x98, 0xe7, 0x9e, 0x1e, 0xf3, 0xdc, 0xef,
-	0xc7, 0x3c, 0xf7, 0xe8, 0x84, 0xf7, 0x3c, 0x3d, 0xe1, 0x3d, 0xcf, 0x4e, 0x78, 0xcf, 0xfd, 0xff,
-	0x3a, 0x2a, 0x59, 0x21, 0x58, 0xc3, 0x3a, 0xba, 0xde, 0xe8, 0x54, 0x92, 0xec, 0xe7, 0x82, 0x03,
-	0xeb, 0x07, 0x03, 0xab, 0xa0, 0x2b, 0x01, 0x6b, 0xa4, 0xfc, 0xef, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8e, 0x1f, 0x84, 0xf0, 0x4b, 0x10, 0x00, 0x00,
+	// 2859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0x5d, 0x6c, 0x23, 0x47,
+	0x39, 0x1b, 0x3b, 0x7f, 0x63, 0x27, 0xf1, 0xcd, 0xe5, 0x12, 0x9f, 0x73, 0xf5, 0xba, 0x7b, 0x6d,
+	0x49, 0x4f, 0xd7, 0xa4, 0x0d, 0x7f, 0x22, 0x15, 0xd0, 0x6c, 0xe2, 0xb4, 0xa6, 0x77, 0x89, 0x35,
+	0xf1, 0xe5, 0xd4, 0x22, 0xb4, 0xda, 0x78, 0x27, 0xce, 0xf6, 0xd6, 0x3b, 0x66, 0x77, 0x9c, 0x9f,
+	0x07, 0x04, 0x08, 0x81, 0xaa, 0x3c, 0xa0, 0x4a, 0x48, 0xa8, 0xa2, 0x0a, 0xaa, 0x40, 0xbc, 0x50,
+	0x89, 0x27, 0x1e, 0xe1, 0x99, 0x03, 0x55, 0xa2, 0xe2, 0xa9, 0x80, 0xe4, 0xd2, 0xab, 0x84, 0xaa,
+	0x88, 0xa7, 0x7b, 0xe2, 0x05, 0x09, 0xcd, 0xcf, 0xae, 0x77, 0x6d, 0x5f, 0x7c, 0xbe, 0xa3, 0x3c,
+	0x65, 0xe7, 0xfb, 0xff, 0xbe, 0xf9, 0xe6, 0x9b, 0xef, 0x1b, 0x07, 0x5c, 0xa9, 0x91, 0x83, 0x1a,
+	0x76, 0x97, 0x6a, 0xe4, 0x60, 0xe9, 0xe0, 0x85, 0x5d, 0x4c, 0xcd, 0x17, 0xd8, 0xf7, 0x62, 0xc3,
+	0x23, 0x94, 0x40, 0x28, 0xb0, 0x8b, 0x0c, 0x22, 0xb1, 0xb9, 0x7c, 0x95, 0xf8, 0x75, 0xe2, 0x2f,
+	0xed, 0x9a, 0x3e, 0x0e, 0x59, 0xaa, 0xc4, 0x76, 0x05, 0x4f, 0x6e, 0xa6, 0x46, 0x6a, 0x84, 0x7f,
+	0x2e, 0xb1, 0x2f, 0x09, 0xbd, 0x2c, 0xb8, 0x0c, 0x81, 0x10, 0x0b, 0x89, 0x52, 0x6b, 0x84, 0xd4,
+	0x1c, 0xbc, 0xc4, 0x57, 0xbb, 0xcd, 0xbd, 0x25, 0x6a, 0xd7, 0xb1, 0x4f, 0xcd, 0x7a, 0x23, 0xe0,
+	0xed, 0x24, 0x30, 0xdd, 0x63, 0x89, 0xca, 0x77, 0xa2, 0xac, 0xa6, 0x67, 0x52, 0x9b, 0x48, 0x63,
+	0xb4, 0x5f, 0x29, 0x00, 0xde, 0xc6, 0x76, 0x6d, 0x9f, 0x62, 0x6b, 0x87, 0x50, 0xbc, 0xd5, 0x60,
+	0x48, 0xf8, 0x25, 0x30, 0x4a, 0xf8, 0x57, 0x56, 0x29, 0x28, 0x0b, 0x53, 0xcb, 0xf9, 0xc5, 0x6e,
+	0x47, 0x17, 0xdb, 0xf4, 0x48, 0x52, 0xc3, 0xdb, 0x60, 0xf4, 0x90, 0x4b, 0xcb, 0x0e, 0x17, 0x94,
+	0x85, 0x09, 0xfd, 0xeb, 0x77, 0x5b, 0xea, 0xd0, 0xdf, 0x5a, 0xea, 0x33, 0x35, 0x9b, 0xee, 0x37,
+	0x77, 0x17, 0xab, 0xa4, 0x2e, 0x7d, 0x93, 0x7f, 0x9e, 0xf3, 0xad, 0x3b, 0x4b, 0xf4, 0xb8, 0x81,
+	0xfd, 0xc5, 0x75, 0x5c, 0xbd, 0xdf, 0x52, 0x27, 0x8f, 0xcd, 0xba, 0xb3, 0xa2, 0x09, 0x29, 0x1a,
+	0x92, 0xe2, 0xb4, 0xdb, 0x20, 0x5d, 0xc1, 0x47, 0xb4, 0xec, 0x91, 0x06, 0xf1, 0x4d, 0x07, 0xce,
+	0x80, 0x11, 0x6a, 0x53, 0x07, 0x73, 0xfb, 0x26, 0x90, 0x58, 0xc0, 0x02, 0x48, 0x59, 0xd8, 0xaf,
+	0x7a, 0xb6, 0xb0, 0x9d, 0xdb, 0x80, 0xa2, 0xa0, 0x95, 0xe9, 0x4f, 0xdf, 0x55, 0x95, 0xbf, 0xfc,
+	0xf6, 0xb9, 0xb1, 0x35, 0xe2, 0x52, 0xec, 0x52, 0xed, 0x0f, 0x0a, 0x98, 0xdb, 0x68, 0xba, 0xdc,
+	0xf9, 0x0d, 0x8c, 0xcb, 0x84, 0x38, 0x8f, 0xab, 0x04, 0x56, 0xc1, 0xa8, 0x59, 0x27, 0x4d, 0x97,
+	0x66, 0x13, 0x85, 0xc4, 0x42, 0x6a, 0xf9, 0xf2, 0xa2, 0xdc, 0x4f, 0x96, 0x12, 0x61, 0xf8, 0xd6,
+	0x88, 0xed, 0xea, 0xcf, 0xb3, 0x00, 0xfd, 0xfa, 0x23, 0x75, 0xe1, 0x21, 0x02, 0xc4, 0x18, 0x7c,
+	0x24, 0x45, 0x77, 0x7b, 0x72, 0x92, 0x00, 0x33, 0xb7, 0x1a, 0x96, 0x49, 0x71, 0xd9, 0xf4, 0xcc,
+	0xba, 0xff, 0xd8, 0x6e, 0xd4, 0xc0, 0x94, 0x85, 0x1b, 0xc4, 0xb7, 0xa9, 0xd1, 0xe0, 0x12, 0xb3,
+	0x89, 0x82, 0xb2, 0x90, 0x5a, 0x7e, 0xb2, 0x57, 0x32, 0xac, 0x0b, 0x4a, 0xa1, 0x5a, 0x7f, 0x82,
+	0xb9, 0x75, 0xbf, 0xa5, 0x5e, 0x12, 0xbb, 0x19, 0x17, 0xa3, 0xa1, 0x49, 0x2b, 0x4a, 0x0d, 0xab,
+	0x60, 0xf2, 0x80, 0x50, 0xdb, 0xad, 0x05, 0x7a, 0x92, 0x5c, 0x4f, 0xe1, 0x01, 0x49, 0x67, 0xbb,
+	0x35, 0xa9, 0xe6, 0x8a, 0x54, 0x33, 0x23, 0xd4, 0xc4, 0x84, 0x68, 0x28, 0x7d, 0x10, 0xa1, 0x85,
+	0x06, 0x48, 0x53, 0xd3, 0x71, 0x8e, 0x03, 0x1d, 0x23, 0x5c, 0x87, 0xda, 0x4b, 0x47, 0x85, 0xd1,
+	0x49, 0x15, 0xf3, 0x52, 0xc5, 0x45, 0xa1, 0x22, 0x2a, 0x42, 0x43, 0x29, 0xda, 0xa6, 0x5c, 0x49,
+	0x45, 0x37, 0xe3, 0xcf, 0x0a, 0x18, 0x93, 0x21, 0x81, 0x5f, 0x06, 0xa9, 0x86, 0xdc, 0x0b, 0xc3,
+	0xb6, 0xf8, 0x2e, 0x24, 0xf5, 0xd9, 0xfb, 0x2d, 0x15, 0x0a, 0x99, 0x11, 0xa4, 0x86, 0x40, 0xb0,
+	0x2a, 0x59, 0xf0, 0x0a, 0x98, 0x90, 0x81, 0x22, 0x9e, 0xdc, 0xa0, 0x36, 0xe0, 0xff, 0x93, 0x65,
+	0xe3, 0x6f, 0xbe, 0xab, 0x0e, 0x7d, 0xfa, 0xae, 0x3a, 0xa4, 0xfd, 0x66, 0x0c, 0x8c, 0x87, 0x29,
+	0xf5, 0x85, 0x5e, 0x2e, 0x5d, 0x3c, 0x6b, 0xa9, 0xc3, 0xb6, 0x75, 0xbf, 0xa5, 0x4e, 0x08, 0xc7,
+	0x3a, 0xfd, 0x79, 0x11, 0x8c, 0x55, 0x45, 0x7c, 0xb8, 0x37, 0xa9, 0xe5, 0x99, 0x45, 0x51, 0x9e,
+	0x16, 0x83, 0xf2, 0xb4, 0xb8, 0xea, 0x1e, 0xeb, 0xa9, 0x3f, 0xb5, 0x03, 0x89, 0x02, 0x0e, 0xb8,
+	0x03, 0x46, 0x7d, 0x6a, 0xd2, 0xa6, 0xc8, 0xc2, 0xa9, 0x65, 0xad, 0xd7, 0xce, 0x05, 0x06, 0x6e,
+	0x73, 0x4a, 0x3d, 0x77, 0xbf, 0xa5, 0xce, 0x76, 0x04, 0x59, 0x08, 0xd1, 0x90, 0x94, 0x06, 0x1b,
+	0x00, 0xee, 0xd9, 0xae, 0xe9, 0x18, 0x62, 0x6b, 0x3d, 0xec, 0x37, 0x1d, 0x9a, 0x4d, 0xf6, 0xc9,
+	0x0e, 0xc4, 0xc9, 0xf4, 0x27, 0x65, 0x76, 0x5c, 0x16, 0x4a, 0xba, 0x05, 0x69, 0x28, 0xc3, 0x81,
+	0x11, 0x26, 0xf8, 0x4d, 0x90, 0xf2, 0x9b, 0xbb, 0x75, 0x9b, 0x1a, 0xac, 0x90, 0xcb, 0x44, 0xcc,
+	0x75, 0x85, 0xa2, 0x12, 0x54, 0x79, 0x3d, 0x2f, 0xb5, 0xc8, 0x7c, 0x89, 0x30, 0x6b, 0x6f, 0x7d,
+	0xa4, 0x2a, 0x08, 0x08, 0x08, 0x63, 0x80, 0x36, 0xc8, 0x04, 0xa7, 0x0d, 0xbb, 0x96, 0xd0, 0x30,
+	0xda, 0x57, 0xc3, 0x55, 0xa9, 0x61, 0x2e, 0x7e, 0x5e, 0x03, 0x09, 0x42, 0x4d, 0x50, 0x0d, 0x8a,
+	0xae, 0xc5, 0x55, 0xbd, 0xa9, 0x80, 0x49, 0x4a, 0xa8, 0xe9, 0x18, 0x12, 0x91, 0x1d, 0xeb, 0x97,
+	0x88, 0xaf, 0xc4, 0x0f, 0x6c, 0x8c, 0x5b, 0x1b, 0x28, 0x41, 0xd3, 0x9c, 0x37, 0x38, 0x62, 0x0e,
+	0xb8, 0x20, 0x0f, 0xbf, 0x4f, 0x4d, 0x4f, 0x06, 0x76, 0xbc, 0xaf, 0xdb, 0x4f, 0x49, 0x73, 0xb2,
+	0xb1, 0xfa, 0xd1, 0x16, 0x21, 0xfc, 0x9e, 0x16, 0xf0, 0x6d, 0x06, 0xe6, 0x8e, 0xef, 0x01, 0x09,
+	0x6a, 0x87, 0x78, 0xa2, 0xaf, 0x2e, 0x4d, 0xea, 0x9a, 0x8d, 0xe9, 0x8a, 0x47, 0x58, 0x96, 0xc1,
+	0x20, 0xc0, 0x39, 0x30, 0x2e, 0xd2, 0x16, 0x7b, 0x59, 0xc0, 0x8f, 0x7f, 0xb8, 0x66, 0xb8, 0x3a,
+	0xa6, 0xa6, 0x65, 0x52, 0x33, 0x9b, 0x12, 0xb8, 0x60, 0xbd, 0x92, 0x64, 0x57, 0x83, 0x76, 0x77,
+	0x18, 0xa4, 0xa2, 0x69, 0xf7, 0x12, 0x48, 0x1c, 0x63, 0x5f, 0x5c, 0x02, 0xfa, 0xe2, 0x00, 0x17,
+	0x73, 0xc9, 0xa5, 0x88, 0xb1, 0xc2, 0x57, 0xc0, 0x98, 0xb9, 0xeb, 0x53, 0xd3, 0x96, 0xd7, 0xc5,
+	0xc0, 0x52, 0x02, 0x76, 0xf8, 0x35, 0x30, 0xec, 0x92, 0x6c, 0xe2, 0x91, 0x84, 0x0c, 0xbb, 0x04,
+	0xd6, 0x40, 0xda, 0x25, 0xc6, 0xa1, 0x4d, 0xf7, 0x8d, 0x03, 0x4c, 0x09, 0x3f, 0xae, 0x13, 0x7a,
+	0x71, 0x30, 0x49, 0xed, 0xaa, 0x1e, 0x95, 0xa5, 0x21, 0xe0, 0x92, 0xdb, 0x36, 0xdd, 0xdf, 0xc1,
+	0x94, 0xc8, 0x50, 0xbe, 0x97, 0x00, 0x53, 0x3b, 0xa6, 0x63, 0x5b, 0x26, 0x25, 0x1e, 0x8f, 0xe9,
+	0xa3, 0x17, 0xf5, 0x12, 0xb8, 0x70, 0x10, 0x88, 0x32, 0x4c, 0xcb, 0xf2, 0xb0, 0xef, 0xcb, 0x70,
+	0x5e, 0x89, 0xa4, 0x62, 0x27, 0x89, 0x86, 0x32, 0x21, 0x6c, 0x55, 0x80, 0xe0, 0x1d, 0x30, 0xb9,
+	0x4b, 0x5c, 0x0b, 0x5b, 0x06, 0x25, 0x77, 0xb0, 0xeb, 0xcb, 0x80, 0x6e, 0x0c, 0x1c, 0x06, 0x79,
+	0x1c, 0x63, 0xc2, 0x34, 0x94, 0x16, 0xeb, 0x0a, 0x5f, 0x42, 0x0c, 0x52, 0x07, 0x84, 0x62, 0xcb,
+	0x68, 0x90, 0x43, 0xec, 0xc9, 0x88, 0xaf, 0x0f, 0xac, 0x0a, 0x86, 0xe9, 0x1f, 0x88, 0xd2, 0x10,
+	0xe0, 0xab, 0x32, 0x5b, 0xc0, 0x17, 0xc1, 0x08, 0xaf, 0x9f, 0x7d, 0xef, 0x67, 0x59, 0x81, 0x93,
+	0xcc, 0x02, 0x24, 0x78, 0xe4, 0x6e, 0xbd, 0xaf, 0x00, 0x28, 0xdb, 0xb9, 0xed, 0x06, 0x71, 0x7d,
+	0xe2, 0xf9, 0xfb, 0x76, 0xe3, 0xd1, 0x77, 0x6c, 0x06, 0x8c, 0x30, 0x03, 0x83, 0x2b, 0x58, 0x2c,
+	0xa0, 0x09, 0x46, 0xfc, 0x06, 0xfe, 0x6c, 0x6e, 0x5f, 0x21, 0x59, 0xba, 0xf3, 0xef, 0x11, 0x30,
+	0x13, 0xbf, 0xd7, 0xd6, 0x31, 0x35, 0x6d, 0xe7, 0xd1, 0x1d, 0x0a, 0x63, 0x3c, 0x3c, 0x78, 0x8c,
+	0xe1, 0x3e, 0x10, 0xa5, 0xd7, 0x10, 0xd9, 0x91, 0x4d, 0x3c, 0xde, 0xd1, 0x8b, 0xca, 0x62, 0x0d,
+	0x15, 0x5b, 0xea, 0x7c, 0xc5, 0xca, 0x0d, 0x6d, 0x7a, 0x2e, 0x69, 0xd2, 0x6c, 0x72, 0xe0, 0x4a,
+	0xb1, 0x8e, 0xab, 0x28, 0x60, 0x87, 0x2f, 0x81, 0xa9, 0x6f, 0x37, 0x89, 0xd7, 0xac, 0x1b, 0x1e,
+	0x36, 0xab, 0xfb, 0xd8, 0xe2, 0xd9, 0x35, 0xae, 0x5f, 0x6e, 0xb7, 0xa8, 0x71, 0xbc, 0x86, 0x26,
+	0x05, 0x00, 0x89, 0x35, 0x3b, 0xb5, 0x74, 0xdf, 0xc3, 0xfe, 0x3e, 0x71, 0xac, 0x50, 0xc8, 0x28,
+	0x17, 0x12, 0x39, 0xb5, 0x5d, 0x24, 0x1a, 0xca, 0x84, 0xb0, 0x40, 0xd4, 0x0a, 0x48, 0xb3, 0x3a,
+	0x13, 0x4a, 0x19, 0xe3, 0x52, 0xe6, 0xda, 0x21, 0x89, 0x62, 0x35, 0x94, 0x62, 0xcb, 0x80, 0x77,
+	0x16, 0x8c, 0x36, 0x4c, 0xdf, 0xc7, 0x3e, 0xbf, 0xdc, 0xc6, 0x91, 0x5c, 0xc1, 0x37, 0xc0, 0x24,
+	0x3f, 0x4b, 0x06, 0x25, 0xc6, 0x9e, 0x63, 0x37, 0xb2, 0x13, 0x8f, 0x57, 0x09, 0x62, 0xc2, 0x34,
+	0x94, 0xe2, 0xeb, 0x0a, 0xd9, 0x70, 0xec, 0x06, 0xac, 0x82, 0x29, 0x76, 0x63, 0x19, 0x1e, 0xae,
+	0x9b, 0xb6, 0x6b, 0xbb, 0x35, 0x7e, 0x37, 0xb1, 0x13, 0xd0, 0x79, 0xf9, 0xad, 0xcb, 0x59, 0x33,
+	0x6c, 0x93, 0x64, 0xac, 0xe3, 0xec, 0xda, 0xdb, 0xfc, 0xea, 0x63, 0x40, 0x14, 0xc0, 0x64, 0xea,
+	0x7f, 0x7f, 0x18, 0x24, 0xd9, 0x49, 0xfe, 0x5f, 0x9f, 0xdd, 0x95, 0x70, 0xbc, 0x4d, 0x3c, 0xcc,
+	0x78, 0xab, 0x0f, 0x67, 0x95, 0x70, 0xc4, 0xdd, 0x00, 0x63, 0xe2, 0x8b, 0x8d, 0x29, 0xec, 0xe4,
+	0x3f, 0xd3, 0x8b, 0xb9, 0x7b, 0xa6, 0x96, 0xa7, 0x28, 0x60, 0x66, 0xcd, 0xbd, 0x88, 0x8e, 0xe9,
+	0x88, 0x1e, 0x70, 0x02, 0xb5, 0x01, 0x2b, 0xe3, 0x6f, 0x07, 0x7d, 0xf7, 0xa7, 0xe3, 0x60, 0x32,
+	0x36, 0x5c, 0xc1, 0x77, 0x14, 0x90, 0xaa, 0xdb, 0x6e, 0xd8, 0x75, 0x29, 0xfd, 0x0a, 0x90, 0xc1,
+	0x34, 0x9f, 0xb5, 0xd4, 0x4b, 0x11, 0xae, 0xeb, 0xa4, 0x6e, 0x53, 0x5c, 0x6f, 0xd0, 0xe3, 0x76,
+	0x14, 0x23, 0xe8, 0xc1, 0x9a, 0x31, 0x50, 0xb7, 0xdd, 0xa0, 0x15, 0xfb, 0xb1, 0x02, 0x60, 0xdd,
+	0x3c, 0x32, 0xc2, 0x99, 0x0f, 0x7b, 0x36, 0xb1, 0xb2, 0xc3, 0xfd, 0x72, 0xa4, 0x28, 0x8d, 0xbc,
+	0xd2, 0xcd, 0x1c, 0xb3, 0x55, 0xb6, 0xda, 0xdd, 0x54, 0x22, 0x8f, 0x32, 0x75, 0xf3, 0x28, 0x08,
+	0x17, 0x07, 0xc3, 0x43, 0x70, 0xc9, 0x74, 0x1c, 0x72, 0x88, 0x2d, 0x43, 0xce, 0x12, 0x06, 0xb7,
+	0x9d, 0x17, 0xee, 0x09, 0x7d, 0xed, 0xac, 0xa5, 0xaa, 0x3d, 0x09, 0x62, 0x6a, 0xaf, 0x08, 0xb5,
+	0x3d, 0x09, 0x35, 0x74, 0x51, 0xc2, 0xe5, 0xd4, 0x52, 0x61, 0x50, 0x78, 0xa2, 0x80, 0x1c, 0x33,
+	0x33, 0x48, 0x47, 0x9f, 0x19, 0x6a, 0x84, 0x1d, 0x5d, 0x92, 0x27, 0xf1, 0xcd, 0xb3, 0x96, 0xfa,
+	0xd4, 0x83, 0xa9, 0x62, 0x36, 0x3c, 0xd9, 0x76, 0xbd, 0x37, 0xb5, 0x86, 0xe6, 0xea, 0xe6, 0x51,
+	0x70, 0x59, 0xf8, 0x65, 0xec, 0x95, 0x25, 0x06, 0xfe, 0x54, 0x01, 0x33, 0xbc, 0xb0, 0x54, 0x09,
+	0x71, 0x2c, 0x72, 0xe8, 0x06, 0x1b, 0x33, 0xd2, 0x6f, 0x63, 0x4a, 0x72, 0x63, 0xf2, 0xbd, 0xd8,
+	0x63, 0xf6, 0xcd, 0x47, 0xea, 0x57, 0x07, 0x9d, 0xd8, 0x1c, 0xc8, 0x50, 0x6b, 0x12, 0x23, 0xb7,
+	0xe7, 0x77, 0x0a, 0x98, 0xe7, 0x1c, 0x91, 0xec, 0x33, 0xea, 0x4d, 0x87, 0xda, 0x0d, 0xc7, 0xc6,
+	0x1e, 0x2f, 0xb2, 0x69, 0xfd, 0x3b, 0x83, 0x95, 0xfe, 0xb3, 0x96, 0xfa, 0xf4, 0x39, 0x42, 0x63,
+	0x56, 0x6b, 0x11, 0xab, 0x7b, 0x93, 0x6b, 0x28, 0xcb, 0xb0, 0x37, 0xc3, 0x24, 0xbf, 0x19, 0xa2,
+	0xe0, 0x1f, 0x15, 0x90, 0xb7, 0x5d, 0xb3, 0x4a, 0xed, 0x03, 0x1c, 0xee, 0x8a, 0xe1, 0xe1, 0xbd,
+	0xa6, 0x6b, 0x19, 0x3c, 0x82, 0xbc, 0xc0, 0xa7, 0xf5, 0x1f, 0x28, 0x03, 0xbb, 0xb0, 0x70, 0xbe,
+	0xe0, 0x98, 0x17, 0x4f, 0xcb, 0x91, 0xfb, 0x5c, 0x0e, 0x0d, 0xcd, 0x07, 0x04, 0x41, 0x92, 0x20,
+	0x8e, 0x46, 0x1c, 0xfb, 0x5e, 0x1a, 0xa4, 0xa3, 0xef, 0x2b, 0xf0, 0x67, 0x0a, 0xb8, 0x14, 0x3c,
+	0xaa, 0xf0, 0xdd, 0x32, 0x2c, 0xbc, 0x67, 0xb2, 0xf9, 0x58, 0xe9, 0x97, 0x35, 0xaf, 0xca, 0xac,
+	0x51, 0x7b, 0xf2, 0xf7, 0x3a, 0x5a, 0x3d, 0x09, 0x45, 0xde, 0x5c, 0x14, 0x38, 0x91, 0x31, 0xeb,
+	0x02, 0x03, 0x7f, 0xaf, 0x80, 0x7c, 0x9c, 0x87, 0x3f, 0xcb, 0x60, 0x8a, 0x3d, 0xa3, 0xba, 0x6f,
+	0xba, 0x35, 0xdc, 0xbf, 0xe8, 0x7c, 0x4b, 0x5a, 0xb9, 0x70, 0xbe, 0xa0, 0x5e, 0x91, 0x3e, 0x9f,
+	0x43, 0xd8, 0x3d, 0x1f, 0xb5, 0xbb, 0x1c, 0x90, 0xac, 0x71, 0x8a, 0x1e, 0xf6, 0xfb, 0x64, 0x8f,
+	0x1e, 0x9a, 0x1e, 0x36, 0x9a, 0x8d, 0x9a, 0x67, 0x5a, 0x38, 0x9b, 0x78, 0x44, 0xfb, 0x3b, 0x05,
+	0xf5, 0xb7, 0xbf, 0x93, 0xa3, 0x87, 0xfd, 0xdb, 0x92, 0xe4, 0x96, 0xa0, 0xe0, 0x85, 0x3e, 0x2e,
+	0x84, 0xe2, 0xa3, 0xe0, 0xe5, 0xe4, 0x61, 0x0a, 0x7d, 0x37, 0x73, 0xaf, 0x42, 0xdf, 0x4d, 0x25,
+	0x0b, 0x7d, 0xd4, 0x36, 0xf6, 0x36, 0x0c, 0x7f, 0xa4, 0x80, 0xcb, 0xac, 0x36, 0xb2, 0x3b, 0xde,
+	0x08, 0xaf, 0x52, 0xc3, 0xc1, 0x6e, 0x8d, 0xee, 0xf3, 0x3a, 0x97, 0xd4, 0x5f, 0x3d, 0x6b, 0xa9,
+	0x57, 0x1f, 0x48, 0x14, 0xd3, 0x5f, 0x68, 0x57, 0xdb, 0x9e, 0xc4, 0x1a, 0x9a, 0xad, 0x9b, 0x47,
+	0xec, 0x82, 0x47, 0x01, 0xe6, 0x06, 0x47, 0xc0, 0x5f, 0x28, 0xa0, 0x80, 0x4d, 0xcf, 0x39, 0x36,
+	0x28, 0xf6, 0xea, 0xb6, 0xcb, 0xd1, 0x46, 0x75, 0x1f, 0x57, 0xef, 0x18, 0xb6, 0x4b, 0xb1, 0x77,
+	0x60, 0x3a, 0xbc, 0xae, 0x25, 0xf5, 0xd7, 0xce, 0x5a, 0xea, 0xb5, 0x7e, 0xb4, 0x31, 0xb3, 0x3e,
+	0x27, 0xcc, 0xea, 0xc7, 0xa3, 0xa1, 0x27, 0x38, 0x49, 0xa5, 0x4d, 0xb1, 0xc6, 0x08, 0x4a, 0x12,
+	0x0f, 0xff, 0xca, 0xea, 0x2e, 0xf3, 0x6b, 0x0f, 0x63, 0xc3, 0x6f, 0xcf, 0x49, 0xc6, 0x6e, 0xd3,
+	0xaa, 0xe1, 0x87, 0x78, 0xcb, 0xf9, 0xae, 0xdc, 0xc7, 0xa7, 0xcf, 0x91, 0xd2, 0xb3, 0xd0, 0x3e,
+	0x98, 0x7c, 0xb0, 0xae, 0x23, 0x7b, 0xd0, 0x35, 0xe4, 0xe9, 0x5c, 0x0c, 0xfc, 0x89, 0x02, 0xd8,
+	0x2f, 0x33, 0x46, 0xdd, 0xaf, 0xb1, 0x6d, 0xc3, 0x86, 0x63, 0xd7, 0x6d, 0x2a, 0x1f, 0x84, 0xae,
+	0xf6, 0xea, 0xd7, 0x5e, 0x26, 0x07, 0x37, 0xfd, 0x1a, 0x32, 0x29, 0xbe, 0xc1, 0x48, 0xf5, 0xd5,
+	0x20, 0x49, 0xbb, 0xc5, 0xf4, 0x4a, 0xd2, 0x6e, 0x2a, 0x0d, 0x4d, 0xd7, 0xe2, 0x32, 0xe1, 0xfb,
+	0x0a, 0x50, 0x7b, 0x3a, 0xcf, 0x6e, 0x1d, 0x39, 0x4d, 0x89, 0xbe, 0xfd, 0x87, 0xca, 0x60, 0x8d,
+	0xfb, 0x59, 0x4b, 0x7d, 0xb6, 0x8f, 0xe4, 0x98, 0xd5, 0xcf, 0x9c, 0xb3, 0x13, 0x6d, 0x16, 0x0d,
+	0xcd, 0x77, 0x47, 0xf8, 0xa6, 0xed, 0x8a, 0xf1, 0x4c, 0x7b, 0x47, 0x01, 0xd3, 0x1d, 0x61, 0x63,
+	0xf3, 0xc9, 0xa1, 0xed, 0x5a, 0xe4, 0x50, 0xb4, 0xe8, 0x48, 0xae, 0xe0, 0x57, 0xc1, 0x64, 0xac,
+	0x6b, 0xe1, 0x95, 0x39, 0xa9, 0x67, 0xdb, 0x13, 0x47, 0x0c, 0xad, 0xa1, 0x74, 0xb4, 0x8f, 0x81,
+	0x2f, 0x80, 0x89, 0xe0, 0x18, 0x8a, 0x47, 0x8e, 0xa4, 0x3e, 0x73, 0xbf, 0xa5, 0x66, 0xe2, 0x27,
+	0xd4, 0xd7, 0xd0, 0xb8, 0x3c, 0x91, 0xbe, 0xf6, 0x2f, 0x05, 0xc0, 0xa0, 0xf9, 0xd9, 0xe1, 0x13,
+	0x54, 0x95, 0x78, 0x56, 0xec, 0x49, 0x4d, 0xe9, 0x78, 0x52, 0xdb, 0x00, 0x19, 0x76, 0xcd, 0xb3,
+	0x77, 0x89, 0x0e, 0x3b, 0xe7, 0xdb, 0x4f, 0xa3, 0x9d, 0x14, 0x1a, 0x9a, 0x16, 0xa0, 0xb6, 0xb5,
+	0x0e, 0xb8, 0x10, 0x76, 0x3f, 0xe1, 0x03, 0x61, 0x62, 0xd0, 0xc7, 0xc8, 0x2e, 0x11, 0xf2, 0x31,
+	0x32, 0x80, 0xcb, 0x47, 0x42, 0x39, 0x29, 0xad, 0x82, 0x94, 0xd8, 0x8b, 0x35, 0xf6, 0x6c, 0xcf,
+	0x86, 0x8b, 0xb6, 0x0f, 0x62, 0x2b, 0xda, 0x80, 0x60, 0x28, 0x92, 0xde, 0x89, 0xa1, 0xc8, 0xd7,
+	0xfe, 0x1e, 0xbc, 0x17, 0xca, 0xcb, 0xff, 0x75, 0x30, 0x2a, 0x66, 0x60, 0x2e, 0x20, 0xad, 0xeb,
+	0x03, 0xf7, 0x2f, 0x19, 0xc1, 0xdf, 0x4e, 0x3d, 0x24, 0x25, 0xc2, 0x2a, 0x98, 0x08, 0xe7, 0x62,
+	0x6e, 0x45, 0x5a, 0x2f, 0x0e, 0x2c, 0xfe, 0x62, 0x28, 0x22, 0xa2, 0xa1, 0x2d, 0x97, 0xf5, 0xdf,
+	0x53, 0xbc, 0xab, 0x6b, 0xab, 0x4a, 0x70, 0x55, 0xd5, 0x81, 0x55, 0x65, 0xe3, 0x72, 0x62, 0x87,
+	0xe9, 0x52, 0xa4, 0x7f, 0x0c, 0x29, 0x34, 0x34, 0xc9, 0x00, 0x95, 0x70, 0xfd, 0x73, 0x05, 0x5c,
+	0xe0, 0x81, 0x15, 0xb7, 0x7f, 0x99, 0x38, 0x76, 0xf5, 0x18, 0x7e, 0x11, 0x4c, 0xf0, 0xc9, 0xc1,
+	0xb1, 0x7d, 0xd1, 0x53, 0x8d, 0xeb, 0x73, 0xcc, 0xb3, 0x10, 0x18, 0xf5, 0x2c, 0x04, 0x42, 0x04,
+	0x46, 0xbc, 0xa6, 0xc3, 0x37, 0x30, 0xf1, 0xa0, 0x8a, 0x16, 0x51, 0x86, 0x9a, 0x0e, 0xd6, 0xe7,
+	0x64, 0x45, 0x9b, 0xe6, 0x9c, 0x11, 0xb9, 0x42, 0x94, 0xf6, 0x1f, 0x05, 0x4c, 0x77, 0xf0, 0xc0,
+	0x65, 0x30, 0xee, 0x37, 0x77, 0xfd, 0x86, 0x59, 0x95, 0x3f, 0x1e, 0xea, 0xb3, 0x67, 0x2d, 0x15,
+	0x06, 0xb0, 0x88, 0x90, 0x90, 0x0e, 0x5e, 0x05, 0x89, 0x3b, 0xf8, 0x58, 0xbe, 0x68, 0x5e, 0x38,
+	0x6b, 0xa9, 0x93, 0x77, 0xf0, 0x71, 0x84, 0x92, 0x61, 0xe1, 0x75, 0x30, 0x6a, 0x61, 0xd7, 0x96,
+	0xcf, 0x47, 0xe3, 0xfa, 0x0c, 0xcb, 0x16, 0x01, 0x89, 0x66, 0x8b, 0x80, 0xc4, 0xb3, 0x25, 0xf9,
+	0xd9, 0x64, 0xcb, 0xb5, 0x7f, 0x2a, 0x00, 0x44, 0x7e, 0x01, 0xbf, 0x0e, 0xe6, 0x76, 0xb6, 0x2a,
+	0x45, 0x63, 0xab, 0x5c, 0x29, 0x6d, 0x6d, 0x1a, 0xb7, 0x36, 0xb7, 0xcb, 0xc5, 0xb5, 0xd2, 0x46,
+	0xa9, 0xb8, 0x9e, 0x19, 0xca, 0x4d, 0x9f, 0x9c, 0x16, 0x52, 0x82, 0xb0, 0xc8, 0xe4, 0x40, 0x0d,
+	0x4c, 0x47, 0xa9, 0x5f, 0x2b, 0x6e, 0x67, 0x94, 0xdc, 0xe4, 0xc9, 0x69, 0x61, 0x42, 0x50, 0xbd,
+	0x86, 0x7d, 0x78, 0x0d, 0x5c, 0x8c, 0xd2, 0xac, 0xea, 0xdb, 0x95, 0xd5, 0xd2, 0x66, 0x66, 0x38,
+	0x77, 0xe1, 0xe4, 0xb4, 0x30, 0x29, 0xe8, 0x56, 0xe5, 0xfb, 0x78, 0x01, 0x4c, 0x45, 0x69, 0x37,
+	0xb7, 0x32, 0x89, 0x5c, 0xfa, 0xe4, 0xb4, 0x30, 0x2e, 0xc8, 0x36, 0x09, 0x5c, 0x06, 0xd9, 0x38,
+	0x85, 0x71, 0xbb, 0x54, 0x79, 0xc5, 0xd8, 0x29, 0x56, 0xb6, 0x32, 0xc9, 0xdc, 0xcc, 0xc9, 0x69,
+	0x21, 0x13, 0xd0, 0x06, 0x8f, 0xd9, 0xb9, 0xe4, 0x9b, 0xbf, 0xcc, 0x0f, 0x5d, 0x7b, 0x7f, 0x18,
+	0x4c, 0xc5, 0xdf, 0x13, 0xe1, 0x22, 0x98, 0x2f, 0xa3, 0xad, 0xf2, 0xd6, 0xf6, 0xea, 0x0d, 0x63,
+	0xbb, 0xb2, 0x5a, 0xb9, 0xb5, 0xdd, 0xe1, 0x30, 0x77, 0x45, 0x10, 0x6f, 0xda, 0x0e, 0x7c, 0x11,
+	0xe4, 0x3b, 0xe9, 0xd7, 0x8b, 0xe5, 0xad, 0xed, 0x52, 0xc5, 0x28, 0x17, 0x51, 0x69, 0x6b, 0x3d,
+	0xa3, 0xe4, 0xe6, 0x4e, 0x4e, 0x0b, 0x17, 0x83, 0xf7, 0xca, 0xe8, 0x3c, 0xfe, 0x15, 0xf0, 0x44,
+	0x27, 0xf3, 0xce, 0x56, 0xa5, 0xb4, 0xf9, 0x72, 0xc0, 0x3b, 0x9c, 0x9b, 0x3d, 0x39, 0x2d, 0x40,
+	0xc1, 0xbb, 0x13, 0xe9, 0xf2, 0xe0, 0x75, 0x30, 0xdb, 0xc9, 0x5a, 0x5e, 0xdd, 0xde, 0x2e, 0xae,
+	0x67, 0x12, 0xb9, 0xcc, 0xc9, 0x69, 0x21, 0x2d, 0x78, 0xca, 0xa6, 0xef, 0x63, 0x0b, 0x3e, 0x0f,
+	0xb2, 0x9d, 0xd4, 0xa8, 0xf8, 0x8d, 0xe2, 0x5a, 0xa5, 0xb8, 0x9e, 0x49, 0xe6, 0xe0, 0xc9, 0x69,
+	0x61, 0x4a, 0xd0, 0x23, 0xfc, 0x06, 0xae, 0x52, 0xdc, 0x53, 0xfe, 0xc6, 0x6a, 0xe9, 0x46, 0x71,
+	0x3d, 0x33, 0x12, 0x95, 0xbf, 0x61, 0xda, 0x0e, 0xb6, 0x44, 0x38, 0xf5, 0xad, 0xbb, 0x1f, 0xe7,
+	0x87, 0x3e, 0xfc, 0x38, 0x3f, 0xf4, 0xbd, 0x7b, 0xf9, 0xa1, 0xbb, 0xf7, 0xf2, 0xca, 0x07, 0xf7,
+	0xf2, 0xca, 0x3f, 0xee, 0xe5, 0x95, 0xb7, 0x3e, 0xc9, 0x0f, 0x7d, 0xf0, 0x49, 0x7e, 0xe8, 0xc3,
+	0x4f, 0xf2, 0x43, 0xaf, 0x3f, 0x1b, 0xc9, 0x53, 0x93, 0x92, 0x3a, 0x71, 0xf1, 0x73, 0xfb, 0xcd,
+	0xdd, 0x25, 0xf9, 0xdf, 0x25, 0x47, 0xec, 0x43, 0xa4, 0xeb, 0xee, 0x28, 0xbf, 0x1b, 0x3e, 0xff,
+	0xdf, 0x01, 0x00, 0x74, 0xb3, 0x01, 0xc0, 0x7a, 0x22, 0x00, 0x00,
 }
 
 func (this *TextProposal) Equal(that interface{}) bool {
@@ -647,6 +1226,41 @@ func (this *TextProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FundVoteFeePoolProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FundVoteFeePoolProposal)
+	if !ok {
+		that2, ok := that.(FundVoteFeePoolProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Proposal) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -698,7 +1312,13 @@ func (this *Proposal) Equal(that interface{}) bool {
 	if !this.VotingEndTime.Equal(that1.VotingEndTime) {
 		return false
 	}
-	return true
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if this.Metadata != that1.Metadata {
+		return false
+	}
+	return true
 }
 func (this *TallyResult) Equal(that interface{}) bool {
 	if that == nil {
@@ -733,6 +1353,158 @@ func (this *TallyResult) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorTally) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorTally)
+	if !ok {
+		that2, ok := that.(ValidatorTally)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.BondedTokens.Equal(that1.BondedTokens) {
+		return false
+	}
+	if !this.VotedPower.Equal(that1.VotedPower) {
+		return false
+	}
+	if !this.Tally.Equal(&that1.Tally) {
+		return false
+	}
+	return true
+}
+func (this *VoteFeeSponsorship) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VoteFeeSponsorship)
+	if !ok {
+		that2, ok := that.(VoteFeeSponsorship)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if this.Voter != that1.Voter {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ProposalStatusDetail) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposalStatusDetail)
+	if !ok {
+		that2, ok := that.(ProposalStatusDetail)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProposalId != that1.ProposalId {
+		return false
+	}
+	if !this.Tally.Equal(&that1.Tally) {
+		return false
+	}
+	if !this.TotalBonded.Equal(that1.TotalBonded) {
+		return false
+	}
+	if !this.Turnout.Equal(that1.Turnout) {
+		return false
+	}
+	if this.QuorumReached != that1.QuorumReached {
+		return false
+	}
+	if this.ThresholdReached != that1.ThresholdReached {
+		return false
+	}
+	if this.VetoReached != that1.VetoReached {
+		return false
+	}
+	if this.Passes != that1.Passes {
+		return false
+	}
+	if !this.PowerToFlip.Equal(that1.PowerToFlip) {
+		return false
+	}
+	if this.TimeRemaining != that1.TimeRemaining {
+		return false
+	}
+	return true
+}
+func (this *ProposerVetoRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProposerVetoRecord)
+	if !ok {
+		that2, ok := that.(ProposerVetoRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Proposer != that1.Proposer {
+		return false
+	}
+	if this.VetoedProposals != that1.VetoedProposals {
+		return false
+	}
+	if !this.CooldownEndTime.Equal(that1.CooldownEndTime) {
+		return false
+	}
+	return true
+}
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -808,6 +1580,124 @@ func (m *TextProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundVoteFeePoolProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundVoteFeePoolProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundVoteFeePoolProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateParamsProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateParamsProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateParamsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Deposit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -877,20 +1767,34 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
-	if err1 != nil {
-		return 0, err1
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x52
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintGov(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintGov(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingStartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintGov(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintGov(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x42
 	if len(m.TotalDeposit) > 0 {
@@ -907,20 +1811,20 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x3a
 		}
 	}
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
-	if err3 != nil {
-		return 0, err3
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.DepositEndTime):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n3
-	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i -= n6
+	i = encodeVarintGov(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x32
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmitTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintGov(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintGov(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -1021,7 +1925,7 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorTally) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1031,19 +1935,256 @@ func (m *Vote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorTally) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotedPower.Size()
+		i -= size
+		if _, err := m.VotedPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteFeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteFeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteFeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposalStatusDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalStatusDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalStatusDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TimeRemaining, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PowerToFlip.Size()
+		i -= size
+		if _, err := m.PowerToFlip.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Passes {
+		i--
+		if m.Passes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VetoReached {
+		i--
+		if m.VetoReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ThresholdReached {
+		i--
+		if m.ThresholdReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QuorumReached {
+		i--
+		if m.QuorumReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Turnout.Size()
+		i -= size
+		if _, err := m.Turnout.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TotalBonded.Size()
+		i -= size
+		if _, err := m.TotalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
 				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
@@ -1095,12 +2236,54 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
-	if err7 != nil {
-		return 0, err7
+	{
+		size := m.InactiveProposalRefundRatio.Size()
+		i -= size
+		if _, err := m.InactiveProposalRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
 	}
-	i -= n7
-	i = encodeVarintGov(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.VetoMinDepositMultiplier.Size()
+		i -= size
+		if _, err := m.VetoMinDepositMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VetoCooldownPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VetoCooldownPeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintGov(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x2a
+	if m.MaxProposalsPerProposer != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposalsPerProposer))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedContentTypes) > 0 {
+		for iNdEx := len(m.AllowedContentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContentTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedContentTypes[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedContentTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintGov(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MinDeposit) > 0 {
@@ -1140,42 +2323,86 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodText, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodText):])
-	if err8 != nil {
-		return 0, err8
+	{
+		size := m.VoteFeeSponsorshipMinBonded.Size()
+		i -= size
+		if _, err := m.VoteFeeSponsorshipMinBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.GovMsgRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.VoteFeeSponsorshipBudget) > 0 {
+		for iNdEx := len(m.VoteFeeSponsorshipBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteFeeSponsorshipBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EarlyTerminationCheckInterval != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EarlyTerminationCheckInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxVoteRationaleLength != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVoteRationaleLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodText, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodText):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n8
-	i = encodeVarintGov(dAtA, i, uint64(n8))
+	i -= n16
+	i = encodeVarintGov(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x22
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodSoftwareUpgrade, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodSoftwareUpgrade):])
-	if err9 != nil {
-		return 0, err9
+	n17, err17 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodSoftwareUpgrade, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodSoftwareUpgrade):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n9
-	i = encodeVarintGov(dAtA, i, uint64(n9))
+	i -= n17
+	i = encodeVarintGov(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodParameterChange, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodParameterChange):])
-	if err10 != nil {
-		return 0, err10
+	n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodParameterChange, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodParameterChange):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n10
-	i = encodeVarintGov(dAtA, i, uint64(n10))
+	i -= n18
+	i = encodeVarintGov(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x12
-	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodDefault, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodDefault):])
-	if err11 != nil {
-		return 0, err11
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.VotingPeriodDefault, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodDefault):])
+	if err19 != nil {
+		return 0, err19
 	}
-	i -= n11
-	i = encodeVarintGov(dAtA, i, uint64(n11))
+	i -= n19
+	i = encodeVarintGov(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+func (m *GovMsgRateLimit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1185,17 +2412,131 @@ func (m *TallyParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *GovMsgRateLimit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GovMsgRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.MaxVotes != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposerVetoRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerVetoRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerVetoRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CooldownEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CooldownEndTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintGov(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x1a
+	if m.VetoedProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.VetoedProposals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovMsgCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovMsgCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovMsgCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Votes != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Proposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Proposals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
 		size := m.VetoThreshold.Size()
 		i -= size
 		if _, err := m.VetoThreshold.MarshalTo(dAtA[i:]); err != nil {
@@ -1228,6 +2569,110 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParamChangePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowlist {
+		i--
+		if m.Allowlist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Denied {
+		i--
+		if m.Denied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1270,6 +2715,52 @@ func (m *TextProposal) Size() (n int) {
 	return n
 }
 
+func (m *FundVoteFeePoolProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateParamsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.DepositParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VotingParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func (m *Deposit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1324,6 +2815,14 @@ func (m *Proposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.VotingEndTime)
 	n += 1 + l + sovGov(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1344,7 +2843,7 @@ func (m *TallyResult) Size() (n int) {
 	return n
 }
 
-func (m *Vote) Size() (n int) {
+func (m *ValidatorTally) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1353,67 +2852,271 @@ func (m *Vote) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovGov(uint64(m.ProposalId))
 	}
-	l = len(m.Voter)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	if m.Option != 0 {
-		n += 1 + sovGov(uint64(m.Option))
-	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovGov(uint64(l))
-		}
-	}
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VotedPower.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Tally.Size()
+	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
-func (m *DepositParams) Size() (n int) {
+func (m *VoteFeeSponsorship) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
 			l = e.Size()
 			n += 1 + l + sovGov(uint64(l))
 		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
-	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
-func (m *VotingParams) Size() (n int) {
+func (m *ProposalStatusDetail) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodDefault)
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = m.Tally.Size()
 	n += 1 + l + sovGov(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodParameterChange)
+	l = m.TotalBonded.Size()
 	n += 1 + l + sovGov(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodSoftwareUpgrade)
+	l = m.Turnout.Size()
 	n += 1 + l + sovGov(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodText)
+	if m.QuorumReached {
+		n += 2
+	}
+	if m.ThresholdReached {
+		n += 2
+	}
+	if m.VetoReached {
+		n += 2
+	}
+	if m.Passes {
+		n += 2
+	}
+	l = m.PowerToFlip.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TimeRemaining)
 	n += 1 + l + sovGov(uint64(l))
 	return n
 }
 
-func (m *TallyParams) Size() (n int) {
+func (m *Vote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Quorum.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.Threshold.Size()
-	n += 1 + l + sovGov(uint64(l))
-	l = m.VetoThreshold.Size()
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxDepositPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	if len(m.AllowedContentTypes) > 0 {
+		for _, s := range m.AllowedContentTypes {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxProposalsPerProposer != 0 {
+		n += 1 + sovGov(uint64(m.MaxProposalsPerProposer))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VetoCooldownPeriod)
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VetoMinDepositMultiplier.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.InactiveProposalRefundRatio.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *VotingParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodDefault)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodParameterChange)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodSoftwareUpgrade)
+	n += 1 + l + sovGov(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.VotingPeriodText)
+	n += 1 + l + sovGov(uint64(l))
+	if m.MaxVoteRationaleLength != 0 {
+		n += 1 + sovGov(uint64(m.MaxVoteRationaleLength))
+	}
+	if m.EarlyTerminationCheckInterval != 0 {
+		n += 1 + sovGov(uint64(m.EarlyTerminationCheckInterval))
+	}
+	if len(m.VoteFeeSponsorshipBudget) > 0 {
+		for _, e := range m.VoteFeeSponsorshipBudget {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = m.GovMsgRateLimit.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VoteFeeSponsorshipMinBonded.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *GovMsgRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovGov(uint64(m.Window))
+	}
+	if m.MaxProposals != 0 {
+		n += 1 + sovGov(uint64(m.MaxProposals))
+	}
+	if m.MaxVotes != 0 {
+		n += 1 + sovGov(uint64(m.MaxVotes))
+	}
+	return n
+}
+
+func (m *ProposerVetoRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VetoedProposals != 0 {
+		n += 1 + sovGov(uint64(m.VetoedProposals))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CooldownEndTime)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *GovMsgCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proposals != 0 {
+		n += 1 + sovGov(uint64(m.Proposals))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovGov(uint64(m.Votes))
+	}
+	return n
+}
+
+func (m *TallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Quorum.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.Threshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	l = m.VetoThreshold.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *ParamChangePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowlist {
+		n += 2
+	}
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangeRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Denied {
+		n += 2
+	}
+	l = m.Threshold.Size()
 	n += 1 + l + sovGov(uint64(l))
 	return n
 }
@@ -1455,9 +3158,1812 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TextProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundVoteFeePoolProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundVoteFeePoolProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundVoteFeePoolProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateParamsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateParamsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateParamsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Content == nil {
+				m.Content = &types1.Any{}
+			}
+			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotedPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteFeeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteFeeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteFeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalStatusDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalStatusDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalStatusDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Turnout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Turnout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QuorumReached = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ThresholdReached = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VetoReached = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passes", wireType)
 			}
-			m.Option = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1467,14 +4973,15 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Passes = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerToFlip", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1502,7 +5009,40 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PowerToFlip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TimeRemaining, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1527,7 +5067,7 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TextProposal) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1550,15 +5090,34 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TextProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TextProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1586,11 +5145,64 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1618,7 +5230,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Rationale = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1641,7 +5253,7 @@ func (m *TextProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Deposit) Unmarshal(dAtA []byte) error {
+func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1660,21 +5272,139 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContentTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContentTypes = append(m.AllowedContentTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalsPerProposer", wireType)
+			}
+			m.MaxProposalsPerProposer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalsPerProposer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCooldownPeriod", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1684,16 +5414,30 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VetoCooldownPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoMinDepositMultiplier", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1703,29 +5447,30 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if err := m.VetoMinDepositMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveProposalRefundRatio", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1735,23 +5480,22 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InactiveProposalRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1776,7 +5520,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1799,17 +5543,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodDefault", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1819,14 +5563,28 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodDefault, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodParameterChange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1853,35 +5611,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Content == nil {
-				m.Content = &types1.Any{}
-			}
-			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodParameterChange, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ProposalStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodSoftwareUpgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1908,13 +5644,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodSoftwareUpgrade, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodText", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1941,15 +5677,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodText, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteRationaleLength", wireType)
 			}
-			var msglen int
+			m.MaxVoteRationaleLength = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -1959,28 +5695,33 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxVoteRationaleLength |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyTerminationCheckInterval", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EarlyTerminationCheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarlyTerminationCheckInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFeeSponsorshipBudget", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2007,14 +5748,14 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
-			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VoteFeeSponsorshipBudget = append(m.VoteFeeSponsorshipBudget, types.Coin{})
+			if err := m.VoteFeeSponsorshipBudget[len(m.VoteFeeSponsorshipBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovMsgRateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2041,15 +5782,15 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GovMsgRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFeeSponsorshipMinBonded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2059,22 +5800,23 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VoteFeeSponsorshipMinBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2099,7 +5841,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *GovMsgRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2122,17 +5864,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovMsgRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovMsgRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Yes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			m.Window = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2142,65 +5884,16 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Window |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Yes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abstain", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Abstain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field No", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposals", wireType)
 			}
-			var stringLen uint64
+			m.MaxProposals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2210,31 +5903,16 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxProposals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.No.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVeto", wireType)
+					break
+				}
 			}
-			var stringLen uint64
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotes", wireType)
+			}
+			m.MaxVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2244,26 +5922,11 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NoWithVeto.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2285,7 +5948,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *ProposerVetoRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2308,34 +5971,15 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposerVetoRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposerVetoRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,13 +6007,13 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoedProposals", wireType)
 			}
-			m.Option = 0
+			m.VetoedProposals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2379,14 +6023,14 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				m.VetoedProposals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CooldownEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2413,8 +6057,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CooldownEndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2439,7 +6082,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositParams) Unmarshal(dAtA []byte) error {
+func (m *GovMsgCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2462,17 +6105,17 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: GovMsgCount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GovMsgCount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
-			var msglen int
+			m.Proposals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2482,31 +6125,16 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Proposals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MinDeposit = append(m.MinDeposit, types.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var msglen int
+			m.Votes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2516,25 +6144,11 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Votes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGov
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGov
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2556,7 +6170,7 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VotingParams) Unmarshal(dAtA []byte) error {
+func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2579,17 +6193,17 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VotingParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodDefault", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2599,30 +6213,30 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodDefault, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodParameterChange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2632,30 +6246,30 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodParameterChange, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodSoftwareUpgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2665,28 +6279,98 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodSoftwareUpgrade, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamChangePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamChangePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamChangePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowlist = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodText", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2713,7 +6397,8 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.VotingPeriodText, dAtA[iNdEx:postIndex]); err != nil {
+			m.Rules = append(m.Rules, ParamChangeRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2738,7 +6423,7 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyParams) Unmarshal(dAtA []byte) error {
+func (m *ParamChangeRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2761,17 +6446,17 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamChangeRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamChangeRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2781,30 +6466,29 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2814,28 +6498,47 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Denied = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2862,7 +6565,7 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31,9 +31,9 @@ func (h MultiGovHooks) AfterProposalVote(ctx sdk.Context, proposalID uint64, vot
 	}
 }
 
-func (h MultiGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64) {
+func (h MultiGovHooks) AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64, outcome DepositsOutcome) {
 	for i := range h {
-		h[i].AfterProposalFailedMinDeposit(ctx, proposalID)
+		h[i].AfterProposalFailedMinDeposit(ctx, proposalID, outcome)
 	}
 }
 
@@ -21,6 +21,10 @@ const (
 
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
+
+	// VoteFeePoolName is the name of the module account which pays the fees of
+	// the votes of accounts with bonded stake
+	VoteFeePoolName = "vote_fee_pool"
 )
 
 // Keys for governance store
@@ -37,6 +41,26 @@ const (
 // - 0x10<proposalID_Bytes><depositorAddrLen (1 Byte)><depositorAddr_Bytes>: Deposit
 //
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
+//
+// - 0x30<proposalID_Bytes><valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorTally
+//
+// - 0x40: DepositParams
+//
+// - 0x41: VotingParams
+//
+// - 0x42: TallyParams
+//
+// - 0x43: ParamChangePolicy
+//
+// - 0x50<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: VoteFeeSponsorship
+//
+// - 0x60<addrLen (1 Byte)><addr_Bytes><height_Bytes>: GovMsgCount
+//
+// - 0x61<height_Bytes><addrLen (1 Byte)><addr_Bytes>: []byte{}
+//
+// - 0x70<proposerAddrLen (1 Byte)><proposerAddr_Bytes>: openProposalsCount
+//
+// - 0x80<proposerAddrLen (1 Byte)><proposerAddr_Bytes>: ProposerVetoRecord
 var (
 	ProposalsKeyPrefix          = []byte{0x00}
 	ActiveProposalQueuePrefix   = []byte{0x01}
@@ -46,6 +70,22 @@ var (
 	DepositsKeyPrefix = []byte{0x10}
 
 	VotesKeyPrefix = []byte{0x20}
+
+	ValidatorTalliesKeyPrefix = []byte{0x30}
+
+	DepositParamsKey     = []byte{0x40}
+	VotingParamsKey      = []byte{0x41}
+	TallyParamsKey       = []byte{0x42}
+	ParamChangePolicyKey = []byte{0x43}
+
+	VoteFeeSponsorshipsKeyPrefix = []byte{0x50}
+
+	GovMsgCountsKeyPrefix         = []byte{0x60}
+	GovMsgCountsByHeightKeyPrefix = []byte{0x61}
+
+	ProposerOpenProposalsKeyPrefix = []byte{0x70}
+
+	ProposerVetoRecordsKeyPrefix = []byte{0x80}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -107,6 +147,63 @@ func VoteKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
 	return append(VotesKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
 }
 
+// ValidatorTalliesKey gets the first part of the validator tallies key based
+// on the proposalID
+func ValidatorTalliesKey(proposalID uint64) []byte {
+	return append(ValidatorTalliesKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// ValidatorTallyKey key of a specific validator tally from the store
+func ValidatorTallyKey(proposalID uint64, valAddr sdk.ValAddress) []byte {
+	return append(ValidatorTalliesKey(proposalID), address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// VoteFeeSponsorshipsKey gets the first part of the vote fee sponsorships key
+// based on the proposalID
+func VoteFeeSponsorshipsKey(proposalID uint64) []byte {
+	return append(VoteFeeSponsorshipsKeyPrefix, GetProposalIDBytes(proposalID)...)
+}
+
+// VoteFeeSponsorshipKey key of a specific vote fee sponsorship from the store
+func VoteFeeSponsorshipKey(proposalID uint64, voterAddr sdk.AccAddress) []byte {
+	return append(VoteFeeSponsorshipsKey(proposalID), address.MustLengthPrefix(voterAddr.Bytes())...)
+}
+
+// GovMsgCountsKey gets the first part of the governance message counts key
+// based on the address
+func GovMsgCountsKey(addr sdk.AccAddress) []byte {
+	return append(GovMsgCountsKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GovMsgCountKey key of the governance message count of an address at a
+// specific height
+func GovMsgCountKey(addr sdk.AccAddress, height int64) []byte {
+	return append(GovMsgCountsKey(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GovMsgCountsByHeightKey gets the first part of the governance message counts
+// by height key based on the height
+func GovMsgCountsByHeightKey(height int64) []byte {
+	return append(GovMsgCountsByHeightKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GovMsgCountByHeightKey key of the index of the governance message count of
+// an address at a specific height, used to prune the counts by height
+func GovMsgCountByHeightKey(height int64, addr sdk.AccAddress) []byte {
+	return append(GovMsgCountsByHeightKey(height), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// ProposerOpenProposalsKey key of the number of proposals in deposit or voting
+// period of a proposer
+func ProposerOpenProposalsKey(proposerAddr sdk.AccAddress) []byte {
+	return append(ProposerOpenProposalsKeyPrefix, address.MustLengthPrefix(proposerAddr.Bytes())...)
+}
+
+// ProposerVetoRecordKey key of the veto record of a proposer
+func ProposerVetoRecordKey(proposerAddr sdk.AccAddress) []byte {
+	return append(ProposerVetoRecordsKeyPrefix, address.MustLengthPrefix(proposerAddr.Bytes())...)
+}
+
 // Split keys function; used for iterators
 
 // SplitProposalKey split the proposal key and returns the proposal id
@@ -136,6 +233,16 @@ func SplitKeyVote(key []byte) (proposalID uint64, voterAddr sdk.AccAddress) {
 	return splitKeyWithAddress(key)
 }
 
+// SplitGovMsgCountByHeightKey split the governance message counts by height
+// key and returns the height and address
+func SplitGovMsgCountByHeightKey(key []byte) (height int64, addr sdk.AccAddress) {
+	kv.AssertKeyAtLeastLength(key, 10)
+	height = int64(sdk.BigEndianToUint64(key[1:9]))
+	kv.AssertKeyAtLeastLength(key, 11)
+	addr = sdk.AccAddress(key[10:])
+	return
+}
+
 // private functions
 
 func splitKeyWithTime(key []byte) (proposalID uint64, endTime time.Time) {
@@ -13,14 +13,26 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgDeposit        = "deposit"
-	TypeMsgVote           = "vote"
-	TypeMsgVoteWeighted   = "weighted_vote"
-	TypeMsgSubmitProposal = "submit_proposal"
+	TypeMsgDeposit         = "deposit"
+	TypeMsgWithdrawDeposit = "withdraw_deposit"
+	TypeMsgVote            = "vote"
+	TypeMsgVoteWeighted    = "weighted_vote"
+	TypeMsgVoteBatch       = "vote_batch"
+	TypeMsgSubmitProposal  = "submit_proposal"
+	TypeMsgUpdateParams    = "update_params"
 )
 
+// MaxVoteRationaleLen is the maximum length in bytes of a vote rationale,
+// checked before the max_vote_rationale_length voting param so that larger
+// rationales don't reach the mempool. The param can't exceed it.
+const MaxVoteRationaleLen = 10000
+
+// MaxVoteBatchSize is the maximum number of votes of a MsgVoteBatch.
+const MaxVoteBatchSize = 100
+
 var (
 	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}
+	_, _, _    sdk.Msg                       = &MsgVoteBatch{}, &MsgUpdateParams{}, &MsgWithdrawDeposit{}
 	_          types.UnpackInterfacesMessage = &MsgSubmitProposal{}
 )
 
@@ -173,11 +185,51 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{depositor}
 }
 
+// NewMsgWithdrawDeposit creates a new MsgWithdrawDeposit instance
+//
+//nolint:interfacer
+func NewMsgWithdrawDeposit(depositor sdk.AccAddress, proposalID uint64) *MsgWithdrawDeposit {
+	return &MsgWithdrawDeposit{proposalID, depositor.String()}
+}
+
+// Route implements Msg
+func (msg MsgWithdrawDeposit) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgWithdrawDeposit) Type() string { return TypeMsgWithdrawDeposit }
+
+// ValidateBasic implements Msg
+func (msg MsgWithdrawDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgWithdrawDeposit) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgWithdrawDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgWithdrawDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
-func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption) *MsgVote {
-	return &MsgVote{proposalID, voter.String(), option}
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, rationale string) *MsgVote {
+	return &MsgVote{proposalID, voter.String(), option, rationale}
 }
 
 // Route implements Msg
@@ -196,7 +248,7 @@ func (msg MsgVote) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidVote, msg.Option.String())
 	}
 
-	return nil
+	return validateVoteRationale(msg.Rationale)
 }
 
 // String implements the Stringer interface
@@ -220,8 +272,8 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 // NewMsgVoteWeighted creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
-func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions) *MsgVoteWeighted {
-	return &MsgVoteWeighted{proposalID, voter.String(), options}
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options WeightedVoteOptions, rationale string) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter.String(), options, rationale}
 }
 
 // Route implements Msg
@@ -236,13 +288,32 @@ func (msg MsgVoteWeighted) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter)
 	}
 
-	if len(msg.Options) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, WeightedVoteOptions(msg.Options).String())
+	if err := validateWeightedVoteOptions(msg.Options); err != nil {
+		return err
+	}
+
+	return validateVoteRationale(msg.Rationale)
+}
+
+// validateVoteRationale checks that rationale doesn't exceed
+// MaxVoteRationaleLen.
+func validateVoteRationale(rationale string) error {
+	if len(rationale) > MaxVoteRationaleLen {
+		return sdkerrors.Wrapf(ErrVoteRationaleTooLong, "got %d, max %d", len(rationale), MaxVoteRationaleLen)
+	}
+	return nil
+}
+
+// validateWeightedVoteOptions checks that options are valid, not duplicated
+// and that their weights sum up to 1.
+func validateWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, options.String())
 	}
 
 	totalWeight := sdk.NewDec(0)
 	usedOptions := make(map[VoteOption]bool)
-	for _, option := range msg.Options {
+	for _, option := range options {
 		if !ValidWeightedVoteOption(option) {
 			return sdkerrors.Wrap(ErrInvalidVote, option.String())
 		}
@@ -281,3 +352,111 @@ func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
 	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
 	return []sdk.AccAddress{voter}
 }
+
+// NewMsgVoteBatch creates a message to cast weighted votes on several active
+// proposals
+//
+//nolint:interfacer
+func NewMsgVoteBatch(voter sdk.AccAddress, votes []BatchVote) *MsgVoteBatch {
+	return &MsgVoteBatch{voter.String(), votes}
+}
+
+// Route implements Msg
+func (msg MsgVoteBatch) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteBatch) Type() string { return TypeMsgVoteBatch }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteBatch) ValidateBasic() error {
+	if msg.Voter == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Voter)
+	}
+
+	if len(msg.Votes) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty vote batch")
+	}
+	if len(msg.Votes) > MaxVoteBatchSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "vote batch too large: got %d votes, max %d", len(msg.Votes), MaxVoteBatchSize)
+	}
+
+	usedProposals := make(map[uint64]bool)
+	for _, vote := range msg.Votes {
+		if usedProposals[vote.ProposalId] {
+			return sdkerrors.Wrapf(ErrInvalidVote, "duplicated vote for proposal %d", vote.ProposalId)
+		}
+		usedProposals[vote.ProposalId] = true
+
+		if err := validateWeightedVoteOptions(vote.Options); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", vote.ProposalId)
+		}
+		if err := validateVoteRationale(vote.Rationale); err != nil {
+			return sdkerrors.Wrapf(err, "proposal %d", vote.ProposalId)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgVoteBatch) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteBatch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteBatch) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgUpdateParams creates a message to update the deposit, voting and tally
+// params with the given authority.
+//
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, depositParams DepositParams, votingParams VotingParams, tallyParams TallyParams) *MsgUpdateParams {
+	return &MsgUpdateParams{authority.String(), depositParams, votingParams, tallyParams}
+}
+
+// Route implements Msg
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := ValidateParams(msg.DepositParams, msg.VotingParams, msg.TallyParams); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (msg MsgUpdateParams) String() string {
+	out, _ := yaml.Marshal(msg)
+	return string(out)
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
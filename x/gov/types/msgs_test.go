@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
@@ -92,6 +93,37 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawDeposit
+func TestMsgWithdrawDeposit(t *testing.T) {
+	tests := []struct {
+		proposalID    uint64
+		depositorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{0, addrs[0], true},
+		{1, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgWithdrawDeposit(tc.depositorAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawDepositGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	msg := NewMsgWithdrawDeposit(addr, 1)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"govgen/MsgWithdrawDeposit","value":{"depositor":"cosmos1v9jxgu33kfsgr5","proposal_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	tests := []struct {
@@ -109,7 +141,7 @@ func TestMsgVote(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgVote(tc.voterAddr, tc.proposalID, tc.option)
+		msg := NewMsgVote(tc.voterAddr, tc.proposalID, tc.option, "")
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -153,7 +185,116 @@ func TestMsgVoteWeighted(t *testing.T) {
 	}
 
 	for i, tc := range tests {
-		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options, "")
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test the rationale length bound of the ValidateBasic of the votes
+func TestMsgVoteRationaleLength(t *testing.T) {
+	maxRationale := strings.Repeat("a", MaxVoteRationaleLen)
+	tooLongRationale := maxRationale + "a"
+	option := NewNonSplitVoteOption(OptionYes)
+
+	require.NoError(t, NewMsgVote(addrs[0], 1, OptionYes, maxRationale).ValidateBasic())
+	require.ErrorIs(t, NewMsgVote(addrs[0], 1, OptionYes, tooLongRationale).ValidateBasic(), ErrVoteRationaleTooLong)
+
+	require.NoError(t, NewMsgVoteWeighted(addrs[0], 1, option, maxRationale).ValidateBasic())
+	require.ErrorIs(t, NewMsgVoteWeighted(addrs[0], 1, option, tooLongRationale).ValidateBasic(), ErrVoteRationaleTooLong)
+
+	batch := NewMsgVoteBatch(addrs[0], []BatchVote{
+		{ProposalId: 1, Options: option, Rationale: maxRationale},
+		{ProposalId: 2, Options: option, Rationale: tooLongRationale},
+	})
+	require.ErrorIs(t, batch.ValidateBasic(), ErrVoteRationaleTooLong)
+	batch.Votes = batch.Votes[:1]
+	require.NoError(t, batch.ValidateBasic())
+}
+
+// test ValidateBasic for MsgVoteBatch
+func TestMsgVoteBatch(t *testing.T) {
+	tests := []struct {
+		voterAddr  sdk.AccAddress
+		votes      []BatchVote
+		expectPass bool
+	}{
+		{addrs[0], []BatchVote{{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)}}, true},
+		{addrs[0], []BatchVote{
+			{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)},
+			{ProposalId: 2, Options: WeightedVoteOptions{
+				WeightedVoteOption{Option: OptionNo, Weight: sdk.NewDecWithPrec(5, 1)},
+				WeightedVoteOption{Option: OptionAbstain, Weight: sdk.NewDecWithPrec(5, 1)},
+			}, Rationale: "split"},
+		}, true},
+		{sdk.AccAddress{}, []BatchVote{{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)}}, false},
+		{addrs[0], []BatchVote{}, false},
+		{addrs[0], []BatchVote{ // duplicate proposal
+			{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)},
+			{ProposalId: 1, Options: NewNonSplitVoteOption(OptionNo)},
+		}, false},
+		{addrs[0], []BatchVote{ // invalid option
+			{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)},
+			{ProposalId: 2, Options: NewNonSplitVoteOption(VoteOption(0x13))},
+		}, false},
+		{addrs[0], []BatchVote{{ProposalId: 1, Options: WeightedVoteOptions{}}}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteBatch(tc.voterAddr, tc.votes)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test the batch size bound of the ValidateBasic of MsgVoteBatch
+func TestMsgVoteBatchSize(t *testing.T) {
+	votes := make([]BatchVote, MaxVoteBatchSize+1)
+	for i := range votes {
+		votes[i] = BatchVote{ProposalId: uint64(i + 1), Options: NewNonSplitVoteOption(OptionYes)}
+	}
+
+	require.NoError(t, NewMsgVoteBatch(addrs[0], votes[:MaxVoteBatchSize]).ValidateBasic())
+	require.ErrorIs(t, NewMsgVoteBatch(addrs[0], votes).ValidateBasic(), sdkerrors.ErrInvalidRequest)
+}
+
+// test that MsgVoteBatch can be Amino JSON encoded for legacy signing
+func TestMsgVoteBatchGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	msg := NewMsgVoteBatch(addr, []BatchVote{{ProposalId: 1, Options: NewNonSplitVoteOption(OptionYes)}})
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"govgen/MsgVoteBatch","value":{"voter":"cosmos1v9jxgu33kfsgr5","votes":[{"options":[{"option":1,"weight":"1.000000000000000000"}],"proposal_id":"1"}]}}`
+	require.Equal(t, expected, string(res))
+}
+
+// test ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParams(t *testing.T) {
+	vetoAboveThreshold := DefaultTallyParams()
+	vetoAboveThreshold.VetoThreshold = sdk.NewDecWithPrec(6, 1)
+	invalidDeposit := DefaultDepositParams()
+	invalidDeposit.MaxDepositPeriod = 0
+
+	tests := []struct {
+		authority     sdk.AccAddress
+		depositParams DepositParams
+		tallyParams   TallyParams
+		expectPass    bool
+	}{
+		{addrs[0], DefaultDepositParams(), DefaultTallyParams(), true},
+		{sdk.AccAddress{}, DefaultDepositParams(), DefaultTallyParams(), false},
+		{addrs[0], invalidDeposit, DefaultTallyParams(), false},
+		{addrs[0], DefaultDepositParams(), vetoAboveThreshold, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgUpdateParams(tc.authority, tc.depositParams, DefaultVotingParams(), tc.tallyParams)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -2,12 +2,17 @@ package types
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Default period for deposits & voting
@@ -18,12 +23,25 @@ const (
 	DefaultPeriodText            time.Duration = time.Hour * 24 * 365 // 1 year
 )
 
+// Default voting params
+const (
+	DefaultMaxVoteRationaleLength        uint64 = 255 // maximum length, in bytes, of a vote rationale
+	DefaultEarlyTerminationCheckInterval uint64 = 0   // early termination disabled
+)
+
+// MaxEarlyTerminationChecks is the maximum number of active proposals whose
+// outcome is checked every EarlyTerminationCheckInterval blocks. The checked
+// proposals rotate over the check heights when there are more.
+const MaxEarlyTerminationChecks = 10
+
 // Default governance params
 var (
 	DefaultMinDepositTokens = sdk.NewInt(10000000)
 	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
 	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
 	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+
+	DefaultVoteFeeSponsorshipMinBonded = sdk.NewInt(1000000)
 )
 
 // Parameter store key
@@ -31,6 +49,8 @@ var (
 	ParamStoreKeyDepositParams = []byte("depositparams")
 	ParamStoreKeyVotingParams  = []byte("votingparams")
 	ParamStoreKeyTallyParams   = []byte("tallyparams")
+
+	ParamStoreKeyParamChangePolicy = []byte("paramchangepolicy")
 )
 
 // ParamKeyTable - Key declaration for parameters
@@ -39,14 +59,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 		paramtypes.NewParamSetPair(ParamStoreKeyDepositParams, DepositParams{}, validateDepositParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyVotingParams, VotingParams{}, validateVotingParams),
 		paramtypes.NewParamSetPair(ParamStoreKeyTallyParams, TallyParams{}, validateTallyParams),
+		paramtypes.NewParamSetPair(ParamStoreKeyParamChangePolicy, ParamChangePolicy{}, validateParamChangePolicy),
 	)
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, allowedContentTypes []string, maxProposalsPerProposer uint64,
+	vetoCooldownPeriod time.Duration, vetoMinDepositMultiplier, inactiveProposalRefundRatio sdk.Dec,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: maxDepositPeriod,
+		MinDeposit:                  minDeposit,
+		MaxDepositPeriod:            maxDepositPeriod,
+		AllowedContentTypes:         allowedContentTypes,
+		MaxProposalsPerProposer:     maxProposalsPerProposer,
+		VetoCooldownPeriod:          vetoCooldownPeriod,
+		VetoMinDepositMultiplier:    vetoMinDepositMultiplier,
+		InactiveProposalRefundRatio: inactiveProposalRefundRatio,
 	}
 }
 
@@ -55,6 +84,11 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		nil,
+		0,
+		0,
+		sdk.ZeroDec(),
+		sdk.ZeroDec(),
 	)
 }
 
@@ -66,7 +100,51 @@ func (dp DepositParams) String() string {
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	if len(dp.AllowedContentTypes) != len(dp2.AllowedContentTypes) {
+		return false
+	}
+	for i, typeURL := range dp.AllowedContentTypes {
+		if typeURL != dp2.AllowedContentTypes[i] {
+			return false
+		}
+	}
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.MaxProposalsPerProposer == dp2.MaxProposalsPerProposer && dp.VetoCooldownPeriod == dp2.VetoCooldownPeriod &&
+		dp.vetoMinDepositMultiplier().Equal(dp2.vetoMinDepositMultiplier()) &&
+		dp.inactiveProposalRefundRatio().Equal(dp2.inactiveProposalRefundRatio())
+}
+
+// vetoMinDepositMultiplier returns the veto min deposit multiplier, an unset
+// multiplier being 0.
+func (dp DepositParams) vetoMinDepositMultiplier() sdk.Dec {
+	if dp.VetoMinDepositMultiplier.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return dp.VetoMinDepositMultiplier
+}
+
+// inactiveProposalRefundRatio returns the inactive proposal refund ratio, an
+// unset ratio being 0.
+func (dp DepositParams) inactiveProposalRefundRatio() sdk.Dec {
+	if dp.InactiveProposalRefundRatio.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return dp.InactiveProposalRefundRatio
+}
+
+// IsContentTypeAllowed returns true if a proposal content with the given type
+// URL can be submitted. All the content types are allowed if
+// AllowedContentTypes is empty.
+func (dp DepositParams) IsContentTypeAllowed(typeURL string) bool {
+	if len(dp.AllowedContentTypes) == 0 {
+		return true
+	}
+	for _, allowed := range dp.AllowedContentTypes {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
 }
 
 func validateDepositParams(i interface{}) error {
@@ -81,6 +159,26 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if v.VetoCooldownPeriod < 0 {
+		return fmt.Errorf("veto cooldown period cannot be negative: %s", v.VetoCooldownPeriod)
+	}
+	if !v.VetoMinDepositMultiplier.IsNil() && v.VetoMinDepositMultiplier.IsNegative() {
+		return fmt.Errorf("veto min deposit multiplier cannot be negative: %s", v.VetoMinDepositMultiplier)
+	}
+	if !v.InactiveProposalRefundRatio.IsNil() &&
+		(v.InactiveProposalRefundRatio.IsNegative() || v.InactiveProposalRefundRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("inactive proposal refund ratio must be between 0 and 1: %s", v.InactiveProposalRefundRatio)
+	}
+	seenContentTypes := make(map[string]bool, len(v.AllowedContentTypes))
+	for _, typeURL := range v.AllowedContentTypes {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid allowed content type: %q", typeURL)
+		}
+		if seenContentTypes[typeURL] {
+			return fmt.Errorf("duplicate allowed content type: %s", typeURL)
+		}
+		seenContentTypes[typeURL] = true
+	}
 
 	return nil
 }
@@ -139,18 +237,23 @@ func validateTallyParams(i interface{}) error {
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriodDefault, votingPeriodParameterChange, votingPeriodSoftwareUpgrade, votingPeriodText time.Duration) VotingParams {
+func NewVotingParams(votingPeriodDefault, votingPeriodParameterChange, votingPeriodSoftwareUpgrade, votingPeriodText time.Duration, maxVoteRationaleLength, earlyTerminationCheckInterval uint64, voteFeeSponsorshipBudget sdk.Coins, voteFeeSponsorshipMinBonded sdk.Int, govMsgRateLimit GovMsgRateLimit) VotingParams {
 	return VotingParams{
-		VotingPeriodDefault:         votingPeriodDefault,
-		VotingPeriodParameterChange: votingPeriodParameterChange,
-		VotingPeriodSoftwareUpgrade: votingPeriodSoftwareUpgrade,
-		VotingPeriodText:            votingPeriodText,
+		VotingPeriodDefault:           votingPeriodDefault,
+		VotingPeriodParameterChange:   votingPeriodParameterChange,
+		VotingPeriodSoftwareUpgrade:   votingPeriodSoftwareUpgrade,
+		VotingPeriodText:              votingPeriodText,
+		MaxVoteRationaleLength:        maxVoteRationaleLength,
+		EarlyTerminationCheckInterval: earlyTerminationCheckInterval,
+		VoteFeeSponsorshipBudget:      voteFeeSponsorshipBudget,
+		VoteFeeSponsorshipMinBonded:   voteFeeSponsorshipMinBonded,
+		GovMsgRateLimit:               govMsgRateLimit,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod, DefaultPeriodParameterChange, DefaultPeriodSoftwareUpgrade, DefaultPeriodText)
+	return NewVotingParams(DefaultPeriod, DefaultPeriodParameterChange, DefaultPeriodSoftwareUpgrade, DefaultPeriodText, DefaultMaxVoteRationaleLength, DefaultEarlyTerminationCheckInterval, nil, DefaultVoteFeeSponsorshipMinBonded, GovMsgRateLimit{})
 }
 
 // Equal checks equality of TallyParams
@@ -158,7 +261,27 @@ func (vp VotingParams) Equal(other VotingParams) bool {
 	return vp.VotingPeriodDefault == other.VotingPeriodDefault &&
 		vp.VotingPeriodParameterChange == other.VotingPeriodParameterChange &&
 		vp.VotingPeriodSoftwareUpgrade == other.VotingPeriodSoftwareUpgrade &&
-		vp.VotingPeriodText == other.VotingPeriodText
+		vp.VotingPeriodText == other.VotingPeriodText &&
+		vp.MaxVoteRationaleLength == other.MaxVoteRationaleLength &&
+		vp.EarlyTerminationCheckInterval == other.EarlyTerminationCheckInterval &&
+		vp.VoteFeeSponsorshipBudget.IsEqual(other.VoteFeeSponsorshipBudget) &&
+		vp.voteFeeSponsorshipMinBonded().Equal(other.voteFeeSponsorshipMinBonded()) &&
+		vp.GovMsgRateLimit == other.GovMsgRateLimit
+}
+
+// voteFeeSponsorshipMinBonded returns the minimum bonded stake for the vote
+// fee sponsorship, an unset minimum being 0.
+func (vp VotingParams) voteFeeSponsorshipMinBonded() sdk.Int {
+	if vp.VoteFeeSponsorshipMinBonded.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return vp.VoteFeeSponsorshipMinBonded
+}
+
+// IsVoteFeeSponsorshipEligible returns true if an account with the given
+// bonded stake can have the fees of its votes paid by the vote fee pool.
+func (vp VotingParams) IsVoteFeeSponsorshipEligible(bonded sdk.Int) bool {
+	return bonded.IsPositive() && bonded.GTE(vp.voteFeeSponsorshipMinBonded())
 }
 
 // String implements stringer interface
@@ -185,32 +308,249 @@ func validateVotingParams(i interface{}) error {
 	if v.VotingPeriodText <= 0 {
 		return fmt.Errorf("voting period for text proposals must be positive: %s", v.VotingPeriodText)
 	}
+	if v.MaxVoteRationaleLength > MaxVoteRationaleLen {
+		return fmt.Errorf("max vote rationale length too large: %d > %d", v.MaxVoteRationaleLength, MaxVoteRationaleLen)
+	}
+	if !v.VoteFeeSponsorshipBudget.IsValid() {
+		return fmt.Errorf("invalid vote fee sponsorship budget: %s", v.VoteFeeSponsorshipBudget)
+	}
+	if !v.VoteFeeSponsorshipMinBonded.IsNil() && v.VoteFeeSponsorshipMinBonded.IsNegative() {
+		return fmt.Errorf("vote fee sponsorship min bonded cannot be negative: %s", v.VoteFeeSponsorshipMinBonded)
+	}
+	if v.GovMsgRateLimit.Window > math.MaxInt64 {
+		return fmt.Errorf("governance message rate limit window too large: %d", v.GovMsgRateLimit.Window)
+	}
+
+	return nil
+}
+
+// NewGovMsgRateLimit creates a new GovMsgRateLimit object
+func NewGovMsgRateLimit(window, maxProposals, maxVotes uint64) GovMsgRateLimit {
+	return GovMsgRateLimit{
+		Window:       window,
+		MaxProposals: maxProposals,
+		MaxVotes:     maxVotes,
+	}
+}
+
+// String implements stringer interface
+func (rl GovMsgRateLimit) String() string {
+	out, _ := yaml.Marshal(rl)
+	return string(out)
+}
+
+// IsEnabled returns true if the window of the rate limit is not empty and at
+// least one of the maximums is set.
+func (rl GovMsgRateLimit) IsEnabled() bool {
+	return rl.Window > 0 && (rl.MaxProposals > 0 || rl.MaxVotes > 0)
+}
+
+// NewParamChangePolicy creates a new ParamChangePolicy object
+func NewParamChangePolicy(allowlist bool, rules []ParamChangeRule) ParamChangePolicy {
+	return ParamChangePolicy{
+		Allowlist: allowlist,
+		Rules:     rules,
+	}
+}
+
+// NewParamChangeRule creates a new ParamChangeRule object
+func NewParamChangeRule(subspace, key string, denied bool, threshold sdk.Dec) ParamChangeRule {
+	return ParamChangeRule{
+		Subspace:  subspace,
+		Key:       key,
+		Denied:    denied,
+		Threshold: threshold,
+	}
+}
+
+// DefaultParamChangePolicy default policy for parameter changes, which denies
+// changing the staking bond denom, and the policy itself so the denial can't
+// be lifted by a parameter change proposal.
+func DefaultParamChangePolicy() ParamChangePolicy {
+	return NewParamChangePolicy(false, []ParamChangeRule{
+		NewParamChangeRule(stakingtypes.ModuleName, string(stakingtypes.KeyBondDenom), true, sdk.ZeroDec()),
+		NewParamChangeRule(ModuleName, string(ParamStoreKeyParamChangePolicy), true, sdk.ZeroDec()),
+	})
+}
+
+// String implements stringer insterface
+func (p ParamChangePolicy) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Equal checks equality of ParamChangePolicy
+func (p ParamChangePolicy) Equal(other ParamChangePolicy) bool {
+	if p.Allowlist != other.Allowlist || len(p.Rules) != len(other.Rules) {
+		return false
+	}
+	for i, rule := range p.Rules {
+		if !rule.Equal(other.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Rule returns the rule applying to a parameter, the rule for the key taking
+// precedence over the rule for the whole subspace.
+func (p ParamChangePolicy) Rule(subspace, key string) (rule ParamChangeRule, found bool) {
+	for _, r := range p.Rules {
+		if r.Subspace != subspace {
+			continue
+		}
+		if r.Key == key {
+			return r, true
+		}
+		if r.Key == "" {
+			rule, found = r, true
+		}
+	}
+	return rule, found
+}
+
+// ValidateChanges returns an error if one of the parameter changes is not
+// allowed by the policy.
+func (p ParamChangePolicy) ValidateChanges(changes []paramsproposal.ParamChange) error {
+	for _, change := range changes {
+		rule, found := p.Rule(change.Subspace, change.Key)
+		if (p.Allowlist && !found) || rule.Denied {
+			return sdkerrors.Wrapf(ErrParamChangeNotAllowed, "%s/%s", change.Subspace, change.Key)
+		}
+	}
+	return nil
+}
+
+// Threshold returns the threshold for a proposal with the given parameter
+// changes to pass, which is the strictest of the given tally threshold and of
+// the thresholds of the rules applying to the changes.
+func (p ParamChangePolicy) Threshold(changes []paramsproposal.ParamChange, threshold sdk.Dec) sdk.Dec {
+	for _, change := range changes {
+		rule, found := p.Rule(change.Subspace, change.Key)
+		if found && !rule.Threshold.IsNil() && rule.Threshold.GT(threshold) {
+			threshold = rule.Threshold
+		}
+	}
+	return threshold
+}
+
+// String implements stringer insterface
+func (r ParamChangeRule) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// Equal checks equality of ParamChangeRule
+func (r ParamChangeRule) Equal(other ParamChangeRule) bool {
+	return r.Subspace == other.Subspace && r.Key == other.Key && r.Denied == other.Denied &&
+		r.Threshold.IsNil() == other.Threshold.IsNil() && (r.Threshold.IsNil() || r.Threshold.Equal(other.Threshold))
+}
+
+func validateParamChangePolicy(i interface{}) error {
+	v, ok := i.(ParamChangePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenRules := make(map[string]bool, len(v.Rules))
+	for _, rule := range v.Rules {
+		if rule.Subspace == "" {
+			return fmt.Errorf("param change rule subspace cannot be empty")
+		}
+		ruleKey := rule.Subspace + "/" + rule.Key
+		if seenRules[ruleKey] {
+			return fmt.Errorf("duplicate param change rule: %s", ruleKey)
+		}
+		seenRules[ruleKey] = true
+		if rule.Threshold.IsNil() {
+			continue
+		}
+		if rule.Threshold.IsNegative() {
+			return fmt.Errorf("param change rule threshold cannot be negative: %s", rule.Threshold)
+		}
+		if rule.Threshold.GT(sdk.OneDec()) {
+			return fmt.Errorf("param change rule threshold too large: %s", rule.Threshold)
+		}
+	}
+
+	// The change of the policy must be at least as strict as the other rules,
+	// otherwise a first proposal could remove a rule that a second proposal
+	// would then bypass.
+	policyRule, found := v.Rule(ModuleName, string(ParamStoreKeyParamChangePolicy))
+	if (v.Allowlist && !found) || policyRule.Denied {
+		return nil
+	}
+	if v.Allowlist {
+		return fmt.Errorf("param change policy must be denied in allowlist mode")
+	}
+	policyThreshold := sdk.ZeroDec()
+	if !policyRule.Threshold.IsNil() {
+		policyThreshold = policyRule.Threshold
+	}
+	for _, rule := range v.Rules {
+		if rule.Denied || (!rule.Threshold.IsNil() && rule.Threshold.GT(policyThreshold)) {
+			return fmt.Errorf("param change rule %s/%s is stricter than the rule of the param change policy", rule.Subspace, rule.Key)
+		}
+	}
 
 	return nil
 }
 
 // Params returns all of the governance params
 type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+	VotingParams      VotingParams      `json:"voting_params" yaml:"voting_params"`
+	TallyParams       TallyParams       `json:"tally_params" yaml:"tally_params"`
+	DepositParams     DepositParams     `json:"deposit_params" yaml:"deposit_params"`
+	ParamChangePolicy ParamChangePolicy `json:"param_change_policy" yaml:"param_change_policy"`
 }
 
 func (gp Params) String() string {
 	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+		gp.TallyParams.String() + "\n" + gp.DepositParams.String() + "\n" +
+		gp.ParamChangePolicy.String()
 }
 
 // NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
+func NewParams(vp VotingParams, tp TallyParams, dp DepositParams, pcp ParamChangePolicy) Params {
 	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+		VotingParams:      vp,
+		DepositParams:     dp,
+		TallyParams:       tp,
+		ParamChangePolicy: pcp,
 	}
 }
 
+// Validate performs basic validation on the governance params
+func (gp Params) Validate() error {
+	if err := ValidateParams(gp.DepositParams, gp.VotingParams, gp.TallyParams); err != nil {
+		return err
+	}
+	return validateParamChangePolicy(gp.ParamChangePolicy)
+}
+
+// ValidateParams validates the deposit, voting and tally params together, so
+// the checks across them are performed in addition to their own validation.
+func ValidateParams(dp DepositParams, vp VotingParams, tp TallyParams) error {
+	if err := validateDepositParams(dp); err != nil {
+		return err
+	}
+	if err := validateVotingParams(vp); err != nil {
+		return err
+	}
+	if err := validateTallyParams(tp); err != nil {
+		return err
+	}
+
+	// a veto burns the deposits, it must not require more votes than the ones
+	// needed for the proposal to pass
+	if tp.VetoThreshold.GT(tp.Threshold) {
+		return fmt.Errorf("veto threshold %s cannot be greater than the threshold %s", tp.VetoThreshold, tp.Threshold)
+	}
+
+	return nil
+}
+
 // DefaultParams default governance params
 func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams(), DefaultParamChangePolicy())
 }
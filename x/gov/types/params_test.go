@@ -0,0 +1,180 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func TestParamChangePolicyRule(t *testing.T) {
+	policy := types.NewParamChangePolicy(false, []types.ParamChangeRule{
+		types.NewParamChangeRule("staking", "BondDenom", true, sdk.ZeroDec()),
+		types.NewParamChangeRule("staking", "", false, sdk.NewDecWithPrec(6, 1)),
+	})
+
+	rule, found := policy.Rule("staking", "BondDenom")
+	require.True(t, found)
+	require.True(t, rule.Denied)
+
+	rule, found = policy.Rule("staking", "MaxValidators")
+	require.True(t, found)
+	require.Equal(t, "", rule.Key)
+
+	_, found = policy.Rule("bank", "SendEnabled")
+	require.False(t, found)
+}
+
+func TestParamChangePolicyThreshold(t *testing.T) {
+	policy := types.NewParamChangePolicy(false, []types.ParamChangeRule{
+		types.NewParamChangeRule("staking", "MaxValidators", false, sdk.NewDecWithPrec(75, 2)),
+		types.NewParamChangeRule("staking", "", false, sdk.NewDecWithPrec(6, 1)),
+		types.NewParamChangeRule("bank", "", false, sdk.NewDecWithPrec(4, 1)),
+	})
+	threshold := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name     string
+		changes  []paramsproposal.ParamChange
+		expected sdk.Dec
+	}{
+		{"no rule", []paramsproposal.ParamChange{{Subspace: "mint", Key: "InflationMax"}}, threshold},
+		{"looser rule", []paramsproposal.ParamChange{{Subspace: "bank", Key: "SendEnabled"}}, threshold},
+		{"subspace rule", []paramsproposal.ParamChange{{Subspace: "staking", Key: "UnbondingTime"}}, sdk.NewDecWithPrec(6, 1)},
+		{"strictest rule", []paramsproposal.ParamChange{
+			{Subspace: "staking", Key: "UnbondingTime"},
+			{Subspace: "staking", Key: "MaxValidators"},
+		}, sdk.NewDecWithPrec(75, 2)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, policy.Threshold(tt.changes, threshold))
+		})
+	}
+}
+
+func TestValidateParamChangePolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    types.ParamChangePolicy
+		expectErr bool
+	}{
+		{"default", types.DefaultParamChangePolicy(), false},
+		{"empty", types.ParamChangePolicy{}, false},
+		{"empty subspace", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("", "BondDenom", true, sdk.ZeroDec()),
+		}), true},
+		{"duplicate rule", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", true, sdk.ZeroDec()),
+			types.NewParamChangeRule("staking", "", false, sdk.ZeroDec()),
+		}), true},
+		{"threshold too large", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", false, sdk.NewDec(2)),
+		}), true},
+		{"zero threshold", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", false, sdk.ZeroDec()),
+		}), false},
+		{"negative threshold", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", false, sdk.NewDec(-1)),
+		}), true},
+		{"denied rule with policy changeable", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "BondDenom", true, sdk.ZeroDec()),
+		}), true},
+		{"denied rule with policy denied by gov subspace rule", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "BondDenom", true, sdk.ZeroDec()),
+			types.NewParamChangeRule("gov", "", true, sdk.ZeroDec()),
+		}), false},
+		{"threshold stricter than policy threshold", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", false, sdk.NewDecWithPrec(75, 2)),
+			types.NewParamChangeRule("gov", "paramchangepolicy", false, sdk.NewDecWithPrec(67, 2)),
+		}), true},
+		{"policy threshold as strict as other thresholds", types.NewParamChangePolicy(false, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "", false, sdk.NewDecWithPrec(67, 2)),
+			types.NewParamChangeRule("gov", "paramchangepolicy", false, sdk.NewDecWithPrec(67, 2)),
+		}), false},
+		{"allowlist without policy", types.NewParamChangePolicy(true, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "MaxValidators", false, sdk.ZeroDec()),
+		}), false},
+		{"allowlist with policy allowed", types.NewParamChangePolicy(true, []types.ParamChangeRule{
+			types.NewParamChangeRule("staking", "MaxValidators", false, sdk.ZeroDec()),
+			types.NewParamChangeRule("gov", "paramchangepolicy", false, sdk.ZeroDec()),
+		}), true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := types.DefaultGenesisState()
+			genesis.ParamChangePolicy = tt.policy
+			err := types.ValidateGenesis(genesis)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateInactiveProposalRefundRatio(t *testing.T) {
+	tests := []struct {
+		name      string
+		ratio     sdk.Dec
+		expectErr bool
+	}{
+		{"unset", sdk.Dec{}, false},
+		{"burn", sdk.ZeroDec(), false},
+		{"partial refund", sdk.NewDecWithPrec(5, 1), false},
+		{"refund", sdk.OneDec(), false},
+		{"negative", sdk.NewDecWithPrec(-1, 1), true},
+		{"greater than one", sdk.NewDecWithPrec(11, 1), true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := types.DefaultGenesisState()
+			genesis.DepositParams.InactiveProposalRefundRatio = tt.ratio
+			err := types.ValidateGenesis(genesis)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateVoteFeeSponsorshipMinBonded(t *testing.T) {
+	tests := []struct {
+		name      string
+		minBonded sdk.Int
+		expectErr bool
+	}{
+		{"unset", sdk.Int{}, false},
+		{"zero", sdk.ZeroInt(), false},
+		{"positive", sdk.NewInt(1000000), false},
+		{"negative", sdk.NewInt(-1), true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := types.DefaultGenesisState()
+			genesis.VotingParams.VoteFeeSponsorshipMinBonded = tt.minBonded
+			err := types.ValidateGenesis(genesis)
+			if tt.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expectErr, types.ValidateParams(genesis.DepositParams, genesis.VotingParams, genesis.TallyParams) != nil)
+		})
+	}
+}
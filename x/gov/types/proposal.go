@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 // DefaultStartingProposalID is 1
@@ -179,7 +180,9 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 
 // Proposal types
 const (
-	ProposalTypeText string = "Text"
+	ProposalTypeText            string = "Text"
+	ProposalTypeFundVoteFeePool string = "FundVoteFeePool"
+	ProposalTypeUpdateParams    string = "UpdateParams"
 )
 
 // Implements Content Interface
@@ -211,8 +214,102 @@ func (tp TextProposal) String() string {
 	return string(out)
 }
 
+// Implements Content Interface
+var _ Content = &FundVoteFeePoolProposal{}
+
+// NewFundVoteFeePoolProposal creates a proposal Content which transfers amount
+// from the community pool to the vote fee pool.
+func NewFundVoteFeePoolProposal(title, description string, amount sdk.Coins) Content {
+	return &FundVoteFeePoolProposal{title, description, amount}
+}
+
+// GetTitle returns the proposal title
+func (fp *FundVoteFeePoolProposal) GetTitle() string { return fp.Title }
+
+// GetDescription returns the proposal description
+func (fp *FundVoteFeePoolProposal) GetDescription() string { return fp.Description }
+
+// ProposalRoute returns the proposal router key
+func (fp *FundVoteFeePoolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "FundVoteFeePool"
+func (fp *FundVoteFeePoolProposal) ProposalType() string { return ProposalTypeFundVoteFeePool }
+
+// ValidateBasic validates the content's title and description of the proposal,
+// and that the amount is positive.
+func (fp *FundVoteFeePoolProposal) ValidateBasic() error {
+	if err := ValidateAbstract(fp); err != nil {
+		return err
+	}
+	if !fp.Amount.IsValid() || fp.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fp.Amount.String())
+	}
+	return nil
+}
+
+// String implements Stringer interface
+func (fp FundVoteFeePoolProposal) String() string {
+	out, _ := yaml.Marshal(fp)
+	return string(out)
+}
+
+// Implements Content Interface
+var _ Content = &UpdateParamsProposal{}
+
+// NewUpdateParamsProposal creates a proposal Content which updates the deposit,
+// voting and tally params.
+func NewUpdateParamsProposal(title, description string, depositParams DepositParams, votingParams VotingParams, tallyParams TallyParams) Content {
+	return &UpdateParamsProposal{title, description, depositParams, votingParams, tallyParams}
+}
+
+// GetTitle returns the proposal title
+func (up *UpdateParamsProposal) GetTitle() string { return up.Title }
+
+// GetDescription returns the proposal description
+func (up *UpdateParamsProposal) GetDescription() string { return up.Description }
+
+// ProposalRoute returns the proposal router key
+func (up *UpdateParamsProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType is "UpdateParams"
+func (up *UpdateParamsProposal) ProposalType() string { return ProposalTypeUpdateParams }
+
+// ValidateBasic validates the content's title and description of the proposal,
+// and the params it sets.
+func (up *UpdateParamsProposal) ValidateBasic() error {
+	if err := ValidateAbstract(up); err != nil {
+		return err
+	}
+	if err := ValidateParams(up.DepositParams, up.VotingParams, up.TallyParams); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// ParamChanges returns the proposal as changes of the params of the gov
+// subspace, so the ParamChangePolicy applies to it like to a parameter change
+// proposal.
+func (up *UpdateParamsProposal) ParamChanges() []paramsproposal.ParamChange {
+	return []paramsproposal.ParamChange{
+		paramsproposal.NewParamChange(ModuleName, string(ParamStoreKeyDepositParams),
+			string(ModuleCdc.LegacyAmino.MustMarshalJSON(up.DepositParams))),
+		paramsproposal.NewParamChange(ModuleName, string(ParamStoreKeyVotingParams),
+			string(ModuleCdc.LegacyAmino.MustMarshalJSON(up.VotingParams))),
+		paramsproposal.NewParamChange(ModuleName, string(ParamStoreKeyTallyParams),
+			string(ModuleCdc.LegacyAmino.MustMarshalJSON(up.TallyParams))),
+	}
+}
+
+// String implements Stringer interface
+func (up UpdateParamsProposal) String() string {
+	out, _ := yaml.Marshal(up)
+	return string(out)
+}
+
 var validProposalTypes = map[string]struct{}{
-	ProposalTypeText: {},
+	ProposalTypeText:            {},
+	ProposalTypeFundVoteFeePool: {},
+	ProposalTypeUpdateParams:    {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
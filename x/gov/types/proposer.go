@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewProposerVetoRecord creates a new ProposerVetoRecord instance
+func NewProposerVetoRecord(proposer sdk.AccAddress, vetoedProposals uint64, cooldownEndTime time.Time) ProposerVetoRecord {
+	return ProposerVetoRecord{
+		Proposer:        proposer.String(),
+		VetoedProposals: vetoedProposals,
+		CooldownEndTime: cooldownEndTime,
+	}
+}
+
+// String implements stringer interface
+func (r ProposerVetoRecord) String() string {
+	out, _ := yaml.Marshal(r)
+	return string(out)
+}
+
+// InCooldown returns true if the proposer can't submit proposals at blockTime
+func (r ProposerVetoRecord) InCooldown(blockTime time.Time) bool {
+	return r.CooldownEndTime.After(blockTime)
+}
+
+// ProposerVetoRecords is a collection of ProposerVetoRecord objects
+type ProposerVetoRecords []ProposerVetoRecord
+
+// Equal returns true if two slices (order-dependant) of proposer veto records
+// are equal.
+func (r ProposerVetoRecords) Equal(other ProposerVetoRecords) bool {
+	if len(r) != len(other) {
+		return false
+	}
+
+	for i, record := range r {
+		if !record.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r ProposerVetoRecords) String() string {
+	if len(r) == 0 {
+		return "[]"
+	}
+	out := "Proposer veto records:"
+	for _, record := range r {
+		out += fmt.Sprintf("\n  %s: %d vetoed proposals, cooldown until %s",
+			record.Proposer, record.VetoedProposals, record.CooldownEndTime)
+	}
+	return out
+}
+
+// ProposerMinDeposit returns the minimum deposit of the proposals of a proposer
+// with vetoedProposals vetoed proposals, which is minDeposit multiplied by
+// 1 + VetoMinDepositMultiplier * vetoedProposals.
+func (dp DepositParams) ProposerMinDeposit(vetoedProposals uint64) sdk.Coins {
+	multiplier := dp.vetoMinDepositMultiplier()
+	if !multiplier.IsPositive() || vetoedProposals == 0 {
+		return dp.MinDeposit
+	}
+
+	factor := sdk.OneDec().Add(multiplier.MulInt64(int64(vetoedProposals)))
+	minDeposit := make(sdk.Coins, len(dp.MinDeposit))
+	for i, coin := range dp.MinDeposit {
+		minDeposit[i] = sdk.NewCoin(coin.Denom, factor.MulInt(coin.Amount).Ceil().TruncateInt())
+	}
+	return minDeposit
+}
@@ -17,15 +17,20 @@ const (
 	QueryVote      = "vote"
 	QueryTally     = "tally"
 
+	QueryStatusDetail = "status_detail"
+
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
 	ParamTallying = "tallying"
+
+	ParamParamChange = "param_change"
 )
 
 // QueryProposalParams Params for queries:
 // - 'custom/gov/proposal'
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
+// - 'custom/gov/status_detail'
 type QueryProposalParams struct {
 	ProposalID uint64
 }
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -421,7 +422,7 @@ func (m *QueryVotesResponse) GetPagination() *query.PageResponse {
 // QueryParamsRequest is the request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 	// params_type defines which parameters to query for, can be one of "voting",
-	// "tallying" or "deposit".
+	// "tallying", "deposit" or "param_change".
 	ParamsType string `protobuf:"bytes,1,opt,name=params_type,json=paramsType,proto3" json:"params_type,omitempty"`
 }
 
@@ -473,6 +474,9 @@ type QueryParamsResponse struct {
 	DepositParams DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params"`
 	// tally_params defines the parameters related to tally.
 	TallyParams TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params"`
+	// param_change_policy defines the restrictions on the parameters that can
+	// be changed by a parameter change proposal.
+	ParamChangePolicy ParamChangePolicy `protobuf:"bytes,4,opt,name=param_change_policy,json=paramChangePolicy,proto3" json:"param_change_policy"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -529,6 +533,13 @@ func (m *QueryParamsResponse) GetTallyParams() TallyParams {
 	return TallyParams{}
 }
 
+func (m *QueryParamsResponse) GetParamChangePolicy() ParamChangePolicy {
+	if m != nil {
+		return m.ParamChangePolicy
+	}
+	return ParamChangePolicy{}
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -817,1321 +828,4973 @@ func (m *QueryTallyResultResponse) GetTally() TallyResult {
 	return TallyResult{}
 }
 
-func init() {
-	proto.RegisterType((*QueryProposalRequest)(nil), "govgen.gov.v1beta1.QueryProposalRequest")
-	proto.RegisterType((*QueryProposalResponse)(nil), "govgen.gov.v1beta1.QueryProposalResponse")
-	proto.RegisterType((*QueryProposalsRequest)(nil), "govgen.gov.v1beta1.QueryProposalsRequest")
-	proto.RegisterType((*QueryProposalsResponse)(nil), "govgen.gov.v1beta1.QueryProposalsResponse")
-	proto.RegisterType((*QueryVoteRequest)(nil), "govgen.gov.v1beta1.QueryVoteRequest")
-	proto.RegisterType((*QueryVoteResponse)(nil), "govgen.gov.v1beta1.QueryVoteResponse")
-	proto.RegisterType((*QueryVotesRequest)(nil), "govgen.gov.v1beta1.QueryVotesRequest")
-	proto.RegisterType((*QueryVotesResponse)(nil), "govgen.gov.v1beta1.QueryVotesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "govgen.gov.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "govgen.gov.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryDepositRequest)(nil), "govgen.gov.v1beta1.QueryDepositRequest")
-	proto.RegisterType((*QueryDepositResponse)(nil), "govgen.gov.v1beta1.QueryDepositResponse")
-	proto.RegisterType((*QueryDepositsRequest)(nil), "govgen.gov.v1beta1.QueryDepositsRequest")
-	proto.RegisterType((*QueryDepositsResponse)(nil), "govgen.gov.v1beta1.QueryDepositsResponse")
-	proto.RegisterType((*QueryTallyResultRequest)(nil), "govgen.gov.v1beta1.QueryTallyResultRequest")
-	proto.RegisterType((*QueryTallyResultResponse)(nil), "govgen.gov.v1beta1.QueryTallyResultResponse")
+// QueryContentTypesRequest is the request type for the Query/ContentTypes RPC
+// method.
+type QueryContentTypesRequest struct {
 }
 
-func init() { proto.RegisterFile("govgen/gov/v1beta1/query.proto", fileDescriptor_f96995b4bb8283af) }
-
-var fileDescriptor_f96995b4bb8283af = []byte{
-	// 972 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x55,
-	0x10, 0xf6, 0x4b, 0x9c, 0xd6, 0x9e, 0xb4, 0x01, 0x86, 0x00, 0xd6, 0x12, 0xec, 0xb0, 0xa2, 0xad,
-	0x9b, 0xd2, 0x5d, 0x92, 0x14, 0x50, 0x5b, 0x40, 0x25, 0xa0, 0xb6, 0xa8, 0x12, 0x2a, 0x4e, 0x05,
-	0x12, 0x07, 0xa2, 0x75, 0xb3, 0xda, 0xae, 0xe4, 0xec, 0xdb, 0xfa, 0x3d, 0x5b, 0x44, 0x21, 0x42,
-	0xe2, 0x04, 0xe2, 0x02, 0x2a, 0xe2, 0x86, 0xa8, 0x54, 0x89, 0xbf, 0xa5, 0xc7, 0x4a, 0x70, 0xe0,
-	0x84, 0x50, 0xc2, 0x01, 0xf1, 0x37, 0x70, 0x40, 0xfb, 0x7e, 0xac, 0x77, 0xed, 0xb5, 0x77, 0x5d,
-	0xa2, 0xde, 0xbc, 0xf3, 0xbe, 0x99, 0xf9, 0xbe, 0x99, 0x79, 0xf3, 0x12, 0xa8, 0x7b, 0xb4, 0xef,
-	0xb9, 0x81, 0xed, 0xd1, 0xbe, 0xdd, 0x5f, 0x6d, 0xbb, 0xdc, 0x59, 0xb5, 0xef, 0xf6, 0xdc, 0xee,
-	0xae, 0x15, 0x76, 0x29, 0xa7, 0x88, 0xf2, 0xdc, 0xf2, 0x68, 0xdf, 0x52, 0xe7, 0xc6, 0xca, 0x6d,
-	0xca, 0x76, 0x28, 0xb3, 0xdb, 0x0e, 0x73, 0x25, 0x38, 0x76, 0x0d, 0x1d, 0xcf, 0x0f, 0x1c, 0xee,
-	0xd3, 0x40, 0xfa, 0x1b, 0x8b, 0x1e, 0xf5, 0xa8, 0xf8, 0x69, 0x47, 0xbf, 0x94, 0x75, 0xc9, 0xa3,
-	0xd4, 0xeb, 0xb8, 0xb6, 0x13, 0xfa, 0xb6, 0x13, 0x04, 0x94, 0x0b, 0x17, 0x36, 0x38, 0x1d, 0xe1,
-	0x14, 0xe5, 0x17, 0xa7, 0xe6, 0x9b, 0xb0, 0xf8, 0x51, 0x94, 0xf3, 0x66, 0x97, 0x86, 0x94, 0x39,
-	0x9d, 0x96, 0x7b, 0xb7, 0xe7, 0x32, 0x8e, 0x0d, 0x98, 0x0f, 0x95, 0x69, 0xcb, 0xdf, 0xae, 0x91,
-	0x65, 0xd2, 0x2c, 0xb7, 0x40, 0x9b, 0x3e, 0xd8, 0x36, 0x3f, 0x81, 0xe7, 0x86, 0x1c, 0x59, 0x48,
-	0x03, 0xe6, 0xe2, 0x3b, 0x50, 0xd1, 0x30, 0xe1, 0x36, 0xbf, 0xb6, 0x64, 0x8d, 0xca, 0xb6, 0xb4,
-	0xdf, 0x46, 0xf9, 0xe1, 0x1f, 0x8d, 0x52, 0x2b, 0xf6, 0x31, 0xff, 0x21, 0x43, 0x91, 0x99, 0xe6,
-	0x74, 0x03, 0x9e, 0x8a, 0x39, 0x31, 0xee, 0xf0, 0x1e, 0x13, 0x09, 0x16, 0xd6, 0xcc, 0x49, 0x09,
-	0x36, 0x05, 0xb2, 0xb5, 0x10, 0xa6, 0xbe, 0x71, 0x11, 0xe6, 0xfa, 0x94, 0xbb, 0xdd, 0xda, 0xcc,
-	0x32, 0x69, 0x56, 0x5b, 0xf2, 0x03, 0x97, 0xa0, 0xba, 0xed, 0x86, 0x94, 0xf9, 0x9c, 0x76, 0x6b,
-	0xb3, 0xe2, 0x64, 0x60, 0xc0, 0xab, 0x00, 0x83, 0x96, 0xd4, 0xca, 0x42, 0xdc, 0x69, 0x4b, 0xf6,
-	0xcf, 0x8a, 0xfa, 0x67, 0xc9, 0x66, 0xc7, 0x14, 0x1c, 0xcf, 0x55, 0xe4, 0x5b, 0x09, 0xcf, 0x4b,
-	0x95, 0xaf, 0xef, 0x37, 0x4a, 0x7f, 0xdf, 0x6f, 0x94, 0xcc, 0x07, 0x04, 0x9e, 0x1f, 0x16, 0xab,
-	0xea, 0x78, 0x05, 0xaa, 0x9a, 0x72, 0xa4, 0x73, 0xb6, 0x60, 0x21, 0x07, 0x4e, 0x78, 0x2d, 0x45,
-	0x77, 0x46, 0xd0, 0x3d, 0x93, 0x4b, 0x57, 0xa6, 0x4f, 0xf2, 0x35, 0x37, 0xe1, 0x69, 0x41, 0xf2,
-	0x63, 0xca, 0xdd, 0xa2, 0x03, 0x92, 0x5d, 0xe0, 0x84, 0xf4, 0x6b, 0xf0, 0x4c, 0x22, 0xa8, 0x12,
-	0xbd, 0x06, 0xe5, 0x08, 0xa7, 0x06, 0xa7, 0x96, 0xa5, 0x37, 0xc2, 0x2b, 0xad, 0x02, 0x6b, 0x7e,
-	0x91, 0x08, 0xc4, 0x0a, 0xd3, 0xbb, 0x9a, 0x51, 0x9c, 0xc7, 0xe8, 0xa5, 0x79, 0x8f, 0x00, 0x26,
-	0xd3, 0x2b, 0x21, 0x17, 0xa4, 0x7a, 0xdd, 0xb9, 0x3c, 0x25, 0x12, 0x7c, 0x74, 0x1d, 0x7b, 0x5d,
-	0x91, 0xba, 0xe9, 0x74, 0x9d, 0x9d, 0x54, 0x51, 0x84, 0x61, 0x8b, 0xef, 0x86, 0xb2, 0xc8, 0xd5,
-	0xc8, 0x2d, 0x32, 0xdd, 0xda, 0x0d, 0x5d, 0xf3, 0x5f, 0x02, 0xcf, 0xa6, 0xfc, 0x94, 0x9a, 0x1b,
-	0x70, 0xb2, 0x4f, 0xb9, 0x1f, 0x78, 0x5b, 0x12, 0xac, 0xfa, 0xb3, 0x3c, 0x46, 0x95, 0x1f, 0x78,
-	0x32, 0x80, 0x52, 0x77, 0xa2, 0x9f, 0xb0, 0xe1, 0x87, 0xb0, 0xa0, 0xae, 0x94, 0x8e, 0x26, 0x85,
-	0xbe, 0x9c, 0x15, 0xed, 0x7d, 0x89, 0x4c, 0x85, 0x3b, 0xb9, 0x9d, 0x34, 0xe2, 0x75, 0x38, 0xc1,
-	0x9d, 0x4e, 0x67, 0x57, 0x47, 0x9b, 0x15, 0xd1, 0x1a, 0x59, 0xd1, 0x6e, 0x45, 0xb8, 0x54, 0xac,
-	0x79, 0x3e, 0x30, 0x99, 0x9f, 0x29, 0xf5, 0x2a, 0x69, 0xe1, 0x59, 0x4a, 0x6d, 0x8d, 0x99, 0xa1,
-	0xad, 0x91, 0x18, 0xf9, 0x4d, 0xb5, 0x6c, 0xe3, 0xf8, 0xaa, 0xbc, 0x97, 0xe1, 0xb8, 0x82, 0xab,
-	0xc2, 0xbe, 0x38, 0xa1, 0x14, 0x8a, 0xb8, 0xf6, 0x30, 0xbf, 0x4c, 0x07, 0x7d, 0xf2, 0x37, 0xe0,
-	0x67, 0xbd, 0xb0, 0x07, 0x0c, 0x94, 0xae, 0xb7, 0xa1, 0xa2, 0x58, 0xea, 0x7b, 0x50, 0x40, 0x58,
-	0xec, 0x72, 0x74, 0xb7, 0xe1, 0x12, 0xbc, 0x20, 0x08, 0x8a, 0xf6, 0xb7, 0x5c, 0xd6, 0xeb, 0xf0,
-	0x29, 0xde, 0xb9, 0xda, 0xa8, 0x6f, 0xdc, 0xb7, 0x39, 0x31, 0x3e, 0xaa, 0x6b, 0xe3, 0x47, 0x4e,
-	0xfa, 0xe9, 0xbb, 0x2e, 0x7c, 0xd6, 0x7e, 0xab, 0xc2, 0x9c, 0x88, 0x8c, 0x3f, 0x10, 0xa8, 0xe8,
-	0x2d, 0x8e, 0xcd, 0xac, 0x20, 0x59, 0x4f, 0xb4, 0x71, 0xb6, 0x00, 0x52, 0x12, 0x35, 0xd7, 0xbf,
-	0xfa, 0xf5, 0xaf, 0x7b, 0x33, 0xe7, 0xf1, 0x9c, 0x9d, 0xf1, 0xc7, 0x40, 0xfc, 0x60, 0xd8, 0x7b,
-	0x89, 0x52, 0xec, 0xe3, 0x37, 0x04, 0xaa, 0xf1, 0xb3, 0x84, 0xf9, 0xd9, 0xf4, 0xe4, 0x19, 0x2b,
-	0x45, 0xa0, 0x8a, 0xd9, 0x29, 0xc1, 0xac, 0x81, 0x2f, 0x4d, 0x64, 0x86, 0x3f, 0x12, 0x28, 0x47,
-	0xeb, 0x12, 0x5f, 0x19, 0x1b, 0x3b, 0xf1, 0x38, 0x19, 0xa7, 0x72, 0x50, 0x2a, 0xf9, 0xbb, 0x22,
-	0xf9, 0x65, 0xbc, 0x38, 0x45, 0x59, 0x6c, 0xb1, 0xa9, 0xed, 0x3d, 0xf1, 0x9c, 0xed, 0xe3, 0xf7,
-	0x04, 0xe6, 0xc4, 0xe6, 0xc7, 0xc9, 0x39, 0xe3, 0xe2, 0x9c, 0xce, 0x83, 0x29, 0x6e, 0x17, 0x05,
-	0xb7, 0x75, 0x5c, 0x9d, 0x9a, 0x1b, 0x7e, 0x4b, 0xe0, 0x98, 0xda, 0x8d, 0xe3, 0xb3, 0xa5, 0x5e,
-	0x06, 0xe3, 0x4c, 0x2e, 0x4e, 0xd1, 0x7a, 0x4d, 0xd0, 0x5a, 0xc1, 0x66, 0x26, 0x2d, 0x81, 0xb5,
-	0xf7, 0x12, 0x8f, 0xcc, 0x3e, 0xfe, 0x42, 0xe0, 0xb8, 0xba, 0xe1, 0x38, 0x3e, 0x4d, 0x7a, 0xe5,
-	0x1a, 0xcd, 0x7c, 0xa0, 0x22, 0x74, 0x5d, 0x10, 0xda, 0xc0, 0x2b, 0xd3, 0xd4, 0x49, 0xaf, 0x18,
-	0x7b, 0x2f, 0x5e, 0xd3, 0xfb, 0xf8, 0x13, 0x81, 0x8a, 0x5e, 0x61, 0x98, 0x4b, 0x80, 0xe5, 0x5f,
-	0xc3, 0xe1, 0x7d, 0x68, 0xbe, 0x25, 0xb8, 0xbe, 0x81, 0x17, 0x1e, 0x87, 0x2b, 0x3e, 0x20, 0x30,
-	0x9f, 0xd8, 0x26, 0x78, 0x6e, 0x6c, 0xe2, 0xd1, 0x3d, 0x67, 0xbc, 0x5a, 0x0c, 0xfc, 0x7f, 0x86,
-	0x4f, 0xac, 0xb5, 0x8d, 0xf7, 0x1e, 0x1e, 0xd4, 0xc9, 0xa3, 0x83, 0x3a, 0xf9, 0xf3, 0xa0, 0x4e,
-	0xbe, 0x3b, 0xac, 0x97, 0x1e, 0x1d, 0xd6, 0x4b, 0xbf, 0x1f, 0xd6, 0x4b, 0x9f, 0x9e, 0xf5, 0x7c,
-	0x7e, 0xa7, 0xd7, 0xb6, 0x6e, 0xd3, 0x1d, 0xdb, 0xe1, 0x74, 0x87, 0x06, 0xee, 0xf9, 0x3b, 0xbd,
-	0xb6, 0x4e, 0xf1, 0xb9, 0x48, 0x12, 0xcd, 0x0c, 0x6b, 0x1f, 0x13, 0xff, 0x9c, 0xac, 0xff, 0x17,
-	0x00, 0x00, 0xff, 0xff, 0xfb, 0x4b, 0x80, 0x6f, 0x50, 0x0d, 0x00, 0x00,
+func (m *QueryContentTypesRequest) Reset()         { *m = QueryContentTypesRequest{} }
+func (m *QueryContentTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContentTypesRequest) ProtoMessage()    {}
+func (*QueryContentTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{16}
+}
+func (m *QueryContentTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContentTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentTypesRequest.Merge(m, src)
+}
+func (m *QueryContentTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentTypesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryContentTypesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Proposal queries proposal details based on ProposalID.
-	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
-	// Proposals queries all proposals based on given status.
-	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
-	// Vote queries voted information based on proposalID, voterAddr.
-	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
-	// Votes queries votes of a given proposal.
-	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
-	// Params queries all parameters of the gov module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Deposit queries single deposit information based proposalID, depositAddr.
-	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
-	// Deposits queries all deposits of a single proposal.
-	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
-	// TallyResult queries the tally of a proposal vote.
-	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+// ContentTypeInfo defines a proposal content type registered in the
+// application.
+type ContentTypeInfo struct {
+	// type_url is the type URL of the content.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// proposal_route is the route of the proposal handler of the content.
+	ProposalRoute string `protobuf:"bytes,2,opt,name=proposal_route,json=proposalRoute,proto3" json:"proposal_route,omitempty"`
+	// routed is true if the router has a proposal handler for proposal_route.
+	Routed bool `protobuf:"varint,3,opt,name=routed,proto3" json:"routed,omitempty"`
+	// allowed is true if the content can be submitted according to the params.
+	Allowed bool `protobuf:"varint,4,opt,name=allowed,proto3" json:"allowed,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ContentTypeInfo) Reset()         { *m = ContentTypeInfo{} }
+func (m *ContentTypeInfo) String() string { return proto.CompactTextString(m) }
+func (*ContentTypeInfo) ProtoMessage()    {}
+func (*ContentTypeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{17}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ContentTypeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
-	out := new(QueryProposalResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Proposal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContentTypeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentTypeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ContentTypeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentTypeInfo.Merge(m, src)
+}
+func (m *ContentTypeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentTypeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentTypeInfo.DiscardUnknown(m)
 }
 
-func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
-	out := new(QueryProposalsResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Proposals", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ContentTypeInfo proto.InternalMessageInfo
+
+func (m *ContentTypeInfo) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
-	out := new(QueryVoteResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Vote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContentTypeInfo) GetProposalRoute() string {
+	if m != nil {
+		return m.ProposalRoute
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
-	out := new(QueryVotesResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Votes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContentTypeInfo) GetRouted() bool {
+	if m != nil {
+		return m.Routed
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ContentTypeInfo) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
-	out := new(QueryDepositResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryContentTypesResponse is the response type for the Query/ContentTypes
+// RPC method.
+type QueryContentTypesResponse struct {
+	// allowed_content_types is the allowed_content_types deposit param, all the
+	// routed content types are allowed if empty.
+	AllowedContentTypes []string `protobuf:"bytes,1,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty"`
+	// content_types defines the content types registered in the application.
+	ContentTypes []ContentTypeInfo `protobuf:"bytes,2,rep,name=content_types,json=contentTypes,proto3" json:"content_types"`
+	// routes defines the routes registered in the proposal router.
+	Routes []string `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
 }
 
-func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
-	out := new(QueryDepositsResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Deposits", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryContentTypesResponse) Reset()         { *m = QueryContentTypesResponse{} }
+func (m *QueryContentTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContentTypesResponse) ProtoMessage()    {}
+func (*QueryContentTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{18}
+}
+func (m *QueryContentTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryContentTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentTypesResponse.Merge(m, src)
+}
+func (m *QueryContentTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentTypesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
-	out := new(QueryTallyResultResponse)
-	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/TallyResult", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContentTypesResponse proto.InternalMessageInfo
+
+func (m *QueryContentTypesResponse) GetAllowedContentTypes() []string {
+	if m != nil {
+		return m.AllowedContentTypes
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Proposal queries proposal details based on ProposalID.
-	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
-	// Proposals queries all proposals based on given status.
-	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
-	// Vote queries voted information based on proposalID, voterAddr.
-	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
-	// Votes queries votes of a given proposal.
-	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
-	// Params queries all parameters of the gov module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Deposit queries single deposit information based proposalID, depositAddr.
-	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
-	// Deposits queries all deposits of a single proposal.
-	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
-	// TallyResult queries the tally of a proposal vote.
-	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+func (m *QueryContentTypesResponse) GetContentTypes() []ContentTypeInfo {
+	if m != nil {
+		return m.ContentTypes
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryContentTypesResponse) GetRoutes() []string {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+// QueryDryRunParamChangesRequest is the request type for the
+// Query/DryRunParamChanges RPC method.
+type QueryDryRunParamChangesRequest struct {
+	// changes defines the parameter changes to check, applied in order.
+	Changes []proposal.ParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
 }
-func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+
+func (m *QueryDryRunParamChangesRequest) Reset()         { *m = QueryDryRunParamChangesRequest{} }
+func (m *QueryDryRunParamChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDryRunParamChangesRequest) ProtoMessage()    {}
+func (*QueryDryRunParamChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{19}
 }
-func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+func (m *QueryDryRunParamChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+func (m *QueryDryRunParamChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDryRunParamChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDryRunParamChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDryRunParamChangesRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *QueryDryRunParamChangesRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+func (m *QueryDryRunParamChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDryRunParamChangesRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
+
+var xxx_messageInfo_QueryDryRunParamChangesRequest proto.InternalMessageInfo
+
+func (m *QueryDryRunParamChangesRequest) GetChanges() []proposal.ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// ParamChangeResult defines the result of the check of a parameter change.
+type ParamChangeResult struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// error is the reason why the change is rejected, empty if it is accepted.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Proposal",
+func (m *ParamChangeResult) Reset()         { *m = ParamChangeResult{} }
+func (m *ParamChangeResult) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeResult) ProtoMessage()    {}
+func (*ParamChangeResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{20}
+}
+func (m *ParamChangeResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamChangeResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamChangeResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+}
+func (m *ParamChangeResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeResult.Merge(m, src)
+}
+func (m *ParamChangeResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamChangeResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeResult proto.InternalMessageInfo
+
+func (m *ParamChangeResult) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProposalsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proposals(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Proposals",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+func (m *ParamChangeResult) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Vote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Vote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+func (m *ParamChangeResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Votes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Votes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDryRunParamChangesResponse is the response type for the
+// Query/DryRunParamChanges RPC method.
+type QueryDryRunParamChangesResponse struct {
+	// valid is true if all the changes are accepted.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// results defines the result of the check of each change.
+	Results []ParamChangeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryDryRunParamChangesResponse) Reset()         { *m = QueryDryRunParamChangesResponse{} }
+func (m *QueryDryRunParamChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDryRunParamChangesResponse) ProtoMessage()    {}
+func (*QueryDryRunParamChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{21}
 }
-
-func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+func (m *QueryDryRunParamChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDryRunParamChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDryRunParamChangesResponse.Merge(m, src)
+}
+func (m *QueryDryRunParamChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDryRunParamChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDryRunParamChangesResponse.DiscardUnknown(m)
 }
 
-func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDepositsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Deposits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/Deposits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+var xxx_messageInfo_QueryDryRunParamChangesResponse proto.InternalMessageInfo
+
+func (m *QueryDryRunParamChangesResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTallyResultRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TallyResult(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/govgen.gov.v1beta1.Query/TallyResult",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+func (m *QueryDryRunParamChangesResponse) GetResults() []ParamChangeResult {
+	if m != nil {
+		return m.Results
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "govgen.gov.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Proposal",
-			Handler:    _Query_Proposal_Handler,
-		},
-		{
-			MethodName: "Proposals",
-			Handler:    _Query_Proposals_Handler,
-		},
-		{
-			MethodName: "Vote",
-			Handler:    _Query_Vote_Handler,
-		},
-		{
-			MethodName: "Votes",
-			Handler:    _Query_Votes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Deposit",
-			Handler:    _Query_Deposit_Handler,
-		},
-		{
-			MethodName: "Deposits",
-			Handler:    _Query_Deposits_Handler,
-		},
-		{
-			MethodName: "TallyResult",
-			Handler:    _Query_TallyResult_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "govgen/gov/v1beta1/query.proto",
+// QuerySimulateProposalExecutionRequest is the request type for the
+// Query/SimulateProposalExecution RPC method.
+type QuerySimulateProposalExecutionRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySimulateProposalExecutionRequest) Reset()         { *m = QuerySimulateProposalExecutionRequest{} }
+func (m *QuerySimulateProposalExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalExecutionRequest) ProtoMessage()    {}
+func (*QuerySimulateProposalExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{22}
 }
-
-func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateProposalExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySimulateProposalExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateProposalExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalExecutionRequest.Merge(m, src)
+}
+func (m *QuerySimulateProposalExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateProposalExecutionRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalExecutionRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExecutionEvent defines an event emitted by the execution of a proposal.
+type ExecutionEvent struct {
+	Type       string                    `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Attributes []ExecutionEventAttribute `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes"`
 }
 
-func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+func (m *ExecutionEvent) Reset()         { *m = ExecutionEvent{} }
+func (m *ExecutionEvent) String() string { return proto.CompactTextString(m) }
+func (*ExecutionEvent) ProtoMessage()    {}
+func (*ExecutionEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{23}
+}
+func (m *ExecutionEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecutionEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionEvent.Merge(m, src)
+}
+func (m *ExecutionEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionEvent.DiscardUnknown(m)
 }
 
-func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_ExecutionEvent proto.InternalMessageInfo
 
-func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalStatus))
-		i--
-		dAtA[i] = 0x8
+func (m *ExecutionEvent) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExecutionEvent) GetAttributes() []ExecutionEventAttribute {
+	if m != nil {
+		return m.Attributes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ExecutionEventAttribute defines an attribute of an ExecutionEvent.
+type ExecutionEventAttribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proposals) > 0 {
-		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ExecutionEventAttribute) Reset()         { *m = ExecutionEventAttribute{} }
+func (m *ExecutionEventAttribute) String() string { return proto.CompactTextString(m) }
+func (*ExecutionEventAttribute) ProtoMessage()    {}
+func (*ExecutionEventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{24}
+}
+func (m *ExecutionEventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionEventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionEventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ExecutionEventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionEventAttribute.Merge(m, src)
 }
-
-func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutionEventAttribute) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionEventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionEventAttribute.DiscardUnknown(m)
 }
 
-func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_ExecutionEventAttribute proto.InternalMessageInfo
+
+func (m *ExecutionEventAttribute) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ExecutionEventAttribute) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateProposalExecutionResponse is the response type for the
+// Query/SimulateProposalExecution RPC method.
+type QuerySimulateProposalExecutionResponse struct {
+	// success is true if the proposal content would be executed without error.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// error is the execution error, empty on success.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// events defines the events emitted by the execution.
+	Events []ExecutionEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events"`
 }
 
-func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySimulateProposalExecutionResponse) Reset() {
+	*m = QuerySimulateProposalExecutionResponse{}
+}
+func (m *QuerySimulateProposalExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateProposalExecutionResponse) ProtoMessage()    {}
+func (*QuerySimulateProposalExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{25}
+}
+func (m *QuerySimulateProposalExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateProposalExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateProposalExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QuerySimulateProposalExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateProposalExecutionResponse.Merge(m, src)
+}
+func (m *QuerySimulateProposalExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateProposalExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateProposalExecutionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateProposalExecutionResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateProposalExecutionResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateProposalExecutionResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateProposalExecutionResponse) GetEvents() []ExecutionEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+// QueryTallyByValidatorRequest is the request type for the
+// Query/TallyByValidator RPC method.
+type QueryTallyByValidatorRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTallyByValidatorRequest) Reset()         { *m = QueryTallyByValidatorRequest{} }
+func (m *QueryTallyByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyByValidatorRequest) ProtoMessage()    {}
+func (*QueryTallyByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{26}
+}
+func (m *QueryTallyByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryTallyByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyByValidatorRequest.Merge(m, src)
+}
+func (m *QueryTallyByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyByValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTallyByValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryTallyByValidatorRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTallyByValidatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTallyByValidatorResponse is the response type for the
+// Query/TallyByValidator RPC method.
+type QueryTallyByValidatorResponse struct {
+	// validator_tallies defines the tallies of the validators whose delegators
+	// voted.
+	ValidatorTallies []ValidatorTally `protobuf:"bytes,1,rep,name=validator_tallies,json=validatorTallies,proto3" json:"validator_tallies"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryTallyByValidatorResponse) Reset()         { *m = QueryTallyByValidatorResponse{} }
+func (m *QueryTallyByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTallyByValidatorResponse) ProtoMessage()    {}
+func (*QueryTallyByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{27}
+}
+func (m *QueryTallyByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTallyByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTallyByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTallyByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTallyByValidatorResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTallyByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTallyByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTallyByValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ParamsType) > 0 {
-		i -= len(m.ParamsType)
-		copy(dAtA[i:], m.ParamsType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamsType)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTallyByValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryTallyByValidatorResponse) GetValidatorTallies() []ValidatorTally {
+	if m != nil {
+		return m.ValidatorTallies
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTallyByValidatorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalStatusDetailRequest is the request type for the
+// Query/ProposalStatusDetail RPC method.
+type QueryProposalStatusDetailRequest struct {
+	// proposal_id defines the unique id of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProposalStatusDetailRequest) Reset()         { *m = QueryProposalStatusDetailRequest{} }
+func (m *QueryProposalStatusDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalStatusDetailRequest) ProtoMessage()    {}
+func (*QueryProposalStatusDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{28}
+}
+func (m *QueryProposalStatusDetailRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalStatusDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalStatusDetailRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryProposalStatusDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalStatusDetailRequest.Merge(m, src)
 }
-
-func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposalStatusDetailRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryProposalStatusDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalStatusDetailRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalStatusDetailRequest proto.InternalMessageInfo
+
+func (m *QueryProposalStatusDetailRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposalStatusDetailResponse is the response type for the
+// Query/ProposalStatusDetail RPC method.
+type QueryProposalStatusDetailResponse struct {
+	// status_detail defines the projected outcome of the proposal.
+	StatusDetail ProposalStatusDetail `protobuf:"bytes,1,opt,name=status_detail,json=statusDetail,proto3" json:"status_detail"`
 }
 
-func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProposalStatusDetailResponse) Reset()         { *m = QueryProposalStatusDetailResponse{} }
+func (m *QueryProposalStatusDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalStatusDetailResponse) ProtoMessage()    {}
+func (*QueryProposalStatusDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{29}
+}
+func (m *QueryProposalStatusDetailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalStatusDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalStatusDetailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposalStatusDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalStatusDetailResponse.Merge(m, src)
+}
+func (m *QueryProposalStatusDetailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalStatusDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalStatusDetailResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposalStatusDetailResponse proto.InternalMessageInfo
+
+func (m *QueryProposalStatusDetailResponse) GetStatusDetail() ProposalStatusDetail {
+	if m != nil {
+		return m.StatusDetail
 	}
-	return dAtA[:n], nil
+	return ProposalStatusDetail{}
 }
 
-func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposersInCooldownRequest is the request type for the
+// Query/ProposersInCooldown RPC method.
+type QueryProposersInCooldownRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProposersInCooldownRequest) Reset()         { *m = QueryProposersInCooldownRequest{} }
+func (m *QueryProposersInCooldownRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProposersInCooldownRequest) ProtoMessage()    {}
+func (*QueryProposersInCooldownRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{30}
+}
+func (m *QueryProposersInCooldownRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposersInCooldownRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposersInCooldownRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProposersInCooldownRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposersInCooldownRequest.Merge(m, src)
+}
+func (m *QueryProposersInCooldownRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposersInCooldownRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposersInCooldownRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProposersInCooldownRequest proto.InternalMessageInfo
+
+func (m *QueryProposersInCooldownRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProposersInCooldownResponse is the response type for the
+// Query/ProposersInCooldown RPC method.
+type QueryProposersInCooldownResponse struct {
+	// veto_records defines the veto records of the proposers in cooldown.
+	VetoRecords []ProposerVetoRecord `protobuf:"bytes,1,rep,name=veto_records,json=vetoRecords,proto3" json:"veto_records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryProposersInCooldownResponse) Reset()         { *m = QueryProposersInCooldownResponse{} }
+func (m *QueryProposersInCooldownResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposersInCooldownResponse) ProtoMessage()    {}
+func (*QueryProposersInCooldownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f96995b4bb8283af, []int{31}
+}
+func (m *QueryProposersInCooldownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposersInCooldownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposersInCooldownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryProposersInCooldownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposersInCooldownResponse.Merge(m, src)
+}
+func (m *QueryProposersInCooldownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposersInCooldownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposersInCooldownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposersInCooldownResponse proto.InternalMessageInfo
+
+func (m *QueryProposersInCooldownResponse) GetVetoRecords() []ProposerVetoRecord {
+	if m != nil {
+		return m.VetoRecords
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProposersInCooldownResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryProposalRequest)(nil), "govgen.gov.v1beta1.QueryProposalRequest")
+	proto.RegisterType((*QueryProposalResponse)(nil), "govgen.gov.v1beta1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposalsRequest)(nil), "govgen.gov.v1beta1.QueryProposalsRequest")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "govgen.gov.v1beta1.QueryProposalsResponse")
+	proto.RegisterType((*QueryVoteRequest)(nil), "govgen.gov.v1beta1.QueryVoteRequest")
+	proto.RegisterType((*QueryVoteResponse)(nil), "govgen.gov.v1beta1.QueryVoteResponse")
+	proto.RegisterType((*QueryVotesRequest)(nil), "govgen.gov.v1beta1.QueryVotesRequest")
+	proto.RegisterType((*QueryVotesResponse)(nil), "govgen.gov.v1beta1.QueryVotesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "govgen.gov.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "govgen.gov.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryDepositRequest)(nil), "govgen.gov.v1beta1.QueryDepositRequest")
+	proto.RegisterType((*QueryDepositResponse)(nil), "govgen.gov.v1beta1.QueryDepositResponse")
+	proto.RegisterType((*QueryDepositsRequest)(nil), "govgen.gov.v1beta1.QueryDepositsRequest")
+	proto.RegisterType((*QueryDepositsResponse)(nil), "govgen.gov.v1beta1.QueryDepositsResponse")
+	proto.RegisterType((*QueryTallyResultRequest)(nil), "govgen.gov.v1beta1.QueryTallyResultRequest")
+	proto.RegisterType((*QueryTallyResultResponse)(nil), "govgen.gov.v1beta1.QueryTallyResultResponse")
+	proto.RegisterType((*QueryContentTypesRequest)(nil), "govgen.gov.v1beta1.QueryContentTypesRequest")
+	proto.RegisterType((*ContentTypeInfo)(nil), "govgen.gov.v1beta1.ContentTypeInfo")
+	proto.RegisterType((*QueryContentTypesResponse)(nil), "govgen.gov.v1beta1.QueryContentTypesResponse")
+	proto.RegisterType((*QueryDryRunParamChangesRequest)(nil), "govgen.gov.v1beta1.QueryDryRunParamChangesRequest")
+	proto.RegisterType((*ParamChangeResult)(nil), "govgen.gov.v1beta1.ParamChangeResult")
+	proto.RegisterType((*QueryDryRunParamChangesResponse)(nil), "govgen.gov.v1beta1.QueryDryRunParamChangesResponse")
+	proto.RegisterType((*QuerySimulateProposalExecutionRequest)(nil), "govgen.gov.v1beta1.QuerySimulateProposalExecutionRequest")
+	proto.RegisterType((*ExecutionEvent)(nil), "govgen.gov.v1beta1.ExecutionEvent")
+	proto.RegisterType((*ExecutionEventAttribute)(nil), "govgen.gov.v1beta1.ExecutionEventAttribute")
+	proto.RegisterType((*QuerySimulateProposalExecutionResponse)(nil), "govgen.gov.v1beta1.QuerySimulateProposalExecutionResponse")
+	proto.RegisterType((*QueryTallyByValidatorRequest)(nil), "govgen.gov.v1beta1.QueryTallyByValidatorRequest")
+	proto.RegisterType((*QueryTallyByValidatorResponse)(nil), "govgen.gov.v1beta1.QueryTallyByValidatorResponse")
+	proto.RegisterType((*QueryProposalStatusDetailRequest)(nil), "govgen.gov.v1beta1.QueryProposalStatusDetailRequest")
+	proto.RegisterType((*QueryProposalStatusDetailResponse)(nil), "govgen.gov.v1beta1.QueryProposalStatusDetailResponse")
+	proto.RegisterType((*QueryProposersInCooldownRequest)(nil), "govgen.gov.v1beta1.QueryProposersInCooldownRequest")
+	proto.RegisterType((*QueryProposersInCooldownResponse)(nil), "govgen.gov.v1beta1.QueryProposersInCooldownResponse")
+}
+
+func init() { proto.RegisterFile("govgen/gov/v1beta1/query.proto", fileDescriptor_f96995b4bb8283af) }
+
+var fileDescriptor_f96995b4bb8283af = []byte{
+	// 1746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcb, 0x6f, 0x14, 0xc9,
+	0x19, 0x77, 0xfb, 0x39, 0xfe, 0xfc, 0xc0, 0x2e, 0x1b, 0x18, 0x3a, 0x66, 0x6c, 0x9a, 0xd8, 0xf8,
+	0x11, 0xa6, 0xf1, 0x83, 0x44, 0x18, 0x82, 0x8c, 0xcd, 0x53, 0x48, 0x04, 0xc6, 0x3c, 0xa4, 0x44,
+	0xca, 0xa8, 0x3d, 0x53, 0x19, 0x5a, 0x19, 0x77, 0x0d, 0xdd, 0x3d, 0x03, 0x23, 0xc7, 0x89, 0x14,
+	0x29, 0x4a, 0xa2, 0x5c, 0x12, 0x11, 0xe5, 0x10, 0x29, 0x0a, 0x12, 0xd2, 0xfe, 0x03, 0x7b, 0xd8,
+	0xcb, 0xae, 0xb8, 0xad, 0x38, 0x22, 0xed, 0x65, 0x77, 0x0f, 0xab, 0x15, 0xec, 0x61, 0xb5, 0x7f,
+	0xc5, 0xaa, 0xab, 0xbe, 0xea, 0xe9, 0x1e, 0x77, 0xbb, 0x67, 0x8c, 0xc5, 0xc9, 0x5d, 0x55, 0xdf,
+	0xe3, 0xf7, 0xbd, 0xaa, 0xbe, 0x6f, 0x0c, 0x99, 0x12, 0xab, 0x95, 0xa8, 0xa5, 0x97, 0x58, 0x4d,
+	0xaf, 0x2d, 0x6e, 0x51, 0xd7, 0x58, 0xd4, 0x9f, 0x54, 0xa9, 0x5d, 0xcf, 0x56, 0x6c, 0xe6, 0x32,
+	0x42, 0xc4, 0x79, 0xb6, 0xc4, 0x6a, 0x59, 0x3c, 0x57, 0xe7, 0x0b, 0xcc, 0xd9, 0x66, 0x8e, 0xbe,
+	0x65, 0x38, 0x54, 0x10, 0xfb, 0xac, 0x15, 0xa3, 0x64, 0x5a, 0x86, 0x6b, 0x32, 0x4b, 0xf0, 0xab,
+	0xe3, 0x25, 0x56, 0x62, 0xfc, 0x53, 0xf7, 0xbe, 0x70, 0x77, 0xa2, 0xc4, 0x58, 0xa9, 0x4c, 0x75,
+	0xa3, 0x62, 0xea, 0x86, 0x65, 0x31, 0x97, 0xb3, 0x38, 0x8d, 0xd3, 0x3d, 0x98, 0x3c, 0xfd, 0xe2,
+	0x54, 0x43, 0xed, 0x15, 0xc3, 0x36, 0xb6, 0x9d, 0x80, 0x66, 0x6f, 0x29, 0x68, 0xb4, 0x5f, 0xc0,
+	0xf8, 0x3d, 0x0f, 0xd7, 0x5d, 0x9b, 0x55, 0x98, 0x63, 0x94, 0x73, 0xf4, 0x49, 0x95, 0x3a, 0x2e,
+	0x99, 0x84, 0x81, 0x0a, 0x6e, 0xe5, 0xcd, 0x62, 0x5a, 0x99, 0x52, 0x66, 0xbb, 0x73, 0x20, 0xb7,
+	0x6e, 0x15, 0xb5, 0x47, 0x70, 0xb4, 0x89, 0xd1, 0xa9, 0x30, 0xcb, 0xa1, 0xe4, 0x32, 0xa4, 0x24,
+	0x19, 0x67, 0x1b, 0x58, 0x9a, 0xc8, 0xee, 0x75, 0x4d, 0x56, 0xf2, 0xad, 0x77, 0xbf, 0xfe, 0x66,
+	0xb2, 0x23, 0xe7, 0xf3, 0x68, 0x3f, 0x28, 0x4d, 0x92, 0x1d, 0x89, 0xe9, 0x36, 0x1c, 0xf1, 0x31,
+	0x39, 0xae, 0xe1, 0x56, 0x1d, 0xae, 0x60, 0x78, 0x49, 0xdb, 0x4f, 0xc1, 0x26, 0xa7, 0xcc, 0x0d,
+	0x57, 0x42, 0x6b, 0x32, 0x0e, 0x3d, 0x35, 0xe6, 0x52, 0x3b, 0xdd, 0x39, 0xa5, 0xcc, 0xf6, 0xe7,
+	0xc4, 0x82, 0x4c, 0x40, 0x7f, 0x91, 0x56, 0x98, 0x63, 0xba, 0xcc, 0x4e, 0x77, 0xf1, 0x93, 0xc6,
+	0x06, 0xb9, 0x0e, 0xd0, 0x08, 0x5b, 0xba, 0x9b, 0x1b, 0x37, 0x93, 0x15, 0x5e, 0xce, 0x7a, 0x31,
+	0xce, 0x8a, 0x84, 0xf0, 0x21, 0x18, 0x25, 0x8a, 0xe0, 0x73, 0x01, 0xce, 0xd5, 0xd4, 0xdf, 0x5e,
+	0x4c, 0x76, 0x7c, 0xff, 0x62, 0xb2, 0x43, 0x7b, 0xa9, 0xc0, 0xb1, 0x66, 0x63, 0xd1, 0x8f, 0x6b,
+	0xd0, 0x2f, 0x21, 0x7b, 0x76, 0x76, 0xb5, 0xe8, 0xc8, 0x06, 0x13, 0xb9, 0x11, 0x82, 0xdb, 0xc9,
+	0xe1, 0x9e, 0x49, 0x84, 0x2b, 0xd4, 0x07, 0xf1, 0x6a, 0x9b, 0x30, 0xc2, 0x41, 0x3e, 0x64, 0x2e,
+	0x6d, 0x35, 0x41, 0xa2, 0x1d, 0x1c, 0x30, 0xfd, 0x06, 0x8c, 0x06, 0x84, 0xa2, 0xd1, 0x4b, 0xd0,
+	0xed, 0xd1, 0x61, 0xe2, 0xa4, 0xa3, 0xec, 0xf5, 0xe8, 0xd1, 0x56, 0x4e, 0xab, 0xfd, 0x21, 0x20,
+	0xc8, 0x69, 0x19, 0xde, 0xf5, 0x08, 0xe7, 0x1c, 0x20, 0x96, 0xda, 0x73, 0x05, 0x48, 0x50, 0x3d,
+	0x1a, 0xb2, 0x22, 0xac, 0x97, 0x91, 0x4b, 0xb2, 0x44, 0x10, 0x1f, 0x5e, 0xc4, 0xce, 0x23, 0xa8,
+	0xbb, 0xbc, 0xd6, 0x83, 0x4e, 0xe1, 0x1b, 0x79, 0xb7, 0x5e, 0x11, 0x4e, 0xee, 0xcf, 0x81, 0xd8,
+	0xba, 0x5f, 0xaf, 0x50, 0xed, 0xab, 0x4e, 0x18, 0x0b, 0xf1, 0xa1, 0x35, 0xb7, 0x61, 0xa8, 0xc6,
+	0x5c, 0xd3, 0x2a, 0xe5, 0x05, 0x31, 0xc6, 0x67, 0x2a, 0xc6, 0x2a, 0xd3, 0x2a, 0x09, 0x01, 0x68,
+	0xdd, 0x60, 0x2d, 0xb0, 0x47, 0xee, 0xc0, 0x30, 0x96, 0x94, 0x94, 0x26, 0x0c, 0x3d, 0x15, 0x25,
+	0xed, 0xaa, 0xa0, 0x0c, 0x89, 0x1b, 0x2a, 0x06, 0x37, 0xc9, 0x4d, 0x18, 0x74, 0x8d, 0x72, 0xb9,
+	0x2e, 0xa5, 0x75, 0x71, 0x69, 0x93, 0x51, 0xd2, 0xee, 0x7b, 0x74, 0x21, 0x59, 0x03, 0x6e, 0x63,
+	0x8b, 0xfc, 0x06, 0xc6, 0xb8, 0x8c, 0x7c, 0xe1, 0xb1, 0x61, 0x95, 0x68, 0xbe, 0xc2, 0xca, 0x66,
+	0xa1, 0x8e, 0x85, 0x3e, 0x1d, 0x59, 0x7c, 0x1e, 0xf9, 0x06, 0xa7, 0xbe, 0xcb, 0x89, 0x51, 0xec,
+	0x68, 0xa5, 0xf9, 0x40, 0xfb, 0x2d, 0xba, 0x16, 0x2d, 0x6a, 0x39, 0x51, 0x43, 0x57, 0x52, 0x67,
+	0xd3, 0x95, 0x14, 0xa8, 0xa7, 0x4d, 0x18, 0x0f, 0xcb, 0xc7, 0xd8, 0x5d, 0x84, 0x3e, 0x24, 0xc7,
+	0xa8, 0xfd, 0x64, 0x1f, 0x3f, 0x23, 0x7c, 0xc9, 0xa1, 0xfd, 0x29, 0x2c, 0xf4, 0xc3, 0x97, 0xd7,
+	0xff, 0xe5, 0x6b, 0xd0, 0x40, 0x80, 0x76, 0xfd, 0x12, 0x52, 0x88, 0x52, 0x16, 0x59, 0x0b, 0x86,
+	0xf9, 0x2c, 0x87, 0x57, 0x6a, 0xab, 0x70, 0x9c, 0x03, 0xe4, 0xb9, 0x95, 0xa3, 0x4e, 0xb5, 0xec,
+	0xb6, 0xf1, 0x88, 0xa6, 0xf7, 0xf2, 0xfa, 0x71, 0xeb, 0xe1, 0xb9, 0x99, 0x56, 0x12, 0xf2, 0x59,
+	0xf0, 0xc9, 0x8b, 0x84, 0xf3, 0x68, 0x2a, 0x0a, 0xde, 0x60, 0x96, 0x4b, 0x2d, 0xd7, 0x2b, 0x6e,
+	0x19, 0x3b, 0xed, 0x2f, 0x0a, 0x1c, 0x09, 0xec, 0xdf, 0xb2, 0x7e, 0xc7, 0xc8, 0x09, 0x48, 0x79,
+	0x57, 0x42, 0xbe, 0x6a, 0x97, 0xf1, 0x5a, 0xe8, 0xf3, 0xd6, 0x0f, 0xec, 0x32, 0x99, 0x06, 0xff,
+	0xe9, 0xcc, 0xdb, 0xac, 0xea, 0x52, 0x4c, 0xc2, 0x21, 0xb9, 0x9b, 0xf3, 0x36, 0xc9, 0x31, 0xe8,
+	0xe5, 0xa7, 0x45, 0x5e, 0x7f, 0xa9, 0x1c, 0xae, 0x48, 0x1a, 0xfa, 0x8c, 0x72, 0x99, 0x3d, 0xa5,
+	0x45, 0x5e, 0x47, 0xa9, 0x9c, 0x5c, 0x6a, 0x9f, 0x28, 0x70, 0x22, 0x02, 0xa4, 0xff, 0x12, 0x1c,
+	0x45, 0xc2, 0x7c, 0x41, 0x9c, 0xf3, 0x4b, 0x4b, 0xc4, 0xba, 0x3f, 0x37, 0x86, 0x87, 0x41, 0x5e,
+	0x72, 0x07, 0x86, 0xc2, 0xb4, 0x9d, 0x3c, 0x2f, 0x4e, 0x47, 0xb9, 0xae, 0xc9, 0x03, 0xf2, 0xa6,
+	0x2a, 0x04, 0xe5, 0x49, 0x9b, 0xbc, 0x3b, 0xc5, 0x53, 0x8a, 0x2b, 0xad, 0x08, 0x19, 0x91, 0x93,
+	0x76, 0x3d, 0x57, 0xb5, 0x02, 0x77, 0x80, 0x5f, 0x1f, 0xeb, 0xd0, 0x27, 0xee, 0x10, 0x99, 0x9b,
+	0x9a, 0x4c, 0x2d, 0xec, 0xbe, 0x22, 0x2e, 0x10, 0x59, 0x7b, 0xc8, 0xa8, 0x3d, 0x82, 0xd1, 0xc0,
+	0xa9, 0x88, 0x32, 0x51, 0x21, 0xe5, 0x54, 0xb7, 0x9c, 0x8a, 0x51, 0x90, 0xf7, 0xb7, 0xbf, 0x26,
+	0x23, 0xd0, 0xf5, 0x7b, 0x5a, 0xc7, 0xf0, 0x78, 0x9f, 0xde, 0x1b, 0x4c, 0x6d, 0xdb, 0x6f, 0x65,
+	0xc4, 0x42, 0xfb, 0x23, 0x4c, 0xc6, 0xc2, 0x47, 0xef, 0x7b, 0x8f, 0xb7, 0x51, 0xc6, 0x9c, 0x4d,
+	0xe5, 0xc4, 0x82, 0x5c, 0x83, 0x3e, 0x9b, 0xc3, 0x90, 0x9e, 0x4d, 0xba, 0x13, 0x43, 0xa9, 0x29,
+	0x79, 0xb5, 0x9b, 0x30, 0xcd, 0xf5, 0x6f, 0x9a, 0xdb, 0xd5, 0xb2, 0xe1, 0x52, 0xd9, 0xc1, 0x5c,
+	0x7b, 0x46, 0x0b, 0x55, 0xaf, 0xa6, 0x5a, 0xae, 0x9f, 0xa7, 0x30, 0xec, 0x33, 0x5d, 0xab, 0x51,
+	0xcb, 0x25, 0x04, 0xba, 0x03, 0x6f, 0x1b, 0xff, 0x26, 0xf7, 0x00, 0x0c, 0xd7, 0xb5, 0xcd, 0x2d,
+	0x1e, 0x4a, 0x81, 0x7c, 0x21, 0x0a, 0x79, 0x58, 0xd6, 0x15, 0xc9, 0x83, 0xf8, 0x03, 0x42, 0xb4,
+	0x2b, 0x70, 0x3c, 0x86, 0x58, 0x46, 0x41, 0x09, 0x45, 0xa1, 0x66, 0x94, 0xab, 0xd4, 0xef, 0x84,
+	0xbc, 0x85, 0xf6, 0x5f, 0x05, 0x66, 0x92, 0xdc, 0x80, 0xd1, 0x48, 0x43, 0x9f, 0x53, 0x2d, 0x14,
+	0xa8, 0xe3, 0x60, 0x3c, 0xe4, 0xb2, 0x11, 0xe0, 0xce, 0x40, 0x80, 0xc9, 0x1a, 0xf4, 0x52, 0x0f,
+	0x94, 0xc8, 0xdb, 0x81, 0xe8, 0xfe, 0x38, 0x8c, 0x1f, 0x6d, 0x44, 0x3e, 0xed, 0xaf, 0x0a, 0x4c,
+	0x34, 0x6e, 0xa6, 0xf5, 0xfa, 0x43, 0x2f, 0xfe, 0x86, 0xcb, 0xec, 0x0f, 0xfe, 0x00, 0xbc, 0x52,
+	0xe0, 0x64, 0x0c, 0x12, 0xf4, 0xce, 0x03, 0x18, 0xad, 0xc9, 0xcd, 0xbc, 0x77, 0xfd, 0x99, 0x81,
+	0xaa, 0x8b, 0x6a, 0x50, 0x24, 0xb1, 0x90, 0x28, 0x0c, 0x1f, 0xa9, 0x05, 0x77, 0xcd, 0xc3, 0xec,
+	0xc5, 0x36, 0x60, 0x2a, 0xd4, 0xe2, 0x8b, 0x01, 0xe4, 0x2a, 0x75, 0x0d, 0xb3, 0xf5, 0x71, 0xeb,
+	0x19, 0x9c, 0xda, 0x47, 0x08, 0x7a, 0x62, 0x13, 0x86, 0xc4, 0x5c, 0x94, 0x2f, 0xf2, 0x03, 0x7c,
+	0x3a, 0x66, 0x93, 0xc7, 0x23, 0x21, 0x48, 0x5e, 0x82, 0x4e, 0x60, 0x4f, 0x33, 0xf1, 0xb6, 0x10,
+	0x0c, 0xd4, 0x76, 0x6e, 0x59, 0x1b, 0x8c, 0x95, 0x8b, 0xec, 0xa9, 0x5f, 0xa7, 0xe1, 0x58, 0x2b,
+	0x07, 0x8e, 0xf5, 0xa7, 0x0a, 0x4c, 0xc5, 0xeb, 0x42, 0x23, 0x7f, 0x05, 0x83, 0x35, 0xea, 0xb2,
+	0xbc, 0x4d, 0x0b, 0xcc, 0x2e, 0xca, 0x48, 0xcf, 0xc4, 0xdb, 0x48, 0xed, 0x87, 0xd4, 0x65, 0x39,
+	0x4e, 0x2e, 0xbb, 0xbe, 0x9a, 0xbf, 0x73, 0x78, 0x81, 0x5e, 0x7a, 0x45, 0xa0, 0x87, 0xc3, 0x27,
+	0xff, 0x56, 0x20, 0x25, 0x1d, 0x4c, 0x22, 0xdd, 0x1f, 0x35, 0x74, 0xab, 0x73, 0x2d, 0x50, 0x0a,
+	0xbd, 0xda, 0xf2, 0x9f, 0xbf, 0xf8, 0xee, 0x79, 0xe7, 0x59, 0xb2, 0xa0, 0x47, 0xfc, 0x04, 0xe0,
+	0x8f, 0x80, 0xfa, 0x4e, 0x20, 0xab, 0x76, 0xc9, 0xdf, 0x15, 0xe8, 0x97, 0x92, 0x1c, 0x92, 0xac,
+	0x4d, 0x3e, 0x67, 0xea, 0x7c, 0x2b, 0xa4, 0x88, 0x6c, 0x9a, 0x23, 0x9b, 0x24, 0x27, 0xf7, 0x45,
+	0x46, 0xfe, 0xa3, 0x40, 0xb7, 0x37, 0x00, 0x91, 0x9f, 0xc6, 0xca, 0x0e, 0x8c, 0x9b, 0xea, 0x74,
+	0x02, 0x15, 0x2a, 0xbf, 0xc2, 0x95, 0x5f, 0x24, 0x17, 0xda, 0x70, 0x8b, 0xce, 0x67, 0x2f, 0x7d,
+	0xc7, 0xfb, 0x63, 0xef, 0x92, 0x7f, 0x29, 0xd0, 0xe3, 0xc9, 0x74, 0xc8, 0xfe, 0x3a, 0x7d, 0xe7,
+	0xcc, 0x24, 0x91, 0x21, 0xb6, 0x0b, 0x1c, 0xdb, 0x32, 0x59, 0x6c, 0x1b, 0x1b, 0xf9, 0x87, 0x02,
+	0xbd, 0x38, 0xa3, 0xc4, 0x6b, 0x0b, 0xcd, 0x7a, 0xea, 0x99, 0x44, 0x3a, 0x84, 0x75, 0x8e, 0xc3,
+	0x9a, 0x27, 0xb3, 0x91, 0xb0, 0x38, 0xad, 0xbe, 0x13, 0x18, 0x1b, 0x77, 0xc9, 0x47, 0x0a, 0xf4,
+	0x61, 0x5b, 0x4d, 0xe2, 0xd5, 0x84, 0xe7, 0x1c, 0x75, 0x36, 0x99, 0x10, 0x01, 0xdd, 0xe4, 0x80,
+	0xd6, 0xc9, 0x5a, 0x3b, 0x7e, 0x92, 0x7d, 0xbd, 0xbe, 0xe3, 0xcf, 0x46, 0xbb, 0xe4, 0x7f, 0x0a,
+	0xa4, 0x50, 0xba, 0x43, 0x12, 0x01, 0x38, 0xc9, 0x65, 0xd8, 0x3c, 0x84, 0x68, 0x97, 0x38, 0xd6,
+	0x9f, 0x93, 0x95, 0x83, 0x60, 0x25, 0x2f, 0x15, 0x18, 0x08, 0xb4, 0xf0, 0x64, 0x21, 0x56, 0xf1,
+	0xde, 0xe1, 0x42, 0xfd, 0x59, 0x6b, 0xc4, 0xef, 0x93, 0x7c, 0x7c, 0x96, 0xf0, 0x2a, 0x75, 0x30,
+	0xd4, 0x66, 0xc7, 0x6b, 0x8e, 0x18, 0x37, 0xd4, 0xb3, 0x2d, 0x52, 0x23, 0xd0, 0x39, 0x0e, 0xf4,
+	0x34, 0x39, 0x15, 0x05, 0x34, 0xd4, 0xdd, 0x93, 0x8f, 0x15, 0x20, 0x7b, 0x7b, 0x58, 0xb2, 0x14,
+	0x1f, 0xbe, 0xb8, 0x7e, 0x5d, 0x5d, 0x6e, 0x8b, 0x07, 0xa1, 0xae, 0x70, 0xa8, 0xd9, 0x55, 0x65,
+	0x5e, 0x9b, 0x8b, 0x2d, 0x1e, 0xfc, 0x2d, 0xc1, 0xd1, 0x8b, 0x76, 0x3d, 0x6f, 0x57, 0x2d, 0xf2,
+	0xb5, 0x02, 0x27, 0x62, 0x5b, 0x3e, 0x72, 0x21, 0x16, 0x48, 0x52, 0xb7, 0xac, 0xae, 0x1e, 0x84,
+	0x15, 0x4d, 0xb9, 0xce, 0x4d, 0x59, 0x23, 0x97, 0xdb, 0x49, 0x0f, 0x07, 0xc5, 0xe6, 0xa9, 0x0f,
+	0xff, 0x33, 0x05, 0x46, 0x9a, 0x1b, 0x35, 0x72, 0x6e, 0xff, 0x4c, 0xdd, 0xdb, 0x5d, 0xaa, 0x8b,
+	0x6d, 0x70, 0xa0, 0x05, 0x57, 0xb9, 0x05, 0x97, 0xc9, 0xa5, 0xb6, 0x13, 0x5c, 0xf7, 0x5b, 0x3f,
+	0x87, 0x7c, 0xae, 0xc0, 0x78, 0x54, 0x67, 0x44, 0x56, 0x12, 0x5f, 0xc0, 0x88, 0xb6, 0x4e, 0x3d,
+	0xdf, 0x26, 0xd7, 0xfb, 0xbc, 0x62, 0xa1, 0xce, 0xcf, 0xab, 0x8d, 0xb1, 0x88, 0x2e, 0x8a, 0x2c,
+	0x27, 0x20, 0x8a, 0xea, 0xef, 0xd4, 0x95, 0xf6, 0x98, 0xd0, 0x8a, 0x45, 0x6e, 0xc5, 0x02, 0x99,
+	0x8b, 0xb7, 0x82, 0xda, 0x4e, 0xde, 0xb4, 0xf2, 0x05, 0x64, 0x5d, 0xdf, 0x78, 0xfd, 0x36, 0xa3,
+	0xbc, 0x79, 0x9b, 0x51, 0xbe, 0x7d, 0x9b, 0x51, 0xfe, 0xf9, 0x2e, 0xd3, 0xf1, 0xe6, 0x5d, 0xa6,
+	0xe3, 0xcb, 0x77, 0x99, 0x8e, 0x5f, 0xcf, 0x95, 0x4c, 0xf7, 0x71, 0x75, 0x2b, 0x5b, 0x60, 0xdb,
+	0xba, 0xe1, 0xb2, 0x6d, 0x66, 0xd1, 0xb3, 0x8f, 0xab, 0x5b, 0x52, 0xf4, 0x33, 0x2e, 0x9c, 0x5f,
+	0x0b, 0x5b, 0xbd, 0xfc, 0x3f, 0x1b, 0xcb, 0x3f, 0x0e, 0x00, 0x72, 0x3d, 0xde, 0x6e, 0xb1, 0x19,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Proposal queries proposal details based on ProposalID.
+	Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error)
+	// Proposals queries all proposals based on given status.
+	Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error)
+	// Vote queries voted information based on proposalID, voterAddr.
+	Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error)
+	// Votes queries votes of a given proposal.
+	Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error)
+	// Params queries all parameters of the gov module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Deposit queries single deposit information based proposalID, depositAddr.
+	Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error)
+	// Deposits queries all deposits of a single proposal.
+	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
+	// TallyResult queries the tally of a proposal vote.
+	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// ContentTypes queries the proposal content types allowed by the params
+	// along with the content types registered in the application.
+	ContentTypes(ctx context.Context, in *QueryContentTypesRequest, opts ...grpc.CallOption) (*QueryContentTypesResponse, error)
+	// DryRunParamChanges checks parameter changes the same way they are checked
+	// when a parameter change proposal is submitted, without submitting it.
+	DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error)
+	// SimulateProposalExecution executes the content of a proposal in a
+	// discarded branch of the state at the current height, as it would be
+	// executed if the proposal passed.
+	SimulateProposalExecution(ctx context.Context, in *QuerySimulateProposalExecutionRequest, opts ...grpc.CallOption) (*QuerySimulateProposalExecutionResponse, error)
+	// TallyByValidator queries the per-validator breakdown of the tally of a
+	// proposal. For proposals in voting period, the breakdown is computed from
+	// the votes cast so far, otherwise the breakdown stored with the final tally
+	// is returned.
+	TallyByValidator(ctx context.Context, in *QueryTallyByValidatorRequest, opts ...grpc.CallOption) (*QueryTallyByValidatorResponse, error)
+	// ProposalStatusDetail queries the projected outcome of a proposal in voting
+	// period.
+	ProposalStatusDetail(ctx context.Context, in *QueryProposalStatusDetailRequest, opts ...grpc.CallOption) (*QueryProposalStatusDetailResponse, error)
+	// ProposersInCooldown queries the proposers which can't submit proposals
+	// because one of their proposals was vetoed.
+	ProposersInCooldown(ctx context.Context, in *QueryProposersInCooldownRequest, opts ...grpc.CallOption) (*QueryProposersInCooldownResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Proposal(ctx context.Context, in *QueryProposalRequest, opts ...grpc.CallOption) (*QueryProposalResponse, error) {
+	out := new(QueryProposalResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Proposal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Proposals(ctx context.Context, in *QueryProposalsRequest, opts ...grpc.CallOption) (*QueryProposalsResponse, error) {
+	out := new(QueryProposalsResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Proposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Vote(ctx context.Context, in *QueryVoteRequest, opts ...grpc.CallOption) (*QueryVoteResponse, error) {
+	out := new(QueryVoteResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Votes(ctx context.Context, in *QueryVotesRequest, opts ...grpc.CallOption) (*QueryVotesResponse, error) {
+	out := new(QueryVotesResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Votes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Deposit(ctx context.Context, in *QueryDepositRequest, opts ...grpc.CallOption) (*QueryDepositResponse, error) {
+	out := new(QueryDepositResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error) {
+	out := new(QueryDepositsResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/Deposits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+
+func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error) {
+	out := new(QueryTallyResultResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/TallyResult", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContentTypes(ctx context.Context, in *QueryContentTypesRequest, opts ...grpc.CallOption) (*QueryContentTypesResponse, error) {
+	out := new(QueryContentTypesResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/ContentTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Proposal.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryProposalsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DryRunParamChanges(ctx context.Context, in *QueryDryRunParamChangesRequest, opts ...grpc.CallOption) (*QueryDryRunParamChangesResponse, error) {
+	out := new(QueryDryRunParamChangesResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/DryRunParamChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalStatus != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	return out, nil
+}
+
+func (c *queryClient) SimulateProposalExecution(ctx context.Context, in *QuerySimulateProposalExecutionRequest, opts ...grpc.CallOption) (*QuerySimulateProposalExecutionResponse, error) {
+	out := new(QuerySimulateProposalExecutionResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/SimulateProposalExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) TallyByValidator(ctx context.Context, in *QueryTallyByValidatorRequest, opts ...grpc.CallOption) (*QueryTallyByValidatorResponse, error) {
+	out := new(QueryTallyByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/TallyByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProposalsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Proposals) > 0 {
-		for _, e := range m.Proposals {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ProposalStatusDetail(ctx context.Context, in *QueryProposalStatusDetailRequest, opts ...grpc.CallOption) (*QueryProposalStatusDetailResponse, error) {
+	out := new(QueryProposalStatusDetailResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/ProposalStatusDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ProposersInCooldown(ctx context.Context, in *QueryProposersInCooldownRequest, opts ...grpc.CallOption) (*QueryProposersInCooldownResponse, error) {
+	out := new(QueryProposersInCooldownResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Query/ProposersInCooldown", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Vote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Proposal queries proposal details based on ProposalID.
+	Proposal(context.Context, *QueryProposalRequest) (*QueryProposalResponse, error)
+	// Proposals queries all proposals based on given status.
+	Proposals(context.Context, *QueryProposalsRequest) (*QueryProposalsResponse, error)
+	// Vote queries voted information based on proposalID, voterAddr.
+	Vote(context.Context, *QueryVoteRequest) (*QueryVoteResponse, error)
+	// Votes queries votes of a given proposal.
+	Votes(context.Context, *QueryVotesRequest) (*QueryVotesResponse, error)
+	// Params queries all parameters of the gov module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Deposit queries single deposit information based proposalID, depositAddr.
+	Deposit(context.Context, *QueryDepositRequest) (*QueryDepositResponse, error)
+	// Deposits queries all deposits of a single proposal.
+	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
+	// TallyResult queries the tally of a proposal vote.
+	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// ContentTypes queries the proposal content types allowed by the params
+	// along with the content types registered in the application.
+	ContentTypes(context.Context, *QueryContentTypesRequest) (*QueryContentTypesResponse, error)
+	// DryRunParamChanges checks parameter changes the same way they are checked
+	// when a parameter change proposal is submitted, without submitting it.
+	DryRunParamChanges(context.Context, *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error)
+	// SimulateProposalExecution executes the content of a proposal in a
+	// discarded branch of the state at the current height, as it would be
+	// executed if the proposal passed.
+	SimulateProposalExecution(context.Context, *QuerySimulateProposalExecutionRequest) (*QuerySimulateProposalExecutionResponse, error)
+	// TallyByValidator queries the per-validator breakdown of the tally of a
+	// proposal. For proposals in voting period, the breakdown is computed from
+	// the votes cast so far, otherwise the breakdown stored with the final tally
+	// is returned.
+	TallyByValidator(context.Context, *QueryTallyByValidatorRequest) (*QueryTallyByValidatorResponse, error)
+	// ProposalStatusDetail queries the projected outcome of a proposal in voting
+	// period.
+	ProposalStatusDetail(context.Context, *QueryProposalStatusDetailRequest) (*QueryProposalStatusDetailResponse, error)
+	// ProposersInCooldown queries the proposers which can't submit proposals
+	// because one of their proposals was vetoed.
+	ProposersInCooldown(context.Context, *QueryProposersInCooldownRequest) (*QueryProposersInCooldownResponse, error)
 }
 
-func (m *QueryVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Proposal(ctx context.Context, req *QueryProposalRequest) (*QueryProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposal not implemented")
+}
+func (*UnimplementedQueryServer) Proposals(ctx context.Context, req *QueryProposalsRequest) (*QueryProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proposals not implemented")
+}
+func (*UnimplementedQueryServer) Vote(ctx context.Context, req *QueryVoteRequest) (*QueryVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) Votes(ctx context.Context, req *QueryVotesRequest) (*QueryVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Votes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Deposit(ctx context.Context, req *QueryDepositRequest) (*QueryDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedQueryServer) Deposits(ctx context.Context, req *QueryDepositsRequest) (*QueryDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposits not implemented")
+}
+func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
+}
+func (*UnimplementedQueryServer) ContentTypes(ctx context.Context, req *QueryContentTypesRequest) (*QueryContentTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentTypes not implemented")
+}
+func (*UnimplementedQueryServer) DryRunParamChanges(ctx context.Context, req *QueryDryRunParamChangesRequest) (*QueryDryRunParamChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunParamChanges not implemented")
+}
+func (*UnimplementedQueryServer) SimulateProposalExecution(ctx context.Context, req *QuerySimulateProposalExecutionRequest) (*QuerySimulateProposalExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateProposalExecution not implemented")
+}
+func (*UnimplementedQueryServer) TallyByValidator(ctx context.Context, req *QueryTallyByValidatorRequest) (*QueryTallyByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TallyByValidator not implemented")
+}
+func (*UnimplementedQueryServer) ProposalStatusDetail(ctx context.Context, req *QueryProposalStatusDetailRequest) (*QueryProposalStatusDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalStatusDetail not implemented")
+}
+func (*UnimplementedQueryServer) ProposersInCooldown(ctx context.Context, req *QueryProposersInCooldownRequest) (*QueryProposersInCooldownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposersInCooldown not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Proposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).Proposal(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Proposal",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposal(ctx, req.(*QueryProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Proposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Votes) > 0 {
-		for _, e := range m.Votes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Proposals(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Proposals",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proposals(ctx, req.(*QueryProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ParamsType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Vote",
 	}
-	var l int
-	_ = l
-	l = m.VotingParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.DepositParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TallyParams.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*QueryVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Votes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).Votes(ctx, in)
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Votes",
 	}
-	return n
-}
-
-func (m *QueryDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Votes(ctx, req.(*QueryVotesRequest))
 	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposit(ctx, req.(*QueryDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Deposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Deposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/Deposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Deposits(ctx, req.(*QueryDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/TallyResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyResult(ctx, req.(*QueryTallyResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContentTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContentTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContentTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/ContentTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContentTypes(ctx, req.(*QueryContentTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DryRunParamChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDryRunParamChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DryRunParamChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/DryRunParamChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DryRunParamChanges(ctx, req.(*QueryDryRunParamChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateProposalExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateProposalExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateProposalExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/SimulateProposalExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateProposalExecution(ctx, req.(*QuerySimulateProposalExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TallyByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTallyByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TallyByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/TallyByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TallyByValidator(ctx, req.(*QueryTallyByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalStatusDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalStatusDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalStatusDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/ProposalStatusDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalStatusDetail(ctx, req.(*QueryProposalStatusDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposersInCooldown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposersInCooldownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposersInCooldown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Query/ProposersInCooldown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposersInCooldown(ctx, req.(*QueryProposersInCooldownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "govgen.gov.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Proposal",
+			Handler:    _Query_Proposal_Handler,
+		},
+		{
+			MethodName: "Proposals",
+			Handler:    _Query_Proposals_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "Votes",
+			Handler:    _Query_Votes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Deposit",
+			Handler:    _Query_Deposit_Handler,
+		},
+		{
+			MethodName: "Deposits",
+			Handler:    _Query_Deposits_Handler,
+		},
+		{
+			MethodName: "TallyResult",
+			Handler:    _Query_TallyResult_Handler,
+		},
+		{
+			MethodName: "ContentTypes",
+			Handler:    _Query_ContentTypes_Handler,
+		},
+		{
+			MethodName: "DryRunParamChanges",
+			Handler:    _Query_DryRunParamChanges_Handler,
+		},
+		{
+			MethodName: "SimulateProposalExecution",
+			Handler:    _Query_SimulateProposalExecution_Handler,
+		},
+		{
+			MethodName: "TallyByValidator",
+			Handler:    _Query_TallyByValidator_Handler,
+		},
+		{
+			MethodName: "ProposalStatusDetail",
+			Handler:    _Query_ProposalStatusDetail_Handler,
+		},
+		{
+			MethodName: "ProposersInCooldown",
+			Handler:    _Query_ProposersInCooldown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "govgen/gov/v1beta1/query.proto",
+}
+
+func (m *QueryProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ParamsType) > 0 {
+		i -= len(m.ParamsType)
+		copy(dAtA[i:], m.ParamsType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ParamsType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ParamChangePolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tally.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContentTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ContentTypeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentTypeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentTypeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Routed {
+		i--
+		if m.Routed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProposalRoute) > 0 {
+		i -= len(m.ProposalRoute)
+		copy(dAtA[i:], m.ProposalRoute)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProposalRoute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContentTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Routes[iNdEx])
+			copy(dAtA[i:], m.Routes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Routes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContentTypes) > 0 {
+		for iNdEx := len(m.ContentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContentTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedContentTypes) > 0 {
+		for iNdEx := len(m.AllowedContentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContentTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedContentTypes[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedContentTypes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDryRunParamChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDryRunParamChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDryRunParamChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamChangeResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamChangeResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamChangeResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDryRunParamChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDryRunParamChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDryRunParamChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProposalExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionEventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionEventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionEventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateProposalExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateProposalExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateProposalExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTallyByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTallyByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTallyByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorTallies) > 0 {
+		for iNdEx := len(m.ValidatorTallies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorTallies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalStatusDetailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalStatusDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalStatusDetailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalStatusDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalStatusDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalStatusDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StatusDetail.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposersInCooldownRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposersInCooldownRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposersInCooldownRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposersInCooldownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposersInCooldownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposersInCooldownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VetoRecords) > 0 {
+		for iNdEx := len(m.VetoRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VetoRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalStatus != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalStatus))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParamsType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DepositParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ParamChangePolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDepositsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTallyResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tally.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContentTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ContentTypeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProposalRoute)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Routed {
+		n += 2
+	}
+	if m.Allowed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContentTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedContentTypes) > 0 {
+		for _, s := range m.AllowedContentTypes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ContentTypes) > 0 {
+		for _, e := range m.ContentTypes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Routes) > 0 {
+		for _, s := range m.Routes {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDryRunParamChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParamChangeResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDryRunParamChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *ExecutionEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionEventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateProposalExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTallyByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTallyByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorTallies) > 0 {
+		for _, e := range m.ValidatorTallies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposalStatusDetailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryProposalStatusDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StatusDetail.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProposersInCooldownRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProposersInCooldownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VetoRecords) > 0 {
+		for _, e := range m.VetoRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+			}
+			m.ProposalStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamChangePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParamChangePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, Deposit{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryTallyResultRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ProposalId != 0 {
-		n += 1 + sovQuery(uint64(m.ProposalId))
-	}
-	return n
-}
 
-func (m *QueryTallyResultResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Tally.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2154,10 +5817,10 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2200,7 +5863,7 @@ func (m *QueryProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,15 +5886,15 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2258,7 +5921,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2283,7 +5946,7 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryContentTypesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2306,17 +5969,131 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryContentTypesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryContentTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentTypeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentTypeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentTypeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routed", wireType)
 			}
-			m.ProposalStatus = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2326,14 +6103,85 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalStatus |= ProposalStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Routed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Allowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContentTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContentTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2361,13 +6209,13 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.AllowedContentTypes = append(m.AllowedContentTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentTypes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2377,29 +6225,31 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.ContentTypes = append(m.ContentTypes, ContentTypeInfo{})
+			if err := m.ContentTypes[len(m.ContentTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2409,27 +6259,23 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2452,7 +6298,7 @@ func (m *QueryProposalsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDryRunParamChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2475,49 +6321,15 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDryRunParamChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDryRunParamChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposals = append(m.Proposals, Proposal{})
-			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2544,10 +6356,8 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Changes = append(m.Changes, proposal.ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2572,7 +6382,7 @@ func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
+func (m *ParamChangeResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2595,17 +6405,17 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParamChangeResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParamChangeResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2615,14 +6425,27 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2650,7 +6473,39 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2673,7 +6528,7 @@ func (m *QueryVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDryRunParamChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2696,15 +6551,35 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDryRunParamChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDryRunParamChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,7 +6606,8 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, ParamChangeResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2756,7 +6632,7 @@ func (m *QueryVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2779,10 +6655,10 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2804,42 +6680,6 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2861,7 +6701,7 @@ func (m *QueryVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *ExecutionEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2884,17 +6724,17 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2904,29 +6744,27 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2953,10 +6791,8 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, ExecutionEventAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2981,7 +6817,7 @@ func (m *QueryVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ExecutionEventAttribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3004,15 +6840,47 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionEventAttribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionEventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3040,7 +6908,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamsType = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3063,7 +6931,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateProposalExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3086,17 +6954,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateProposalExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateProposalExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3106,30 +6974,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3139,28 +6994,27 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3187,7 +7041,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Events = append(m.Events, ExecutionEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3212,7 +7067,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3235,10 +7090,10 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3262,9 +7117,9 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3274,23 +7129,27 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3313,7 +7172,7 @@ func (m *QueryDepositRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTallyByValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3336,15 +7195,15 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTallyByValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTallyByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorTallies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3371,7 +7230,44 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorTallies = append(m.ValidatorTallies, ValidatorTally{})
+			if err := m.ValidatorTallies[len(m.ValidatorTallies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3396,7 +7292,7 @@ func (m *QueryDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalStatusDetailRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3419,10 +7315,10 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalStatusDetailRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalStatusDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3444,42 +7340,6 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3501,7 +7361,7 @@ func (m *QueryDepositsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposalStatusDetailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3524,49 +7384,15 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDepositsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposalStatusDetailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposalStatusDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Deposits = append(m.Deposits, Deposit{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDetail", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3593,10 +7419,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatusDetail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3621,7 +7444,7 @@ func (m *QueryDepositsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProposersInCooldownRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,17 +7467,17 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposersInCooldownRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposersInCooldownRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.ProposalId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3664,11 +7487,28 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3690,7 +7530,7 @@ func (m *QueryTallyResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProposersInCooldownResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3713,15 +7553,15 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProposersInCooldownResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTallyResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProposersInCooldownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3748,7 +7588,44 @@ func (m *QueryTallyResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tally.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VetoRecords = append(m.VetoRecords, ProposerVetoRecord{})
+			if err := m.VetoRecords[len(m.VetoRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
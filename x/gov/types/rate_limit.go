@@ -0,0 +1,51 @@
+package types
+
+import (
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGovMsgCount creates a new GovMsgCount object
+func NewGovMsgCount(proposals, votes uint64) GovMsgCount {
+	return GovMsgCount{
+		Proposals: proposals,
+		Votes:     votes,
+	}
+}
+
+// Add returns the sum of two GovMsgCount
+func (c GovMsgCount) Add(other GovMsgCount) GovMsgCount {
+	return NewGovMsgCount(c.Proposals+other.Proposals, c.Votes+other.Votes)
+}
+
+// IsZero returns true if no proposals and no votes are counted
+func (c GovMsgCount) IsZero() bool {
+	return c.Proposals == 0 && c.Votes == 0
+}
+
+// String implements stringer interface
+func (c GovMsgCount) String() string {
+	out, _ := yaml.Marshal(c)
+	return string(out)
+}
+
+// CheckCount returns an error if the count of the governance messages sent by
+// addr in the window exceeds the rate limit. A rate limit which is not enabled
+// is never exceeded.
+func (rl GovMsgRateLimit) CheckCount(count GovMsgCount, addr sdk.AccAddress) error {
+	if !rl.IsEnabled() {
+		return nil
+	}
+	if rl.MaxProposals > 0 && count.Proposals > rl.MaxProposals {
+		return ErrGovMsgRateLimitExceeded.Wrapf(
+			"%s submitted %d proposals in the last %d blocks, max %d", addr, count.Proposals, rl.Window, rl.MaxProposals,
+		)
+	}
+	if rl.MaxVotes > 0 && count.Votes > rl.MaxVotes {
+		return ErrGovMsgRateLimitExceeded.Wrapf(
+			"%s cast %d votes in the last %d blocks, max %d", addr, count.Votes, rl.Window, rl.MaxVotes,
+		)
+	}
+	return nil
+}
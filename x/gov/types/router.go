@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,6 +16,7 @@ type Router interface {
 	AddRoute(r string, h Handler) (rtr Router)
 	HasRoute(r string) bool
 	GetRoute(path string) (h Handler)
+	Routes() []string
 	Seal()
 }
 
@@ -70,3 +72,13 @@ func (rtr *router) GetRoute(path string) Handler {
 
 	return rtr.routes[path]
 }
+
+// Routes returns the registered paths in lexicographic order.
+func (rtr *router) Routes() []string {
+	paths := make([]string, 0, len(rtr.routes))
+	for path := range rtr.routes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
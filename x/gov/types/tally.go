@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	yaml "gopkg.in/yaml.v2"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -66,3 +68,104 @@ func (tr TallyResult) String() string {
 	out, _ := yaml.Marshal(tr)
 	return string(out)
 }
+
+// String implements stringer interface
+func (d ProposalStatusDetail) String() string {
+	out, _ := yaml.Marshal(d)
+	return string(out)
+}
+
+// NewValidatorTally creates a new ValidatorTally instance
+func NewValidatorTally(proposalID uint64, valAddr sdk.ValAddress, bondedTokens sdk.Int,
+	results map[VoteOption]sdk.Dec,
+) ValidatorTally {
+	votedPower := sdk.ZeroDec()
+	for _, power := range results {
+		votedPower = votedPower.Add(power)
+	}
+
+	return ValidatorTally{
+		ProposalId:       proposalID,
+		ValidatorAddress: valAddr.String(),
+		BondedTokens:     bondedTokens,
+		VotedPower:       votedPower.TruncateInt(),
+		Tally:            NewTallyResultFromMap(results),
+	}
+}
+
+// String implements stringer interface
+func (vt ValidatorTally) String() string {
+	out, _ := yaml.Marshal(vt)
+	return string(out)
+}
+
+// ValidatorTallies is a collection of ValidatorTally objects
+type ValidatorTallies []ValidatorTally
+
+// Equal returns true if two slices (order-dependant) of validator tallies are
+// equal.
+func (v ValidatorTallies) Equal(other ValidatorTallies) bool {
+	if len(v) != len(other) {
+		return false
+	}
+
+	for i, vt := range v {
+		if !vt.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v ValidatorTallies) String() string {
+	if len(v) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Validator tallies for Proposal %d:", v[0].ProposalId)
+	for _, vt := range v {
+		out += fmt.Sprintf("\n  %s: voted %s of %s", vt.ValidatorAddress, vt.VotedPower, vt.BondedTokens)
+	}
+	return out
+}
+
+// NewVoteFeeSponsorship creates a new VoteFeeSponsorship instance
+func NewVoteFeeSponsorship(proposalID uint64, voter sdk.AccAddress, spent sdk.Coins) VoteFeeSponsorship {
+	return VoteFeeSponsorship{ProposalId: proposalID, Voter: voter.String(), Spent: spent}
+}
+
+// String implements stringer interface
+func (s VoteFeeSponsorship) String() string {
+	out, _ := yaml.Marshal(s)
+	return string(out)
+}
+
+// VoteFeeSponsorships is a collection of VoteFeeSponsorship objects
+type VoteFeeSponsorships []VoteFeeSponsorship
+
+// Equal returns true if two slices (order-dependant) of vote fee sponsorships
+// are equal.
+func (v VoteFeeSponsorships) Equal(other VoteFeeSponsorships) bool {
+	if len(v) != len(other) {
+		return false
+	}
+
+	for i, s := range v {
+		if !s.Equal(other[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v VoteFeeSponsorships) String() string {
+	if len(v) == 0 {
+		return "[]"
+	}
+	out := "Vote fee sponsorships:"
+	for _, s := range v {
+		out += fmt.Sprintf("\n  %d %s: %s", s.ProposalId, s.Voter, s.Spent)
+	}
+	return out
+}
@@ -122,6 +122,8 @@ type MsgVote struct {
 	ProposalId uint64     `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
 	Voter      string     `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Option     VoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=govgen.gov.v1beta1.VoteOption" json:"option,omitempty"`
+	// rationale is an optional free text explaining the vote.
+	Rationale string `protobuf:"bytes,4,opt,name=rationale,proto3" json:"rationale,omitempty"`
 }
 
 func (m *MsgVote) Reset()      { *m = MsgVote{} }
@@ -200,6 +202,8 @@ type MsgVoteWeighted struct {
 	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" yaml:"proposal_id"`
 	Voter      string               `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
 	Options    []WeightedVoteOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options"`
+	// rationale is an optional free text explaining the vote.
+	Rationale string `protobuf:"bytes,4,opt,name=rationale,proto3" json:"rationale,omitempty"`
 }
 
 func (m *MsgVoteWeighted) Reset()      { *m = MsgVoteWeighted{} }
@@ -273,6 +277,122 @@ func (m *MsgVoteWeightedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVoteWeightedResponse proto.InternalMessageInfo
 
+// BatchVote defines a single weighted vote of a MsgVoteBatch.
+type BatchVote struct {
+	ProposalId uint64               `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Options    []WeightedVoteOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options"`
+	// rationale is an optional free text explaining the vote.
+	Rationale string `protobuf:"bytes,3,opt,name=rationale,proto3" json:"rationale,omitempty"`
+}
+
+func (m *BatchVote) Reset()         { *m = BatchVote{} }
+func (m *BatchVote) String() string { return proto.CompactTextString(m) }
+func (*BatchVote) ProtoMessage()    {}
+func (*BatchVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{6}
+}
+func (m *BatchVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchVote.Merge(m, src)
+}
+func (m *BatchVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchVote proto.InternalMessageInfo
+
+// MsgVoteBatch defines a message to cast weighted votes on several proposals.
+type MsgVoteBatch struct {
+	Voter string      `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Votes []BatchVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
+}
+
+func (m *MsgVoteBatch) Reset()      { *m = MsgVoteBatch{} }
+func (*MsgVoteBatch) ProtoMessage() {}
+func (*MsgVoteBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{7}
+}
+func (m *MsgVoteBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteBatch.Merge(m, src)
+}
+func (m *MsgVoteBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteBatch proto.InternalMessageInfo
+
+// MsgVoteBatchResponse defines the Msg/VoteBatch response type.
+type MsgVoteBatchResponse struct {
+}
+
+func (m *MsgVoteBatchResponse) Reset()         { *m = MsgVoteBatchResponse{} }
+func (m *MsgVoteBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteBatchResponse) ProtoMessage()    {}
+func (*MsgVoteBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{8}
+}
+func (m *MsgVoteBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVoteBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVoteBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVoteBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteBatchResponse.Merge(m, src)
+}
+func (m *MsgVoteBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVoteBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVoteBatchResponse proto.InternalMessageInfo
+
 // MsgDeposit defines a message to submit a deposit to an existing proposal.
 type MsgDeposit struct {
 	ProposalId uint64                                   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
@@ -283,7 +403,7 @@ type MsgDeposit struct {
 func (m *MsgDeposit) Reset()      { *m = MsgDeposit{} }
 func (*MsgDeposit) ProtoMessage() {}
 func (*MsgDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d797604b29f060, []int{6}
+	return fileDescriptor_f1d797604b29f060, []int{9}
 }
 func (m *MsgDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +440,7 @@ func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
 func (m *MsgDepositResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositResponse) ProtoMessage()    {}
 func (*MsgDepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f1d797604b29f060, []int{7}
+	return fileDescriptor_f1d797604b29f060, []int{10}
 }
 func (m *MsgDepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,6 +469,170 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgWithdrawDeposit defines a message to withdraw the whole deposit of a
+// depositor on a proposal in its deposit period.
+type MsgWithdrawDeposit struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id" yaml:"proposal_id"`
+	Depositor  string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+}
+
+func (m *MsgWithdrawDeposit) Reset()      { *m = MsgWithdrawDeposit{} }
+func (*MsgWithdrawDeposit) ProtoMessage() {}
+func (*MsgWithdrawDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{11}
+}
+func (m *MsgWithdrawDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDeposit.Merge(m, src)
+}
+func (m *MsgWithdrawDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDeposit proto.InternalMessageInfo
+
+// MsgWithdrawDepositResponse defines the Msg/WithdrawDeposit response type.
+type MsgWithdrawDepositResponse struct {
+	// amount is the deposit which was refunded to the depositor.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawDepositResponse) Reset()         { *m = MsgWithdrawDepositResponse{} }
+func (m *MsgWithdrawDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawDepositResponse) ProtoMessage()    {}
+func (*MsgWithdrawDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{12}
+}
+func (m *MsgWithdrawDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawDepositResponse.Merge(m, src)
+}
+func (m *MsgWithdrawDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawDepositResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawDepositResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgUpdateParams defines a message to update the gov params, which are
+// validated together.
+type MsgUpdateParams struct {
+	// authority is the address of the gov module account.
+	Authority     string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	DepositParams DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params" yaml:"deposit_params"`
+	VotingParams  VotingParams  `protobuf:"bytes,3,opt,name=voting_params,json=votingParams,proto3" json:"voting_params" yaml:"voting_params"`
+	TallyParams   TallyParams   `protobuf:"bytes,4,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params" yaml:"tally_params"`
+}
+
+func (m *MsgUpdateParams) Reset()      { *m = MsgUpdateParams{} }
+func (*MsgUpdateParams) ProtoMessage() {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{13}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1d797604b29f060, []int{14}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "govgen.gov.v1beta1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "govgen.gov.v1beta1.MsgSubmitProposalResponse")
@@ -356,56 +640,81 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "govgen.gov.v1beta1.MsgVoteResponse")
 	proto.RegisterType((*MsgVoteWeighted)(nil), "govgen.gov.v1beta1.MsgVoteWeighted")
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "govgen.gov.v1beta1.MsgVoteWeightedResponse")
+	proto.RegisterType((*BatchVote)(nil), "govgen.gov.v1beta1.BatchVote")
+	proto.RegisterType((*MsgVoteBatch)(nil), "govgen.gov.v1beta1.MsgVoteBatch")
+	proto.RegisterType((*MsgVoteBatchResponse)(nil), "govgen.gov.v1beta1.MsgVoteBatchResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "govgen.gov.v1beta1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "govgen.gov.v1beta1.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdrawDeposit)(nil), "govgen.gov.v1beta1.MsgWithdrawDeposit")
+	proto.RegisterType((*MsgWithdrawDepositResponse)(nil), "govgen.gov.v1beta1.MsgWithdrawDepositResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "govgen.gov.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "govgen.gov.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("govgen/gov/v1beta1/tx.proto", fileDescriptor_f1d797604b29f060) }
 
 var fileDescriptor_f1d797604b29f060 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x93, 0xd2, 0xd0, 0x0b, 0x6a, 0xa9, 0x15, 0x95, 0xc4, 0xad, 0xec, 0xc8, 0xa8, 0x55,
-	0x10, 0x8a, 0x4d, 0x83, 0x04, 0x52, 0x99, 0x48, 0x51, 0x05, 0x48, 0x11, 0x60, 0x24, 0x90, 0x58,
-	0x8a, 0x9d, 0x5c, 0xaf, 0x16, 0x89, 0x9f, 0x95, 0xbb, 0x44, 0xcd, 0xc6, 0xc8, 0x04, 0x8c, 0x8c,
-	0x9d, 0xd9, 0x90, 0x98, 0xf8, 0x05, 0x15, 0x53, 0x47, 0x06, 0x14, 0x50, 0xbb, 0x00, 0x62, 0xea,
-	0x2f, 0x40, 0xb6, 0xef, 0xdc, 0xd2, 0xb8, 0x51, 0x41, 0x9d, 0x92, 0xf7, 0xbe, 0xf7, 0x3d, 0xbf,
-	0xef, 0xdd, 0x7b, 0x77, 0x68, 0x9e, 0x40, 0x9f, 0x60, 0xdf, 0x22, 0xd0, 0xb7, 0xfa, 0xcb, 0x2e,
-	0x66, 0xce, 0xb2, 0xc5, 0xb6, 0xcc, 0xa0, 0x0b, 0x0c, 0x14, 0x25, 0x06, 0x4d, 0x02, 0x7d, 0x93,
-	0x83, 0xaa, 0xd6, 0x04, 0xda, 0x01, 0x6a, 0xb9, 0x0e, 0xc5, 0x09, 0xa3, 0x09, 0x9e, 0x1f, 0x73,
-	0xd4, 0x85, 0x94, 0x84, 0x21, 0x3f, 0x46, 0x4b, 0x31, 0x7b, 0x3d, 0xb2, 0xac, 0xd8, 0xe0, 0x50,
-	0x81, 0x00, 0x81, 0xd8, 0x1f, 0xfe, 0x13, 0x04, 0x02, 0x40, 0xda, 0xd8, 0x8a, 0x2c, 0xb7, 0xb7,
-	0x61, 0x39, 0xfe, 0x20, 0x86, 0x8c, 0x37, 0x19, 0x34, 0xdb, 0xa0, 0xe4, 0x71, 0xcf, 0xed, 0x78,
-	0xec, 0x61, 0x17, 0x02, 0xa0, 0x4e, 0x5b, 0xb9, 0x85, 0x72, 0x4d, 0xf0, 0x19, 0xf6, 0x59, 0x51,
-	0x2e, 0xcb, 0x95, 0x7c, 0xad, 0x60, 0xc6, 0x29, 0x4c, 0x91, 0xc2, 0xbc, 0xed, 0x0f, 0xea, 0xf9,
-	0xcf, 0x1f, 0xab, 0xb9, 0xd5, 0x38, 0xd0, 0x16, 0x0c, 0xe5, 0xb5, 0x8c, 0x66, 0x3c, 0xdf, 0x63,
-	0x9e, 0xd3, 0x5e, 0x6f, 0xe1, 0x00, 0xa8, 0xc7, 0x8a, 0x99, 0x72, 0xb6, 0x92, 0xaf, 0x95, 0x4c,
-	0x5e, 0x6c, 0xa8, 0x5b, 0x34, 0xc3, 0x5c, 0x05, 0xcf, 0xaf, 0xdf, 0xdf, 0x19, 0xea, 0xd2, 0xc1,
-	0x50, 0x9f, 0x1b, 0x38, 0x9d, 0xf6, 0x8a, 0x71, 0x8c, 0x6f, 0xbc, 0xff, 0xa6, 0x57, 0x88, 0xc7,
-	0x36, 0x7b, 0xae, 0xd9, 0x84, 0x0e, 0xd7, 0xcc, 0x7f, 0xaa, 0xb4, 0xf5, 0xc2, 0x62, 0x83, 0x00,
-	0xd3, 0x28, 0x15, 0xb5, 0xa7, 0x39, 0xfb, 0x4e, 0x4c, 0x56, 0x54, 0x74, 0x3e, 0x88, 0x94, 0xe1,
-	0x6e, 0x31, 0x5b, 0x96, 0x2b, 0x53, 0x76, 0x62, 0xaf, 0x5c, 0x7c, 0xb5, 0xad, 0x4b, 0xef, 0xb6,
-	0x75, 0xe9, 0xc7, 0xb6, 0x2e, 0xbd, 0xfc, 0x5a, 0x96, 0x8c, 0x26, 0x2a, 0x8d, 0x34, 0xc4, 0xc6,
-	0x34, 0x00, 0x9f, 0x62, 0x65, 0x0d, 0xe5, 0x03, 0xee, 0x5b, 0xf7, 0x5a, 0x51, 0x73, 0x26, 0xea,
-	0x8b, 0xbf, 0x86, 0xfa, 0x51, 0xf7, 0xc1, 0x50, 0x57, 0x62, 0x19, 0x47, 0x9c, 0x86, 0x8d, 0x84,
-	0x75, 0xaf, 0x65, 0x7c, 0x90, 0x51, 0xae, 0x41, 0xc9, 0x13, 0x60, 0x67, 0x96, 0x53, 0x29, 0xa0,
-	0x73, 0x7d, 0x60, 0xb8, 0x5b, 0xcc, 0x44, 0x1a, 0x63, 0x43, 0xb9, 0x81, 0x26, 0x21, 0x60, 0x1e,
-	0xf8, 0x91, 0xf4, 0xe9, 0x9a, 0x66, 0x8e, 0xce, 0xa3, 0x19, 0xd6, 0xf1, 0x20, 0x8a, 0xb2, 0x79,
-	0x74, 0x4a, 0x63, 0x66, 0xd1, 0x0c, 0x2f, 0x59, 0xb4, 0xc3, 0xf8, 0x24, 0x27, 0xbe, 0xa7, 0xd8,
-	0x23, 0x9b, 0x0c, 0xb7, 0x94, 0x9b, 0x69, 0x72, 0xe6, 0xfe, 0xbb, 0xfe, 0x35, 0x94, 0x8b, 0x2b,
-	0xa2, 0xc5, 0x6c, 0x34, 0x44, 0x4b, 0x69, 0x02, 0xc4, 0xd7, 0x0f, 0x85, 0xd4, 0x27, 0xc2, 0x89,
-	0xb2, 0x05, 0x39, 0x45, 0x4f, 0x09, 0x5d, 0x3a, 0x56, 0x7b, 0xa2, 0xeb, 0xa7, 0x8c, 0x50, 0x83,
-	0x12, 0x31, 0x40, 0x67, 0x75, 0x42, 0x0b, 0x68, 0x8a, 0x0f, 0x34, 0x08, 0x95, 0x87, 0x0e, 0xa5,
-	0x89, 0x26, 0x9d, 0x0e, 0xf4, 0x7c, 0xc6, 0x85, 0x8e, 0xd9, 0x96, 0x6b, 0xa1, 0xb6, 0x7f, 0xda,
-	0x09, 0x9e, 0x3a, 0xa5, 0x0d, 0x05, 0xa4, 0x1c, 0x4a, 0x15, 0x1d, 0xa8, 0xfd, 0xce, 0xa0, 0x6c,
-	0x83, 0x12, 0x65, 0x03, 0x4d, 0x1f, 0xbb, 0x1b, 0x16, 0xd3, 0xfa, 0x3f, 0xb2, 0x31, 0x6a, 0xf5,
-	0x54, 0x61, 0xc9, 0x62, 0xdd, 0x45, 0x13, 0xd1, 0x32, 0xcc, 0x9f, 0x40, 0x0b, 0x41, 0xf5, 0xf2,
-	0x18, 0x30, 0xc9, 0xf4, 0x1c, 0x5d, 0xf8, 0x6b, 0x1e, 0xc7, 0x91, 0x44, 0x90, 0x7a, 0xf5, 0x14,
-	0x41, 0xc9, 0x17, 0x1e, 0xa1, 0x9c, 0x98, 0x0c, 0xed, 0x04, 0x1e, 0xc7, 0xd5, 0xa5, 0xf1, 0xb8,
-	0x48, 0x59, 0x5f, 0xdd, 0xd9, 0xd3, 0xe4, 0xdd, 0x3d, 0x4d, 0xfe, 0xbe, 0xa7, 0xc9, 0x6f, 0xf7,
-	0x35, 0x69, 0x77, 0x5f, 0x93, 0xbe, 0xec, 0x6b, 0xd2, 0xb3, 0x2b, 0x47, 0x8e, 0xd8, 0x61, 0xd0,
-	0x01, 0x1f, 0x57, 0x37, 0x7b, 0xae, 0xc5, 0x5f, 0x88, 0xad, 0xe8, 0x8d, 0x88, 0x4e, 0xda, 0x9d,
-	0x8c, 0x2e, 0xe7, 0xeb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x64, 0x9a, 0x36, 0xec, 0x8f, 0x06,
+	// 962 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xc6, 0x6e, 0x5c, 0x3f, 0xa7, 0x09, 0x1d, 0x4c, 0x70, 0x36, 0xe9, 0xae, 0x59, 0xd4,
+	0xca, 0xa8, 0xca, 0x2e, 0x35, 0x12, 0x88, 0x72, 0xc2, 0x41, 0x15, 0x20, 0x45, 0x94, 0xe5, 0x47,
+	0x24, 0x2e, 0x66, 0x6c, 0x6f, 0xc7, 0x2b, 0xec, 0x9d, 0xc5, 0x3b, 0x36, 0xf5, 0x0d, 0x6e, 0x3d,
+	0x20, 0x40, 0x9c, 0x38, 0xe6, 0xcc, 0x99, 0xff, 0x80, 0x4b, 0xc5, 0x85, 0x1e, 0x7b, 0x40, 0x01,
+	0x25, 0x17, 0xe0, 0x84, 0xfa, 0x17, 0xa0, 0x9d, 0x1f, 0xbb, 0x1b, 0x67, 0x9d, 0xa6, 0x28, 0xf4,
+	0x94, 0x9d, 0xf7, 0xbe, 0xef, 0xbd, 0xf7, 0x7d, 0x19, 0xbf, 0x5d, 0xd8, 0x24, 0x74, 0x4a, 0xbc,
+	0xc0, 0x21, 0x74, 0xea, 0x4c, 0x6f, 0x74, 0x3d, 0x86, 0x6f, 0x38, 0xec, 0xae, 0x1d, 0x8e, 0x29,
+	0xa3, 0x08, 0x89, 0xa4, 0x4d, 0xe8, 0xd4, 0x96, 0x49, 0xdd, 0xe8, 0xd1, 0x68, 0x44, 0x23, 0xa7,
+	0x8b, 0x23, 0x2f, 0x61, 0xf4, 0xa8, 0x1f, 0x08, 0x8e, 0xbe, 0x95, 0x53, 0x30, 0xe6, 0x8b, 0xec,
+	0x86, 0x60, 0x77, 0xf8, 0xc9, 0x11, 0x07, 0x99, 0xaa, 0x11, 0x4a, 0xa8, 0x88, 0xc7, 0x4f, 0x8a,
+	0x40, 0x28, 0x25, 0x43, 0xcf, 0xe1, 0xa7, 0xee, 0xe4, 0x8e, 0x83, 0x83, 0x99, 0x48, 0x59, 0xdf,
+	0x2e, 0xc1, 0xe5, 0xdd, 0x88, 0x7c, 0x30, 0xe9, 0x8e, 0x7c, 0x76, 0x7b, 0x4c, 0x43, 0x1a, 0xe1,
+	0x21, 0x7a, 0x03, 0xca, 0x3d, 0x1a, 0x30, 0x2f, 0x60, 0x75, 0xad, 0xa1, 0x35, 0xab, 0xad, 0x9a,
+	0x2d, 0x4a, 0xd8, 0xaa, 0x84, 0xfd, 0x66, 0x30, 0x6b, 0x57, 0x7f, 0xf9, 0x69, 0xbb, 0xbc, 0x23,
+	0x80, 0xae, 0x62, 0xa0, 0x6f, 0x34, 0x58, 0xf3, 0x03, 0x9f, 0xf9, 0x78, 0xd8, 0xe9, 0x7b, 0x21,
+	0x8d, 0x7c, 0x56, 0x5f, 0x6a, 0x14, 0x9b, 0xd5, 0xd6, 0x86, 0x2d, 0x87, 0x8d, 0x75, 0x2b, 0x33,
+	0xec, 0x1d, 0xea, 0x07, 0xed, 0x77, 0xef, 0x1f, 0x98, 0x85, 0x47, 0x07, 0xe6, 0xfa, 0x0c, 0x8f,
+	0x86, 0x37, 0xad, 0x39, 0xbe, 0xf5, 0xe3, 0xef, 0x66, 0x93, 0xf8, 0x6c, 0x30, 0xe9, 0xda, 0x3d,
+	0x3a, 0x92, 0x9a, 0xe5, 0x9f, 0xed, 0xa8, 0xff, 0x99, 0xc3, 0x66, 0xa1, 0x17, 0xf1, 0x52, 0x91,
+	0xbb, 0x2a, 0xd9, 0x6f, 0x09, 0x32, 0xd2, 0xe1, 0x62, 0xc8, 0x95, 0x79, 0xe3, 0x7a, 0xb1, 0xa1,
+	0x35, 0x2b, 0x6e, 0x72, 0xbe, 0xf9, 0xcc, 0xbd, 0x7d, 0xb3, 0xf0, 0xc3, 0xbe, 0x59, 0xf8, 0x73,
+	0xdf, 0x2c, 0x7c, 0xf9, 0x5b, 0xa3, 0x60, 0xf5, 0x60, 0xe3, 0x84, 0x21, 0xae, 0x17, 0x85, 0x34,
+	0x88, 0x3c, 0x74, 0x0b, 0xaa, 0xa1, 0x8c, 0x75, 0xfc, 0x3e, 0x37, 0xa7, 0xd4, 0xbe, 0xfa, 0xf7,
+	0x81, 0x99, 0x0d, 0x3f, 0x3a, 0x30, 0x91, 0x90, 0x91, 0x09, 0x5a, 0x2e, 0xa8, 0xd3, 0x3b, 0x7d,
+	0xeb, 0x57, 0x0d, 0xca, 0xbb, 0x11, 0xf9, 0x98, 0xb2, 0x73, 0xab, 0x89, 0x6a, 0x70, 0x61, 0x4a,
+	0x99, 0x37, 0xae, 0x2f, 0x71, 0x8d, 0xe2, 0x80, 0x5e, 0x85, 0x65, 0x1a, 0x32, 0x9f, 0x06, 0x5c,
+	0xfa, 0x6a, 0xcb, 0xb0, 0x4f, 0xde, 0x47, 0x3b, 0x9e, 0xe3, 0x3d, 0x8e, 0x72, 0x25, 0x1a, 0x6d,
+	0x41, 0x65, 0x8c, 0xe3, 0x27, 0x3c, 0xf4, 0xea, 0x25, 0x5e, 0x31, 0x0d, 0xe4, 0xd8, 0x76, 0x19,
+	0xd6, 0xa4, 0x20, 0x65, 0x96, 0xf5, 0x50, 0x4b, 0x62, 0x7b, 0x9e, 0x4f, 0x06, 0xcc, 0xeb, 0xa3,
+	0xd7, 0xf2, 0xc4, 0xae, 0xff, 0x67, 0x75, 0xb7, 0xa0, 0x2c, 0xe6, 0x8d, 0xea, 0x45, 0x7e, 0xc5,
+	0xae, 0xe5, 0xc9, 0x53, 0xdd, 0x53, 0x99, 0xed, 0x52, 0x7c, 0xdf, 0x5c, 0x45, 0x7e, 0x62, 0xb5,
+	0x1b, 0xf0, 0xfc, 0x9c, 0xb2, 0x44, 0xf5, 0xcf, 0x1a, 0x54, 0xda, 0x98, 0xf5, 0x06, 0xe7, 0xfa,
+	0xcf, 0xcd, 0x08, 0x5d, 0x3a, 0x37, 0xa1, 0xc5, 0x79, 0xa1, 0x17, 0xef, 0x49, 0x91, 0xd6, 0xe7,
+	0xb0, 0x22, 0x05, 0x72, 0x2d, 0xa9, 0xfd, 0x5a, 0xd6, 0xfe, 0xd7, 0x45, 0x54, 0xcd, 0x74, 0x25,
+	0x6f, 0xa6, 0xc4, 0x0b, 0x39, 0x8a, 0x60, 0xe4, 0x78, 0xba, 0x0e, 0xb5, 0x6c, 0xcb, 0xc4, 0xd0,
+	0xbf, 0x34, 0x80, 0xdd, 0x88, 0xa8, 0x5f, 0xf3, 0x79, 0x39, 0xba, 0x05, 0x15, 0xb9, 0x5d, 0xa8,
+	0xba, 0x54, 0x69, 0x00, 0xf5, 0x60, 0x19, 0x8f, 0xe8, 0x24, 0x60, 0xf5, 0xe2, 0xe3, 0x56, 0xd7,
+	0xcb, 0xb1, 0xac, 0x27, 0x5a, 0x50, 0xb2, 0x74, 0x8e, 0x07, 0x35, 0x40, 0xa9, 0xd4, 0xc4, 0x81,
+	0xaf, 0x35, 0x1e, 0xde, 0xf3, 0xd9, 0xa0, 0x3f, 0xc6, 0x5f, 0x3c, 0x55, 0x27, 0x72, 0x86, 0xfc,
+	0x4a, 0x03, 0xfd, 0xe4, 0x38, 0xc9, 0x8e, 0x4c, 0xad, 0xd3, 0xfe, 0x37, 0xeb, 0xac, 0x7f, 0x96,
+	0xf8, 0x6e, 0xf9, 0x28, 0xec, 0x63, 0xe6, 0xdd, 0xc6, 0x63, 0x3c, 0xe2, 0x77, 0x1b, 0x4f, 0xd8,
+	0x80, 0x8e, 0x7d, 0x36, 0x93, 0xf7, 0x34, 0x0d, 0x20, 0x02, 0xab, 0x52, 0x54, 0x27, 0xe4, 0x78,
+	0x2e, 0xb5, 0xda, 0x7a, 0x21, 0xef, 0xd2, 0x4a, 0x4d, 0xa2, 0x70, 0xfb, 0x8a, 0x7c, 0x39, 0x3d,
+	0x27, 0x8c, 0x3c, 0x5e, 0xc6, 0x72, 0x2f, 0xf5, 0xb3, 0x68, 0xd4, 0x83, 0x4b, 0x53, 0xca, 0xfc,
+	0x80, 0xa8, 0x3e, 0x45, 0xde, 0xa7, 0xb1, 0x60, 0xf1, 0xfa, 0x01, 0x91, 0x6d, 0xb6, 0x64, 0x9b,
+	0x9a, 0x68, 0x73, 0xac, 0x88, 0xe5, 0xae, 0x4c, 0x33, 0x58, 0xd4, 0x81, 0x15, 0x86, 0x87, 0xc3,
+	0x99, 0xea, 0x51, 0xe2, 0x3d, 0xcc, 0xbc, 0x1e, 0x1f, 0xc6, 0x38, 0xd9, 0x62, 0x53, 0xb6, 0x78,
+	0x56, 0xb4, 0xc8, 0x96, 0xb0, 0xdc, 0x2a, 0x4b, 0x91, 0x0b, 0x77, 0x5e, 0xd6, 0x71, 0xf5, 0x2f,
+	0x6f, 0x7d, 0x7f, 0x01, 0x8a, 0xbb, 0x11, 0x41, 0x77, 0x60, 0x75, 0xee, 0x4b, 0xe2, 0x6a, 0xde,
+	0x44, 0x27, 0xde, 0xaf, 0xfa, 0xf6, 0x99, 0x60, 0xc9, 0x15, 0x7b, 0x1b, 0x4a, 0x7c, 0xbb, 0x6e,
+	0x2e, 0xa0, 0xc5, 0x49, 0xfd, 0xc5, 0x53, 0x92, 0x49, 0xa5, 0x4f, 0x61, 0xe5, 0xd8, 0xfb, 0xe9,
+	0x34, 0x92, 0x02, 0xe9, 0xd7, 0xcf, 0x00, 0x4a, 0x3a, 0xec, 0x41, 0x25, 0x5d, 0xa3, 0x8d, 0x53,
+	0x98, 0x1c, 0xa1, 0x37, 0x1f, 0x87, 0x48, 0x0a, 0xbf, 0x0f, 0x65, 0xb5, 0x09, 0x8c, 0x05, 0x24,
+	0x99, 0xd7, 0xaf, 0x9d, 0x9e, 0x4f, 0x4a, 0xfa, 0xb0, 0x36, 0xbf, 0x64, 0x16, 0x51, 0xe7, 0x70,
+	0xba, 0x7d, 0x36, 0x5c, 0xd6, 0xf8, 0x63, 0x3f, 0xde, 0x45, 0xc6, 0x67, 0x41, 0xfa, 0xf5, 0x33,
+	0x80, 0x54, 0x87, 0xf6, 0xce, 0xfd, 0x43, 0x43, 0x7b, 0x70, 0x68, 0x68, 0x7f, 0x1c, 0x1a, 0xda,
+	0x77, 0x47, 0x46, 0xe1, 0xc1, 0x91, 0x51, 0x78, 0x78, 0x64, 0x14, 0x3e, 0x79, 0x29, 0xb3, 0x6e,
+	0x30, 0xa3, 0x23, 0x1a, 0x78, 0xdb, 0x83, 0x49, 0xd7, 0x91, 0x5f, 0xdd, 0x77, 0xe3, 0x07, 0xb1,
+	0x75, 0xba, 0xcb, 0xfc, 0x83, 0xf7, 0x95, 0x7f, 0x07, 0x00, 0x2e, 0xc0, 0xa2, 0x4e, 0xe3, 0x0b,
 	0x00, 0x00,
 }
 
@@ -429,8 +738,18 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.43
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
+	// VoteBatch defines a method to add weighted votes on several proposals at
+	// once. Either all votes are cast or none is.
+	VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// WithdrawDeposit defines a method to withdraw the deposit made on a
+	// proposal which is still in its deposit period.
+	WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error)
+	// UpdateParams defines a method to update the deposit, voting and tally
+	// params at once. It can only be executed with the gov module account as
+	// authority.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -468,6 +787,15 @@ func (c *msgClient) VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts
 	return out, nil
 }
 
+func (c *msgClient) VoteBatch(ctx context.Context, in *MsgVoteBatch, opts ...grpc.CallOption) (*MsgVoteBatchResponse, error) {
+	out := new(MsgVoteBatchResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Msg/VoteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
 	out := new(MsgDepositResponse)
 	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Msg/Deposit", in, out, opts...)
@@ -477,6 +805,24 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) WithdrawDeposit(ctx context.Context, in *MsgWithdrawDeposit, opts ...grpc.CallOption) (*MsgWithdrawDepositResponse, error) {
+	out := new(MsgWithdrawDepositResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Msg/WithdrawDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/govgen.gov.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -487,8 +833,18 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.43
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
+	// VoteBatch defines a method to add weighted votes on several proposals at
+	// once. Either all votes are cast or none is.
+	VoteBatch(context.Context, *MsgVoteBatch) (*MsgVoteBatchResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// WithdrawDeposit defines a method to withdraw the deposit made on a
+	// proposal which is still in its deposit period.
+	WithdrawDeposit(context.Context, *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error)
+	// UpdateParams defines a method to update the deposit, voting and tally
+	// params at once. It can only be executed with the gov module account as
+	// authority.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -504,9 +860,18 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWeighted) (*MsgVoteWeightedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoteWeighted not implemented")
 }
+func (*UnimplementedMsgServer) VoteBatch(ctx context.Context, req *MsgVoteBatch) (*MsgVoteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteBatch not implemented")
+}
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) WithdrawDeposit(ctx context.Context, req *MsgWithdrawDeposit) (*MsgWithdrawDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDeposit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -566,6 +931,24 @@ func _Msg_VoteWeighted_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VoteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VoteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Msg/VoteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteBatch(ctx, req.(*MsgVoteBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgDeposit)
 	if err := dec(in); err != nil {
@@ -584,6 +967,42 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Msg/WithdrawDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawDeposit(ctx, req.(*MsgWithdrawDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/govgen.gov.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "govgen.gov.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -600,10 +1019,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoteWeighted",
 			Handler:    _Msg_VoteWeighted_Handler,
 		},
+		{
+			MethodName: "VoteBatch",
+			Handler:    _Msg_VoteBatch_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "WithdrawDeposit",
+			Handler:    _Msg_WithdrawDeposit_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "govgen/gov/v1beta1/tx.proto",
@@ -713,6 +1144,13 @@ func (m *MsgVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Option != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Option))
 		i--
@@ -776,6 +1214,13 @@ func (m *MsgVoteWeighted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Options) > 0 {
 		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -828,7 +1273,7 @@ func (m *MsgVoteWeightedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+func (m *BatchVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -838,20 +1283,27 @@ func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchVote) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Rationale) > 0 {
+		i -= len(m.Rationale)
+		copy(dAtA[i:], m.Rationale)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Rationale)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -859,16 +1311,9 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.ProposalId != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
 		i--
@@ -877,7 +1322,7 @@ func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgVoteBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -887,21 +1332,292 @@ func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgVoteBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgVoteBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.VotingParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -962,6 +1678,10 @@ func (m *MsgVote) Size() (n int) {
 	if m.Option != 0 {
 		n += 1 + sovTx(uint64(m.Option))
 	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -993,6 +1713,10 @@ func (m *MsgVoteWeighted) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1005,7 +1729,7 @@ func (m *MsgVoteWeightedResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDeposit) Size() (n int) {
+func (m *BatchVote) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1014,31 +1738,140 @@ func (m *MsgDeposit) Size() (n int) {
 	if m.ProposalId != 0 {
 		n += 1 + sovTx(uint64(m.ProposalId))
 	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
+	l = len(m.Rationale)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
+func (m *MsgVoteBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVoteBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.DepositParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.VotingParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TallyParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
@@ -1106,12 +1939,751 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			if err := m.Content.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
+			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rationale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rationale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rationale", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rationale = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1121,31 +2693,29 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDeposit = append(m.InitialDeposit, types1.Coin{})
-			if err := m.InitialDeposit[len(m.InitialDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1155,23 +2725,25 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			m.Votes = append(m.Votes, BatchVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1194,7 +2766,7 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1217,31 +2789,12 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-			}
-			m.ProposalId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1263,7 +2816,7 @@ func (m *MsgSubmitProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVote) Unmarshal(dAtA []byte) error {
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1286,10 +2839,10 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1313,7 +2866,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1341,13 +2894,13 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Option = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1357,11 +2910,26 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Option |= VoteOption(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1383,7 +2951,7 @@ func (m *MsgVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1406,10 +2974,10 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1433,7 +3001,7 @@ func (m *MsgVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,10 +3024,10 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteWeighted: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteWeighted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1483,7 +3051,7 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1511,41 +3079,7 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Options = append(m.Options, WeightedVoteOption{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1568,7 +3102,7 @@ func (m *MsgVoteWeighted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1591,12 +3125,46 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteWeightedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteWeightedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1618,7 +3186,7 @@ func (m *MsgVoteWeightedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,17 +3209,17 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.ProposalId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1661,16 +3229,29 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProposalId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1680,27 +3261,28 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			if err := m.DepositParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1727,8 +3309,40 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VotingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TallyParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1753,7 +3367,7 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1776,10 +3390,10 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DefaultBlockTimeEstimate is the block time assumed to estimate the height at
+// which the voting period of a software upgrade proposal ends when it cannot
+// be derived from the historical info.
+const DefaultBlockTimeEstimate = 5 * time.Second
+
+// platformRegex matches the keys of the binaries of an upgrade info, which
+// must be either "any" or "os/arch".
+var platformRegex = regexp.MustCompile(`^(any|[a-z0-9]+/[a-z0-9]+)$`)
+
+// checksumLengths maps the supported checksum types to their expected hex
+// encoded length.
+var checksumLengths = map[string]int{
+	"md5":    32,
+	"sha1":   40,
+	"sha256": 64,
+	"sha512": 128,
+}
+
+// UpgradeInfo defines the cosmovisor-style info expected in a software upgrade
+// plan, listing the binary download URLs by platform.
+type UpgradeInfo struct {
+	Binaries map[string]string `json:"binaries"`
+}
+
+// ValidatePlanInfo checks that the info of a software upgrade plan is a
+// cosmovisor-style binaries JSON, in which each binary URL references the
+// checksum of the file to download, e.g.:
+//
+//	{"binaries":{"linux/amd64":"https://example.com/govgend?checksum=sha256:<hex>"}}
+func ValidatePlanInfo(info string) error {
+	var upgradeInfo UpgradeInfo
+	if err := json.Unmarshal([]byte(info), &upgradeInfo); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidUpgradePlan, "info is not a valid binaries JSON: %s", err)
+	}
+	if len(upgradeInfo.Binaries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradePlan, "info has no binaries")
+	}
+
+	// check the platforms in order so the reported error is deterministic
+	platforms := make([]string, 0, len(upgradeInfo.Binaries))
+	for platform := range upgradeInfo.Binaries {
+		platforms = append(platforms, platform)
+	}
+	sort.Strings(platforms)
+
+	for _, platform := range platforms {
+		binary := upgradeInfo.Binaries[platform]
+		if !platformRegex.MatchString(platform) {
+			return sdkerrors.Wrapf(ErrInvalidUpgradePlan, "invalid binary platform %q, expected \"any\" or \"os/arch\"", platform)
+		}
+		if err := validateBinaryURL(binary); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidUpgradePlan, "invalid binary for platform %s: %s", platform, err)
+		}
+	}
+
+	return nil
+}
+
+func validateBinaryURL(binary string) error {
+	u, err := url.Parse(binary)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "" || (u.Host == "" && u.Path == "") {
+		return fmt.Errorf("%q is not an absolute URL", binary)
+	}
+
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return fmt.Errorf("%q has no checksum", binary)
+	}
+
+	checksumType, checksumHex, ok := strings.Cut(checksum, ":")
+	if !ok {
+		return fmt.Errorf("checksum %q must have the form type:value", checksum)
+	}
+	length, ok := checksumLengths[checksumType]
+	if !ok {
+		return fmt.Errorf("unsupported checksum type %q", checksumType)
+	}
+	if _, err := hex.DecodeString(checksumHex); err != nil || len(checksumHex) != length {
+		return fmt.Errorf("invalid %s checksum %q", checksumType, checksumHex)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+)
+
+func TestValidatePlanInfo(t *testing.T) {
+	sha256 := "sha256:" + strings.Repeat("a", 64)
+
+	tests := []struct {
+		name   string
+		info   string
+		expErr bool
+	}{
+		{"valid", `{"binaries":{"linux/amd64":"https://example.com/govgend?checksum=` + sha256 + `"}}`, false},
+		{"valid any platform", `{"binaries":{"any":"https://example.com/govgend.zip?checksum=` + sha256 + `"}}`, false},
+		{"valid md5", `{"binaries":{"any":"https://example.com/govgend?checksum=md5:` + strings.Repeat("0", 32) + `"}}`, false},
+		{"empty", "", true},
+		{"not json", "v2.0.0", true},
+		{"no binaries", `{"binaries":{}}`, true},
+		{"invalid platform", `{"binaries":{"linux":"https://example.com/govgend?checksum=` + sha256 + `"}}`, true},
+		{"relative url", `{"binaries":{"any":"govgend?checksum=` + sha256 + `"}}`, true},
+		{"missing checksum", `{"binaries":{"any":"https://example.com/govgend"}}`, true},
+		{"checksum without type", `{"binaries":{"any":"https://example.com/govgend?checksum=` + strings.Repeat("a", 64) + `"}}`, true},
+		{"unsupported checksum type", `{"binaries":{"any":"https://example.com/govgend?checksum=crc32:00000000"}}`, true},
+		{"invalid checksum length", `{"binaries":{"any":"https://example.com/govgend?checksum=sha256:aaaa"}}`, true},
+		{"invalid checksum hex", `{"binaries":{"any":"https://example.com/govgend?checksum=sha256:` + strings.Repeat("z", 64) + `"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidatePlanInfo(tt.info)
+			if tt.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidUpgradePlan)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePlanInfoDeterministicError(t *testing.T) {
+	info := `{"binaries":{
+		"linux/arm64":"https://example.com/govgend",
+		"darwin/amd64":"https://example.com/govgend",
+		"windows/amd64":"https://example.com/govgend"
+	}}`
+
+	// the platforms are checked in order, so the first one is always reported
+	for i := 0; i < 10; i++ {
+		err := types.ValidatePlanInfo(info)
+		require.ErrorIs(t, err, types.ErrInvalidUpgradePlan)
+		require.Contains(t, err.Error(), "platform darwin/amd64")
+	}
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	v1beta1 "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary types and interfaces
+// for the v1 messages of the governance module.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSubmitProposal{}, "govgen/v1/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(&MsgExecLegacyContent{}, "govgen/v1/MsgExecLegacyContent", nil)
+	cdc.RegisterConcrete(&MsgDeposit{}, "govgen/v1/MsgDeposit", nil)
+	cdc.RegisterConcrete(&MsgVote{}, "govgen/v1/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgVoteWeighted{}, "govgen/v1/MsgVoteWeighted", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "govgen/v1/MsgUpdateParams", nil)
+}
+
+// RegisterInterfaces registers the v1 messages of the governance module.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitProposal{},
+		&MsgExecLegacyContent{},
+		&MsgVote{},
+		&MsgVoteWeighted{},
+		&MsgDeposit{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the codec of the v1 messages of the governance
+	// module, used for their amino JSON sign bytes. It also knows the
+	// v1beta1 types, as the messages can embed a legacy content.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	v1beta1.RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+
+	amino.RegisterConcrete(&paramsproposal.ParameterChangeProposal{}, "cosmos-sdk/ParameterChangeProposal", nil)
+	amino.RegisterConcrete(&upgradetypes.SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal", nil)
+	amino.RegisterConcrete(&upgradetypes.CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal", nil)
+}
@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	v1beta1 "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// LegacyContentFromMessages returns the content of the messages of a v1
+// proposal, which must be a single MsgExecLegacyContent.
+func LegacyContentFromMessages(messages []*types.Any) (v1beta1.Content, error) {
+	if len(messages) != 1 {
+		return nil, sdkerrors.Wrapf(v1beta1.ErrInvalidProposalContent,
+			"a proposal must have a single %s message, got %d messages", sdk.MsgTypeURL(&MsgExecLegacyContent{}), len(messages))
+	}
+	msg, ok := messages[0].GetCachedValue().(*MsgExecLegacyContent)
+	if !ok {
+		return nil, sdkerrors.Wrapf(v1beta1.ErrInvalidProposalContent,
+			"unsupported proposal message %s, expected %s", messages[0].GetTypeUrl(), sdk.MsgTypeURL(&MsgExecLegacyContent{}))
+	}
+	content := msg.GetLegacyContent()
+	if content == nil {
+		return nil, sdkerrors.Wrap(v1beta1.ErrInvalidProposalContent, "missing content")
+	}
+	return content, nil
+}
+
+// ConvertToV1Proposal converts a v1beta1 proposal to a v1 proposal, whose
+// single message is a MsgExecLegacyContent wrapping the proposal content with
+// the gov module account as authority.
+func ConvertToV1Proposal(proposal v1beta1.Proposal) (Proposal, error) {
+	msg := &MsgExecLegacyContent{
+		Content:   proposal.Content,
+		Authority: authtypes.NewModuleAddress(v1beta1.ModuleName).String(),
+	}
+	msgAny, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return Proposal{}, err
+	}
+	tally := ConvertToV1TallyResult(proposal.FinalTallyResult)
+
+	var title, summary string
+	if content := proposal.GetContent(); content != nil {
+		title, summary = content.GetTitle(), content.GetDescription()
+	}
+
+	return Proposal{
+		Id:               proposal.ProposalId,
+		Messages:         []*types.Any{msgAny},
+		Status:           ProposalStatus(proposal.Status),
+		FinalTallyResult: &tally,
+		SubmitTime:       timePtr(proposal.SubmitTime),
+		DepositEndTime:   timePtr(proposal.DepositEndTime),
+		TotalDeposit:     proposal.TotalDeposit,
+		VotingStartTime:  timePtr(proposal.VotingStartTime),
+		VotingEndTime:    timePtr(proposal.VotingEndTime),
+		Metadata:         proposal.Metadata,
+		Title:            title,
+		Summary:          summary,
+		Proposer:         proposal.Proposer,
+	}, nil
+}
+
+// ConvertToV1beta1Proposal converts a v1 proposal to a v1beta1 proposal. The
+// v1 proposal must have a single MsgExecLegacyContent message.
+func ConvertToV1beta1Proposal(proposal Proposal) (v1beta1.Proposal, error) {
+	if _, err := LegacyContentFromMessages(proposal.Messages); err != nil {
+		return v1beta1.Proposal{}, err
+	}
+	msg := proposal.Messages[0].GetCachedValue().(*MsgExecLegacyContent)
+
+	tally := v1beta1.EmptyTallyResult()
+	if proposal.FinalTallyResult != nil {
+		var err error
+		if tally, err = ConvertToV1beta1TallyResult(*proposal.FinalTallyResult); err != nil {
+			return v1beta1.Proposal{}, err
+		}
+	}
+
+	return v1beta1.Proposal{
+		ProposalId:       proposal.Id,
+		Content:          msg.Content,
+		Status:           v1beta1.ProposalStatus(proposal.Status),
+		FinalTallyResult: tally,
+		SubmitTime:       timeValue(proposal.SubmitTime),
+		DepositEndTime:   timeValue(proposal.DepositEndTime),
+		TotalDeposit:     sdk.Coins(proposal.TotalDeposit),
+		VotingStartTime:  timeValue(proposal.VotingStartTime),
+		VotingEndTime:    timeValue(proposal.VotingEndTime),
+		Proposer:         proposal.Proposer,
+		Metadata:         proposal.Metadata,
+	}, nil
+}
+
+// ConvertToV1TallyResult converts a v1beta1 tally result to a v1 tally result.
+func ConvertToV1TallyResult(tally v1beta1.TallyResult) TallyResult {
+	return TallyResult{
+		YesCount:        tally.Yes.String(),
+		AbstainCount:    tally.Abstain.String(),
+		NoCount:         tally.No.String(),
+		NoWithVetoCount: tally.NoWithVeto.String(),
+	}
+}
+
+// ConvertToV1beta1TallyResult converts a v1 tally result to a v1beta1 tally
+// result.
+func ConvertToV1beta1TallyResult(tally TallyResult) (v1beta1.TallyResult, error) {
+	counts := make([]sdk.Int, 4)
+	for i, count := range []string{tally.YesCount, tally.AbstainCount, tally.NoCount, tally.NoWithVetoCount} {
+		c, ok := sdk.NewIntFromString(count)
+		if !ok {
+			return v1beta1.TallyResult{}, fmt.Errorf("invalid tally count %q", count)
+		}
+		counts[i] = c
+	}
+	return v1beta1.NewTallyResult(counts[0], counts[1], counts[2], counts[3]), nil
+}
+
+// ConvertToV1WeightedVoteOptions converts v1beta1 weighted vote options to v1
+// weighted vote options.
+func ConvertToV1WeightedVoteOptions(options v1beta1.WeightedVoteOptions) []*WeightedVoteOption {
+	v1Options := make([]*WeightedVoteOption, len(options))
+	for i, option := range options {
+		v1Options[i] = &WeightedVoteOption{
+			Option: VoteOption(option.Option),
+			Weight: option.Weight.String(),
+		}
+	}
+	return v1Options
+}
+
+// ConvertToV1beta1WeightedVoteOptions converts v1 weighted vote options to
+// v1beta1 weighted vote options.
+func ConvertToV1beta1WeightedVoteOptions(options []*WeightedVoteOption) (v1beta1.WeightedVoteOptions, error) {
+	legacyOptions := make(v1beta1.WeightedVoteOptions, len(options))
+	for i, option := range options {
+		if option == nil {
+			return nil, fmt.Errorf("empty vote option")
+		}
+		weight, err := sdk.NewDecFromStr(option.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of vote option %s: %w", option.Option, err)
+		}
+		legacyOptions[i] = v1beta1.WeightedVoteOption{
+			Option: v1beta1.VoteOption(option.Option),
+			Weight: weight,
+		}
+	}
+	return legacyOptions, nil
+}
+
+// ConvertToV1Vote converts a v1beta1 vote to a v1 vote, whose metadata is the
+// rationale of the vote.
+func ConvertToV1Vote(vote v1beta1.Vote) Vote {
+	return Vote{
+		ProposalId: vote.ProposalId,
+		Voter:      vote.Voter,
+		Options:    ConvertToV1WeightedVoteOptions(vote.Options),
+		Metadata:   vote.Rationale,
+	}
+}
+
+// ConvertToV1beta1Vote converts a v1 vote to a v1beta1 vote, whose rationale is
+// the metadata of the vote.
+func ConvertToV1beta1Vote(vote Vote) (v1beta1.Vote, error) {
+	options, err := ConvertToV1beta1WeightedVoteOptions(vote.Options)
+	if err != nil {
+		return v1beta1.Vote{}, err
+	}
+	return v1beta1.Vote{
+		ProposalId: vote.ProposalId,
+		Voter:      vote.Voter,
+		Options:    options,
+		Rationale:  vote.Metadata,
+	}, nil
+}
+
+// ConvertToV1Deposit converts a v1beta1 deposit to a v1 deposit.
+func ConvertToV1Deposit(deposit v1beta1.Deposit) Deposit {
+	return Deposit{
+		ProposalId: deposit.ProposalId,
+		Depositor:  deposit.Depositor,
+		Amount:     deposit.Amount,
+	}
+}
+
+// ConvertToV1beta1Deposit converts a v1 deposit to a v1beta1 deposit.
+func ConvertToV1beta1Deposit(deposit Deposit) v1beta1.Deposit {
+	return v1beta1.Deposit{
+		ProposalId: deposit.ProposalId,
+		Depositor:  deposit.Depositor,
+		Amount:     sdk.Coins(deposit.Amount),
+	}
+}
+
+// ConvertToV1Params converts the v1beta1 deposit, voting and tally params to
+// v1 params.
+func ConvertToV1Params(depositParams v1beta1.DepositParams, votingParams v1beta1.VotingParams, tallyParams v1beta1.TallyParams) Params {
+	return Params{
+		MinDeposit:                    depositParams.MinDeposit,
+		MaxDepositPeriod:              durationPtr(depositParams.MaxDepositPeriod),
+		AllowedContentTypes:           depositParams.AllowedContentTypes,
+		MaxProposalsPerProposer:       depositParams.MaxProposalsPerProposer,
+		VetoCooldownPeriod:            durationPtr(depositParams.VetoCooldownPeriod),
+		VetoMinDepositMultiplier:      decString(depositParams.VetoMinDepositMultiplier),
+		InactiveProposalRefundRatio:   decString(depositParams.InactiveProposalRefundRatio),
+		VotingPeriodDefault:           durationPtr(votingParams.VotingPeriodDefault),
+		VotingPeriodParameterChange:   durationPtr(votingParams.VotingPeriodParameterChange),
+		VotingPeriodSoftwareUpgrade:   durationPtr(votingParams.VotingPeriodSoftwareUpgrade),
+		VotingPeriodText:              durationPtr(votingParams.VotingPeriodText),
+		MaxVoteRationaleLength:        votingParams.MaxVoteRationaleLength,
+		EarlyTerminationCheckInterval: votingParams.EarlyTerminationCheckInterval,
+		VoteFeeSponsorshipBudget:      votingParams.VoteFeeSponsorshipBudget,
+		VoteFeeSponsorshipMinBonded:   intString(votingParams.VoteFeeSponsorshipMinBonded),
+		GovMsgRateLimitWindow:         votingParams.GovMsgRateLimit.Window,
+		GovMsgRateLimitMaxProposals:   votingParams.GovMsgRateLimit.MaxProposals,
+		GovMsgRateLimitMaxVotes:       votingParams.GovMsgRateLimit.MaxVotes,
+		Quorum:                        tallyParams.Quorum.String(),
+		Threshold:                     tallyParams.Threshold.String(),
+		VetoThreshold:                 tallyParams.VetoThreshold.String(),
+	}
+}
+
+// ConvertToV1beta1Params converts v1 params to the v1beta1 deposit, voting and
+// tally params. All the durations and decimals must be set.
+func ConvertToV1beta1Params(params Params) (v1beta1.DepositParams, v1beta1.VotingParams, v1beta1.TallyParams, error) {
+	var (
+		depositParams v1beta1.DepositParams
+		votingParams  v1beta1.VotingParams
+		tallyParams   v1beta1.TallyParams
+	)
+
+	durations := []struct {
+		name  string
+		value *time.Duration
+		dest  *time.Duration
+	}{
+		{"max_deposit_period", params.MaxDepositPeriod, &depositParams.MaxDepositPeriod},
+		{"veto_cooldown_period", params.VetoCooldownPeriod, &depositParams.VetoCooldownPeriod},
+		{"voting_period_default", params.VotingPeriodDefault, &votingParams.VotingPeriodDefault},
+		{"voting_period_parameter_change", params.VotingPeriodParameterChange, &votingParams.VotingPeriodParameterChange},
+		{"voting_period_software_upgrade", params.VotingPeriodSoftwareUpgrade, &votingParams.VotingPeriodSoftwareUpgrade},
+		{"voting_period_text", params.VotingPeriodText, &votingParams.VotingPeriodText},
+	}
+	for _, d := range durations {
+		if d.value == nil {
+			return depositParams, votingParams, tallyParams, fmt.Errorf("%s must be set", d.name)
+		}
+		*d.dest = *d.value
+	}
+
+	decs := []struct {
+		name  string
+		value string
+		dest  *sdk.Dec
+	}{
+		{"quorum", params.Quorum, &tallyParams.Quorum},
+		{"threshold", params.Threshold, &tallyParams.Threshold},
+		{"veto_threshold", params.VetoThreshold, &tallyParams.VetoThreshold},
+		{"veto_min_deposit_multiplier", params.VetoMinDepositMultiplier, &depositParams.VetoMinDepositMultiplier},
+		{"inactive_proposal_refund_ratio", params.InactiveProposalRefundRatio, &depositParams.InactiveProposalRefundRatio},
+	}
+	for _, d := range decs {
+		dec, err := sdk.NewDecFromStr(d.value)
+		if err != nil {
+			return depositParams, votingParams, tallyParams, fmt.Errorf("invalid %s: %w", d.name, err)
+		}
+		*d.dest = dec
+	}
+
+	minBonded, ok := sdk.NewIntFromString(params.VoteFeeSponsorshipMinBonded)
+	if !ok {
+		return depositParams, votingParams, tallyParams, fmt.Errorf("invalid vote_fee_sponsorship_min_bonded: %s", params.VoteFeeSponsorshipMinBonded)
+	}
+	votingParams.VoteFeeSponsorshipMinBonded = minBonded
+
+	depositParams.MinDeposit = sdk.Coins(params.MinDeposit)
+	depositParams.AllowedContentTypes = params.AllowedContentTypes
+	depositParams.MaxProposalsPerProposer = params.MaxProposalsPerProposer
+	votingParams.MaxVoteRationaleLength = params.MaxVoteRationaleLength
+	votingParams.EarlyTerminationCheckInterval = params.EarlyTerminationCheckInterval
+	votingParams.VoteFeeSponsorshipBudget = sdk.Coins(params.VoteFeeSponsorshipBudget)
+	votingParams.GovMsgRateLimit = v1beta1.NewGovMsgRateLimit(
+		params.GovMsgRateLimitWindow, params.GovMsgRateLimitMaxProposals, params.GovMsgRateLimitMaxVotes,
+	)
+
+	return depositParams, votingParams, tallyParams, nil
+}
+
+// ConvertToV1beta1MsgVote converts a v1 MsgVote to a v1beta1 MsgVote, whose
+// rationale is the metadata of the vote.
+func ConvertToV1beta1MsgVote(msg MsgVote) v1beta1.MsgVote {
+	return v1beta1.MsgVote{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Option:     v1beta1.VoteOption(msg.Option),
+		Rationale:  msg.Metadata,
+	}
+}
+
+// ConvertToV1beta1MsgVoteWeighted converts a v1 MsgVoteWeighted to a v1beta1
+// MsgVoteWeighted, whose rationale is the metadata of the vote.
+func ConvertToV1beta1MsgVoteWeighted(msg MsgVoteWeighted) (v1beta1.MsgVoteWeighted, error) {
+	options, err := ConvertToV1beta1WeightedVoteOptions(msg.Options)
+	if err != nil {
+		return v1beta1.MsgVoteWeighted{}, err
+	}
+	return v1beta1.MsgVoteWeighted{
+		ProposalId: msg.ProposalId,
+		Voter:      msg.Voter,
+		Options:    options,
+		Rationale:  msg.Metadata,
+	}, nil
+}
+
+// ConvertToV1beta1MsgDeposit converts a v1 MsgDeposit to a v1beta1 MsgDeposit.
+func ConvertToV1beta1MsgDeposit(msg MsgDeposit) v1beta1.MsgDeposit {
+	return v1beta1.MsgDeposit{
+		ProposalId: msg.ProposalId,
+		Depositor:  msg.Depositor,
+		Amount:     msg.Amount,
+	}
+}
+
+// ConvertToV1beta1MsgUpdateParams converts a v1 MsgUpdateParams to a v1beta1
+// MsgUpdateParams.
+func ConvertToV1beta1MsgUpdateParams(msg MsgUpdateParams) (v1beta1.MsgUpdateParams, error) {
+	depositParams, votingParams, tallyParams, err := ConvertToV1beta1Params(msg.Params)
+	if err != nil {
+		return v1beta1.MsgUpdateParams{}, err
+	}
+	return v1beta1.MsgUpdateParams{
+		Authority:     msg.Authority,
+		DepositParams: depositParams,
+		VotingParams:  votingParams,
+		TallyParams:   tallyParams,
+	}, nil
+}
+
+// timePtr returns a pointer to t, or nil if t is the zero time, like the
+// voting times of a proposal in deposit period.
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
+
+// decString returns the string representation of d, an unset decimal being 0.
+func decString(d sdk.Dec) string {
+	if d.IsNil() {
+		return sdk.ZeroDec().String()
+	}
+	return d.String()
+}
+
+// intString returns the string representation of i, an unset integer being 0.
+func intString(i sdk.Int) string {
+	if i.IsNil() {
+		return sdk.ZeroInt().String()
+	}
+	return i.String()
+}
@@ -0,0 +1,124 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+func TestConvertProposal(t *testing.T) {
+	submitTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	proposal, err := types.NewProposal(types.NewTextProposal("title", "description"), 1, submitTime, submitTime.Add(time.Hour))
+	require.NoError(t, err)
+	proposal.TotalDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	proposal.FinalTallyResult = types.NewTallyResult(sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(3), sdk.NewInt(4))
+	proposal.Proposer = sdk.AccAddress("proposer").String()
+	proposal.Metadata = "ipfs://metadata"
+
+	v1Proposal, err := v1.ConvertToV1Proposal(proposal)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v1Proposal.Id)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD, v1Proposal.Status)
+	require.Equal(t, "title", v1Proposal.Title)
+	require.Equal(t, "description", v1Proposal.Summary)
+	require.Equal(t, proposal.Proposer, v1Proposal.Proposer)
+	require.Equal(t, proposal.Metadata, v1Proposal.Metadata)
+	require.Equal(t, "2", v1Proposal.FinalTallyResult.AbstainCount)
+	require.Nil(t, v1Proposal.VotingStartTime)
+	require.Nil(t, v1Proposal.VotingEndTime)
+
+	require.Len(t, v1Proposal.Messages, 1)
+	msg, ok := v1Proposal.Messages[0].GetCachedValue().(*v1.MsgExecLegacyContent)
+	require.True(t, ok)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), msg.Authority)
+	require.Equal(t, proposal.GetContent(), msg.GetLegacyContent())
+
+	legacyProposal, err := v1.ConvertToV1beta1Proposal(v1Proposal)
+	require.NoError(t, err)
+	require.Equal(t, proposal, legacyProposal)
+}
+
+func TestLegacyContentFromMessages(t *testing.T) {
+	content := types.NewTextProposal("title", "description")
+	exec, err := v1.NewMsgExecLegacyContent(content, authtypes.NewModuleAddress(types.ModuleName).String())
+	require.NoError(t, err)
+	execAny, err := codectypes.NewAnyWithValue(exec)
+	require.NoError(t, err)
+	depositAny, err := codectypes.NewAnyWithValue(v1.NewMsgDeposit(sdk.AccAddress("depositor"), 1, nil))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		messages []*codectypes.Any
+		expErr   bool
+	}{
+		{"no message", nil, true},
+		{"single legacy content", []*codectypes.Any{execAny}, false},
+		{"several messages", []*codectypes.Any{execAny, execAny}, true},
+		{"unsupported message", []*codectypes.Any{depositAny}, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := v1.LegacyContentFromMessages(tt.messages)
+			if tt.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidProposalContent)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, content, c)
+		})
+	}
+}
+
+func TestConvertVote(t *testing.T) {
+	vote := types.NewVote(1, sdk.AccAddress("voter"), types.WeightedVoteOptions{
+		{Option: types.OptionYes, Weight: sdk.NewDecWithPrec(7, 1)},
+		{Option: types.OptionNo, Weight: sdk.NewDecWithPrec(3, 1)},
+	}, "rationale")
+
+	v1Vote := v1.ConvertToV1Vote(vote)
+	require.Equal(t, "rationale", v1Vote.Metadata)
+	require.Equal(t, v1.VoteOption_VOTE_OPTION_YES, v1Vote.Options[0].Option)
+	require.Equal(t, "0.700000000000000000", v1Vote.Options[0].Weight)
+
+	legacyVote, err := v1.ConvertToV1beta1Vote(v1Vote)
+	require.NoError(t, err)
+	require.Equal(t, vote, legacyVote)
+
+	v1Vote.Options[0].Weight = "seventy percent"
+	_, err = v1.ConvertToV1beta1Vote(v1Vote)
+	require.Error(t, err)
+}
+
+func TestConvertParams(t *testing.T) {
+	depositParams, votingParams, tallyParams := types.DefaultDepositParams(), types.DefaultVotingParams(), types.DefaultTallyParams()
+
+	params := v1.ConvertToV1Params(depositParams, votingParams, tallyParams)
+	require.Equal(t, depositParams.MaxDepositPeriod, *params.MaxDepositPeriod)
+	require.Equal(t, tallyParams.Quorum.String(), params.Quorum)
+
+	dp, vp, tp, err := v1.ConvertToV1beta1Params(params)
+	require.NoError(t, err)
+	require.Equal(t, depositParams, dp)
+	require.Equal(t, votingParams, vp)
+	require.Equal(t, tallyParams, tp)
+
+	params.VotingPeriodText = nil
+	_, _, _, err = v1.ConvertToV1beta1Params(params)
+	require.ErrorContains(t, err, "voting_period_text must be set")
+
+	params = v1.ConvertToV1Params(depositParams, votingParams, tallyParams)
+	params.VetoThreshold = ""
+	_, _, _, err = v1.ConvertToV1beta1Params(params)
+	require.ErrorContains(t, err, "invalid veto_threshold")
+}
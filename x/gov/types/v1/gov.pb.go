@@ -0,0 +1,3477 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: govgen/gov/v1/gov.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// VoteOption enumerates the valid vote options for a given governance proposal.
+type VoteOption int32
+
+const (
+	// VOTE_OPTION_UNSPECIFIED defines a no-op vote option.
+	VoteOption_VOTE_OPTION_UNSPECIFIED VoteOption = 0
+	// VOTE_OPTION_YES defines a yes vote option.
+	VoteOption_VOTE_OPTION_YES VoteOption = 1
+	// VOTE_OPTION_ABSTAIN defines an abstain vote option.
+	VoteOption_VOTE_OPTION_ABSTAIN VoteOption = 2
+	// VOTE_OPTION_NO defines a no vote option.
+	VoteOption_VOTE_OPTION_NO VoteOption = 3
+	// VOTE_OPTION_NO_WITH_VETO defines a no with veto vote option.
+	VoteOption_VOTE_OPTION_NO_WITH_VETO VoteOption = 4
+)
+
+var VoteOption_name = map[int32]string{
+	0: "VOTE_OPTION_UNSPECIFIED",
+	1: "VOTE_OPTION_YES",
+	2: "VOTE_OPTION_ABSTAIN",
+	3: "VOTE_OPTION_NO",
+	4: "VOTE_OPTION_NO_WITH_VETO",
+}
+
+var VoteOption_value = map[string]int32{
+	"VOTE_OPTION_UNSPECIFIED":  0,
+	"VOTE_OPTION_YES":          1,
+	"VOTE_OPTION_ABSTAIN":      2,
+	"VOTE_OPTION_NO":           3,
+	"VOTE_OPTION_NO_WITH_VETO": 4,
+}
+
+func (x VoteOption) String() string {
+	return proto.EnumName(VoteOption_name, int32(x))
+}
+
+func (VoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{0}
+}
+
+// ProposalStatus enumerates the valid statuses of a proposal.
+type ProposalStatus int32
+
+const (
+	// PROPOSAL_STATUS_UNSPECIFIED defines the default proposal status.
+	ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED ProposalStatus = 0
+	// PROPOSAL_STATUS_DEPOSIT_PERIOD defines a proposal status during the deposit
+	// period.
+	ProposalStatus_PROPOSAL_STATUS_DEPOSIT_PERIOD ProposalStatus = 1
+	// PROPOSAL_STATUS_VOTING_PERIOD defines a proposal status during the voting
+	// period.
+	ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD ProposalStatus = 2
+	// PROPOSAL_STATUS_PASSED defines a proposal status of a proposal that has
+	// passed.
+	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 3
+	// PROPOSAL_STATUS_REJECTED defines a proposal status of a proposal that has
+	// been rejected.
+	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 4
+	// PROPOSAL_STATUS_FAILED defines a proposal status of a proposal that has
+	// failed.
+	ProposalStatus_PROPOSAL_STATUS_FAILED ProposalStatus = 5
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "PROPOSAL_STATUS_UNSPECIFIED",
+	1: "PROPOSAL_STATUS_DEPOSIT_PERIOD",
+	2: "PROPOSAL_STATUS_VOTING_PERIOD",
+	3: "PROPOSAL_STATUS_PASSED",
+	4: "PROPOSAL_STATUS_REJECTED",
+	5: "PROPOSAL_STATUS_FAILED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"PROPOSAL_STATUS_UNSPECIFIED":    0,
+	"PROPOSAL_STATUS_DEPOSIT_PERIOD": 1,
+	"PROPOSAL_STATUS_VOTING_PERIOD":  2,
+	"PROPOSAL_STATUS_PASSED":         3,
+	"PROPOSAL_STATUS_REJECTED":       4,
+	"PROPOSAL_STATUS_FAILED":         5,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{1}
+}
+
+// WeightedVoteOption defines a unit of vote for vote split.
+type WeightedVoteOption struct {
+	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=govgen.gov.v1.VoteOption" json:"option,omitempty"`
+	// weight is the decimal weight of the option, the weights of the options of
+	// a vote sum up to 1.
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
+func (m *WeightedVoteOption) String() string { return proto.CompactTextString(m) }
+func (*WeightedVoteOption) ProtoMessage()    {}
+func (*WeightedVoteOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{0}
+}
+func (m *WeightedVoteOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightedVoteOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WeightedVoteOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WeightedVoteOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightedVoteOption.Merge(m, src)
+}
+func (m *WeightedVoteOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightedVoteOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightedVoteOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightedVoteOption proto.InternalMessageInfo
+
+func (m *WeightedVoteOption) GetOption() VoteOption {
+	if m != nil {
+		return m.Option
+	}
+	return VoteOption_VOTE_OPTION_UNSPECIFIED
+}
+
+func (m *WeightedVoteOption) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+// Deposit defines an amount deposited by an account address to an active
+// proposal.
+type Deposit struct {
+	ProposalId uint64       `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Depositor  string       `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount     []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+}
+
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{1}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Deposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *Deposit) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// Proposal defines the core field members of a governance proposal.
+//
+// The proposals are stored as govgen.gov.v1beta1 proposals, whose content is
+// exposed as a single MsgExecLegacyContent message.
+type Proposal struct {
+	Id       uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Messages []*types1.Any  `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Status   ProposalStatus `protobuf:"varint,3,opt,name=status,proto3,enum=govgen.gov.v1.ProposalStatus" json:"status,omitempty"`
+	// final_tally_result is the final tally result of the proposal. When
+	// querying a proposal via gRPC, this field is not populated until the
+	// proposal's voting period has ended.
+	FinalTallyResult *TallyResult `protobuf:"bytes,4,opt,name=final_tally_result,json=finalTallyResult,proto3" json:"final_tally_result,omitempty"`
+	SubmitTime       *time.Time   `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	DepositEndTime   *time.Time   `protobuf:"bytes,6,opt,name=deposit_end_time,json=depositEndTime,proto3,stdtime" json:"deposit_end_time,omitempty"`
+	TotalDeposit     []types.Coin `protobuf:"bytes,7,rep,name=total_deposit,json=totalDeposit,proto3" json:"total_deposit"`
+	VotingStartTime  *time.Time   `protobuf:"bytes,8,opt,name=voting_start_time,json=votingStartTime,proto3,stdtime" json:"voting_start_time,omitempty"`
+	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
+	// metadata is any arbitrary metadata attached to the proposal.
+	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// title is the title of the proposal content.
+	Title string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
+	// summary is the description of the proposal content.
+	Summary string `protobuf:"bytes,12,opt,name=summary,proto3" json:"summary,omitempty"`
+	// proposer is the address of the proposal submitter, it is empty for the
+	// proposals submitted before it was recorded.
+	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{2}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetMessages() []*types1.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *Proposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
+}
+
+func (m *Proposal) GetFinalTallyResult() *TallyResult {
+	if m != nil {
+		return m.FinalTallyResult
+	}
+	return nil
+}
+
+func (m *Proposal) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetDepositEndTime() *time.Time {
+	if m != nil {
+		return m.DepositEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetTotalDeposit() []types.Coin {
+	if m != nil {
+		return m.TotalDeposit
+	}
+	return nil
+}
+
+func (m *Proposal) GetVotingStartTime() *time.Time {
+	if m != nil {
+		return m.VotingStartTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetVotingEndTime() *time.Time {
+	if m != nil {
+		return m.VotingEndTime
+	}
+	return nil
+}
+
+func (m *Proposal) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+func (m *Proposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Proposal) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// TallyResult defines a standard tally for a governance proposal.
+type TallyResult struct {
+	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
+	AbstainCount    string `protobuf:"bytes,2,opt,name=abstain_count,json=abstainCount,proto3" json:"abstain_count,omitempty"`
+	NoCount         string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
+	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+}
+
+func (m *TallyResult) Reset()         { *m = TallyResult{} }
+func (m *TallyResult) String() string { return proto.CompactTextString(m) }
+func (*TallyResult) ProtoMessage()    {}
+func (*TallyResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{3}
+}
+func (m *TallyResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TallyResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyResult.Merge(m, src)
+}
+func (m *TallyResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyResult proto.InternalMessageInfo
+
+func (m *TallyResult) GetYesCount() string {
+	if m != nil {
+		return m.YesCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetAbstainCount() string {
+	if m != nil {
+		return m.AbstainCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetNoCount() string {
+	if m != nil {
+		return m.NoCount
+	}
+	return ""
+}
+
+func (m *TallyResult) GetNoWithVetoCount() string {
+	if m != nil {
+		return m.NoWithVetoCount
+	}
+	return ""
+}
+
+// Vote defines a vote on a governance proposal.
+// A Vote consists of a proposal ID, the voter, and the vote option.
+type Vote struct {
+	ProposalId uint64                `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Voter      string                `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty"`
+	Options    []*WeightedVoteOption `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	// metadata is any arbitrary metadata attached to the vote, it is stored as
+	// the rationale of the vote.
+	Metadata string `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{4}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *Vote) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+func (m *Vote) GetOptions() []*WeightedVoteOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Vote) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// Params defines the parameters for the gov module. The param change policy
+// is not part of it, since it can only be updated by a parameter change
+// proposal.
+type Params struct {
+	//  Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	//  Maximum period for GOVGEN holders to deposit on a proposal.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	// Type URLs of the proposal contents that can be submitted.
+	AllowedContentTypes []string `protobuf:"bytes,3,rep,name=allowed_content_types,json=allowedContentTypes,proto3" json:"allowed_content_types,omitempty"`
+	// Maximum number of proposals in deposit or voting period that a single
+	// proposer can have at once.
+	MaxProposalsPerProposer uint64 `protobuf:"varint,17,opt,name=max_proposals_per_proposer,json=maxProposalsPerProposer,proto3" json:"max_proposals_per_proposer,omitempty"`
+	// Period during which a proposer whose proposal was vetoed can't submit new
+	// proposals.
+	VetoCooldownPeriod *time.Duration `protobuf:"bytes,18,opt,name=veto_cooldown_period,json=vetoCooldownPeriod,proto3,stdduration" json:"veto_cooldown_period,omitempty"`
+	// Increase of the minimum deposit of the proposals of a proposer for each of
+	// its vetoed proposals.
+	VetoMinDepositMultiplier string `protobuf:"bytes,19,opt,name=veto_min_deposit_multiplier,json=vetoMinDepositMultiplier,proto3" json:"veto_min_deposit_multiplier,omitempty"`
+	// Ratio of the deposits of a proposal which fails to reach the minimum
+	// deposit that is refunded to the depositors, the rest being burned.
+	InactiveProposalRefundRatio string `protobuf:"bytes,20,opt,name=inactive_proposal_refund_ratio,json=inactiveProposalRefundRatio,proto3" json:"inactive_proposal_refund_ratio,omitempty"`
+	// Length of the voting period by default.
+	VotingPeriodDefault *time.Duration `protobuf:"bytes,4,opt,name=voting_period_default,json=votingPeriodDefault,proto3,stdduration" json:"voting_period_default,omitempty"`
+	// Length of the voting period for parameter change proposal.
+	VotingPeriodParameterChange *time.Duration `protobuf:"bytes,5,opt,name=voting_period_parameter_change,json=votingPeriodParameterChange,proto3,stdduration" json:"voting_period_parameter_change,omitempty"`
+	// Length of the voting period for software upgrade and cancel software
+	// upgrade proposal.
+	VotingPeriodSoftwareUpgrade *time.Duration `protobuf:"bytes,6,opt,name=voting_period_software_upgrade,json=votingPeriodSoftwareUpgrade,proto3,stdduration" json:"voting_period_software_upgrade,omitempty"`
+	// Length of the voting period for text proposal.
+	VotingPeriodText *time.Duration `protobuf:"bytes,7,opt,name=voting_period_text,json=votingPeriodText,proto3,stdduration" json:"voting_period_text,omitempty"`
+	// Maximum length in bytes of the rationale attached to a vote.
+	MaxVoteRationaleLength uint64 `protobuf:"varint,8,opt,name=max_vote_rationale_length,json=maxVoteRationaleLength,proto3" json:"max_vote_rationale_length,omitempty"`
+	// Number of blocks between two checks for the early termination of active
+	// proposals.
+	EarlyTerminationCheckInterval uint64 `protobuf:"varint,9,opt,name=early_termination_check_interval,json=earlyTerminationCheckInterval,proto3" json:"early_termination_check_interval,omitempty"`
+	// Maximum fees paid by the vote fee pool for the votes of an account with
+	// bonded stake on a proposal.
+	VoteFeeSponsorshipBudget []types.Coin `protobuf:"bytes,13,rep,name=vote_fee_sponsorship_budget,json=voteFeeSponsorshipBudget,proto3" json:"vote_fee_sponsorship_budget"`
+	// Number of blocks of the sliding window of the governance message rate
+	// limit.
+	GovMsgRateLimitWindow uint64 `protobuf:"varint,14,opt,name=gov_msg_rate_limit_window,json=govMsgRateLimitWindow,proto3" json:"gov_msg_rate_limit_window,omitempty"`
+	// Maximum number of proposals an account can submit in the window.
+	GovMsgRateLimitMaxProposals uint64 `protobuf:"varint,15,opt,name=gov_msg_rate_limit_max_proposals,json=govMsgRateLimitMaxProposals,proto3" json:"gov_msg_rate_limit_max_proposals,omitempty"`
+	// Maximum number of votes an account can cast in the window.
+	GovMsgRateLimitMaxVotes uint64 `protobuf:"varint,16,opt,name=gov_msg_rate_limit_max_votes,json=govMsgRateLimitMaxVotes,proto3" json:"gov_msg_rate_limit_max_votes,omitempty"`
+	// Minimum amount of stake an account must have bonded for the vote fee pool
+	// to pay the fees of its votes.
+	VoteFeeSponsorshipMinBonded string `protobuf:"bytes,21,opt,name=vote_fee_sponsorship_min_bonded,json=voteFeeSponsorshipMinBonded,proto3" json:"vote_fee_sponsorship_min_bonded,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,10,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,11,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,12,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b3108eb4dc4a3ab, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedContentTypes() []string {
+	if m != nil {
+		return m.AllowedContentTypes
+	}
+	return nil
+}
+
+func (m *Params) GetMaxProposalsPerProposer() uint64 {
+	if m != nil {
+		return m.MaxProposalsPerProposer
+	}
+	return 0
+}
+
+func (m *Params) GetVetoCooldownPeriod() *time.Duration {
+	if m != nil {
+		return m.VetoCooldownPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetVetoMinDepositMultiplier() string {
+	if m != nil {
+		return m.VetoMinDepositMultiplier
+	}
+	return ""
+}
+
+func (m *Params) GetInactiveProposalRefundRatio() string {
+	if m != nil {
+		return m.InactiveProposalRefundRatio
+	}
+	return ""
+}
+
+func (m *Params) GetVotingPeriodDefault() *time.Duration {
+	if m != nil {
+		return m.VotingPeriodDefault
+	}
+	return nil
+}
+
+func (m *Params) GetVotingPeriodParameterChange() *time.Duration {
+	if m != nil {
+		return m.VotingPeriodParameterChange
+	}
+	return nil
+}
+
+func (m *Params) GetVotingPeriodSoftwareUpgrade() *time.Duration {
+	if m != nil {
+		return m.VotingPeriodSoftwareUpgrade
+	}
+	return nil
+}
+
+func (m *Params) GetVotingPeriodText() *time.Duration {
+	if m != nil {
+		return m.VotingPeriodText
+	}
+	return nil
+}
+
+func (m *Params) GetMaxVoteRationaleLength() uint64 {
+	if m != nil {
+		return m.MaxVoteRationaleLength
+	}
+	return 0
+}
+
+func (m *Params) GetEarlyTerminationCheckInterval() uint64 {
+	if m != nil {
+		return m.EarlyTerminationCheckInterval
+	}
+	return 0
+}
+
+func (m *Params) GetVoteFeeSponsorshipBudget() []types.Coin {
+	if m != nil {
+		return m.VoteFeeSponsorshipBudget
+	}
+	return nil
+}
+
+func (m *Params) GetGovMsgRateLimitWindow() uint64 {
+	if m != nil {
+		return m.GovMsgRateLimitWindow
+	}
+	return 0
+}
+
+func (m *Params) GetGovMsgRateLimitMaxProposals() uint64 {
+	if m != nil {
+		return m.GovMsgRateLimitMaxProposals
+	}
+	return 0
+}
+
+func (m *Params) GetGovMsgRateLimitMaxVotes() uint64 {
+	if m != nil {
+		return m.GovMsgRateLimitMaxVotes
+	}
+	return 0
+}
+
+func (m *Params) GetVoteFeeSponsorshipMinBonded() string {
+	if m != nil {
+		return m.VoteFeeSponsorshipMinBonded
+	}
+	return ""
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *Params) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("govgen.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("govgen.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*WeightedVoteOption)(nil), "govgen.gov.v1.WeightedVoteOption")
+	proto.RegisterType((*Deposit)(nil), "govgen.gov.v1.Deposit")
+	proto.RegisterType((*Proposal)(nil), "govgen.gov.v1.Proposal")
+	proto.RegisterType((*TallyResult)(nil), "govgen.gov.v1.TallyResult")
+	proto.RegisterType((*Vote)(nil), "govgen.gov.v1.Vote")
+	proto.RegisterType((*Params)(nil), "govgen.gov.v1.Params")
+}
+
+func init() { proto.RegisterFile("govgen/gov/v1/gov.proto", fileDescriptor_3b3108eb4dc4a3ab) }
+
+var fileDescriptor_3b3108eb4dc4a3ab = []byte{
+	// 1451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x36, 0x6d, 0xf9, 0x6b, 0x15, 0xdb, 0xca, 0xda, 0x8e, 0x69, 0x2b, 0x91, 0x1d, 0xbf, 0x78,
+	0x01, 0x23, 0x6d, 0xa5, 0xda, 0x45, 0xd1, 0x16, 0x41, 0x80, 0xca, 0x92, 0x9c, 0x28, 0xb0, 0x2d,
+	0x95, 0x62, 0x6c, 0xb4, 0x87, 0x2e, 0x56, 0xe2, 0x9a, 0x22, 0x4a, 0xee, 0xaa, 0xdc, 0x95, 0x6c,
+	0x1d, 0x7b, 0xec, 0x2d, 0xb7, 0xe6, 0xd0, 0x53, 0x7f, 0x4d, 0x4e, 0x45, 0x8e, 0x3d, 0xb5, 0x45,
+	0xf2, 0x47, 0x8a, 0xfd, 0xa0, 0xbe, 0x9c, 0x22, 0xea, 0x49, 0xdc, 0x79, 0x9e, 0x79, 0x76, 0x67,
+	0x76, 0x66, 0x48, 0x81, 0x2d, 0x9f, 0xf5, 0x7c, 0x42, 0x0b, 0x3e, 0xeb, 0x15, 0x7a, 0x87, 0xf2,
+	0x27, 0xdf, 0x89, 0x99, 0x60, 0x70, 0x45, 0x03, 0x79, 0x69, 0xe9, 0x1d, 0xee, 0xe4, 0x5a, 0x8c,
+	0x47, 0x8c, 0x17, 0x9a, 0x98, 0x93, 0x42, 0xef, 0xb0, 0x49, 0x04, 0x3e, 0x2c, 0xb4, 0x58, 0x40,
+	0x35, 0x7d, 0x67, 0xc3, 0x67, 0x3e, 0x53, 0x8f, 0x05, 0xf9, 0x64, 0xac, 0xbb, 0x3e, 0x63, 0x7e,
+	0x48, 0x0a, 0x6a, 0xd5, 0xec, 0x5e, 0x15, 0x44, 0x10, 0x11, 0x2e, 0x70, 0xd4, 0x31, 0x84, 0xed,
+	0x49, 0x02, 0xa6, 0x7d, 0x03, 0xe5, 0x26, 0x21, 0xaf, 0x1b, 0x63, 0x11, 0x30, 0xb3, 0xe3, 0x3e,
+	0x02, 0xf0, 0x92, 0x04, 0x7e, 0x5b, 0x10, 0xef, 0x82, 0x09, 0x52, 0xeb, 0x48, 0x0c, 0x1e, 0x82,
+	0x05, 0xa6, 0x9e, 0x6c, 0x6b, 0xcf, 0x3a, 0x58, 0x3d, 0xda, 0xce, 0x8f, 0xc5, 0x91, 0x1f, 0x52,
+	0x1d, 0x43, 0x84, 0xf7, 0xc0, 0xc2, 0xb5, 0x12, 0xb2, 0x67, 0xf7, 0xac, 0x83, 0x65, 0xc7, 0xac,
+	0xf6, 0x7f, 0xb2, 0xc0, 0x62, 0x99, 0x74, 0x18, 0x0f, 0x04, 0xdc, 0x05, 0xe9, 0x4e, 0xcc, 0x3a,
+	0x8c, 0xe3, 0x10, 0x05, 0x9e, 0xd2, 0x4e, 0x39, 0x20, 0x31, 0x55, 0x3d, 0x78, 0x1f, 0x2c, 0x7b,
+	0x9a, 0xcb, 0x62, 0xa3, 0x33, 0x34, 0xc0, 0x2f, 0xc0, 0x02, 0x8e, 0x58, 0x97, 0x0a, 0x7b, 0x6e,
+	0x6f, 0xee, 0x20, 0x7d, 0xb4, 0x9d, 0xd7, 0xe9, 0xcc, 0xcb, 0x74, 0xe6, 0x4d, 0x3a, 0xf3, 0x25,
+	0x16, 0xd0, 0xe3, 0xd4, 0xeb, 0x3f, 0x77, 0x67, 0x1c, 0x43, 0xdf, 0xff, 0x6d, 0x1e, 0x2c, 0xd5,
+	0xcd, 0x2e, 0x70, 0x15, 0xcc, 0x0e, 0xf6, 0x9e, 0x0d, 0x3c, 0xf8, 0x29, 0x58, 0x8a, 0x08, 0xe7,
+	0xd8, 0x27, 0xdc, 0x9e, 0x55, 0xba, 0x1b, 0x79, 0x9d, 0xb4, 0x7c, 0x92, 0xb4, 0x7c, 0x91, 0xf6,
+	0x9d, 0x01, 0x0b, 0x7e, 0x0e, 0x16, 0xb8, 0xc0, 0xa2, 0xcb, 0xed, 0x39, 0x95, 0x9d, 0x07, 0x13,
+	0xd9, 0x49, 0xb6, 0x6a, 0x28, 0x92, 0x63, 0xc8, 0xf0, 0x19, 0x80, 0x57, 0x01, 0xc5, 0x21, 0x12,
+	0x38, 0x0c, 0xfb, 0x28, 0x26, 0xbc, 0x1b, 0x0a, 0x3b, 0xb5, 0x67, 0x1d, 0xa4, 0x8f, 0x76, 0x26,
+	0x24, 0x5c, 0x49, 0x71, 0x14, 0xc3, 0xc9, 0x28, 0xaf, 0x11, 0x0b, 0x2c, 0x82, 0x34, 0xef, 0x36,
+	0xa3, 0x40, 0x20, 0x59, 0x09, 0xf6, 0xfc, 0x40, 0x62, 0xfc, 0xd4, 0x6e, 0x52, 0x26, 0xc7, 0xa9,
+	0x97, 0x7f, 0xed, 0x5a, 0x0e, 0xd0, 0x4e, 0xd2, 0x0c, 0x9f, 0x83, 0x8c, 0x49, 0x2c, 0x22, 0xd4,
+	0xd3, 0x3a, 0x0b, 0x53, 0xea, 0xac, 0x1a, 0xcf, 0x0a, 0xf5, 0x94, 0x56, 0x19, 0xac, 0x08, 0x26,
+	0x70, 0x88, 0x8c, 0xdd, 0x5e, 0x9c, 0xee, 0x7a, 0xee, 0x28, 0xaf, 0xa4, 0x38, 0x4e, 0xc1, 0xdd,
+	0x1e, 0x13, 0x01, 0xf5, 0x11, 0x17, 0x38, 0x36, 0xa1, 0x2d, 0x4d, 0x79, 0xa4, 0x35, 0xed, 0xda,
+	0x90, 0x9e, 0xea, 0x4c, 0xcf, 0x80, 0x31, 0x0d, 0xc3, 0x5b, 0x9e, 0x52, 0x6b, 0x45, 0x3b, 0x26,
+	0xd1, 0xed, 0xc8, 0xfa, 0x10, 0xd8, 0xc3, 0x02, 0xdb, 0x40, 0x95, 0xe4, 0x60, 0x0d, 0x37, 0xc0,
+	0xbc, 0x08, 0x44, 0x48, 0xec, 0xb4, 0x02, 0xf4, 0x02, 0xda, 0x60, 0x91, 0x77, 0xa3, 0x08, 0xc7,
+	0x7d, 0xfb, 0x8e, 0xb2, 0x27, 0x4b, 0xa9, 0xa5, 0xab, 0x9d, 0xc4, 0xf6, 0x8a, 0xd6, 0x4a, 0xd6,
+	0xfb, 0xbf, 0x58, 0x20, 0x3d, 0x7a, 0xc9, 0x59, 0xb0, 0xdc, 0x27, 0x1c, 0xb5, 0x54, 0xc1, 0x5b,
+	0x9a, 0xdc, 0x27, 0xbc, 0x24, 0xd7, 0xf0, 0x7f, 0x60, 0x05, 0x37, 0xb9, 0xc0, 0x01, 0x35, 0x04,
+	0xdd, 0x2c, 0x77, 0x8c, 0x51, 0x93, 0xb6, 0xc1, 0x12, 0x65, 0x06, 0x9f, 0xd3, 0x07, 0xa1, 0x4c,
+	0x43, 0x1f, 0x01, 0x48, 0x19, 0xba, 0x0e, 0x44, 0x1b, 0xf5, 0x88, 0x48, 0x48, 0x29, 0x45, 0x5a,
+	0xa3, 0xec, 0x32, 0x10, 0xed, 0x0b, 0x22, 0x34, 0x79, 0xff, 0x57, 0x0b, 0xa4, 0x64, 0xc7, 0x7f,
+	0xb8, 0x7f, 0x37, 0xc0, 0x7c, 0x8f, 0x09, 0x92, 0xf4, 0xae, 0x5e, 0xc0, 0xc7, 0x60, 0x51, 0x0f,
+	0x09, 0x6e, 0xa7, 0x54, 0x65, 0x3c, 0x9c, 0xa8, 0xf6, 0xdb, 0x13, 0xc8, 0x49, 0x3c, 0xc6, 0xd2,
+	0x3f, 0x3f, 0x9e, 0xfe, 0xe7, 0xa9, 0xa5, 0xb9, 0x4c, 0x6a, 0xff, 0x55, 0x1a, 0x2c, 0xd4, 0x71,
+	0x8c, 0x23, 0x0e, 0xbf, 0x06, 0xe9, 0x28, 0xa0, 0x83, 0x3a, 0xb4, 0xa6, 0xab, 0x43, 0x10, 0x05,
+	0x34, 0xa9, 0xc2, 0x33, 0x00, 0x23, 0x7c, 0x93, 0x28, 0xa0, 0x0e, 0x89, 0x03, 0xe6, 0xa9, 0x70,
+	0xd2, 0x47, 0xdb, 0xb7, 0x4a, 0xa7, 0x6c, 0x86, 0xe9, 0x71, 0xea, 0x95, 0xac, 0x9c, 0x4c, 0x84,
+	0x6f, 0x8c, 0x50, 0x5d, 0x39, 0xc2, 0x23, 0xb0, 0x89, 0xc3, 0x90, 0x5d, 0x13, 0x0f, 0xb5, 0x18,
+	0x15, 0x84, 0x0a, 0x24, 0xfa, 0x1d, 0xc2, 0xd5, 0x04, 0x5b, 0x76, 0xd6, 0x0d, 0x58, 0xd2, 0x98,
+	0x2b, 0x21, 0xf8, 0x18, 0xec, 0xc8, 0x23, 0x24, 0x69, 0xe5, 0xf2, 0x10, 0x68, 0x50, 0x36, 0x77,
+	0x55, 0xd2, 0xb7, 0x22, 0x7c, 0x93, 0x8c, 0x19, 0x5e, 0x27, 0x71, 0xdd, 0xc0, 0xf0, 0x1b, 0xb0,
+	0x61, 0x2e, 0x94, 0x85, 0x1e, 0xbb, 0xa6, 0x49, 0x04, 0x70, 0xba, 0x08, 0x60, 0x4f, 0xdd, 0xba,
+	0xf6, 0x35, 0x31, 0x3c, 0x01, 0x59, 0x25, 0x39, 0x92, 0x59, 0x14, 0x75, 0x43, 0x11, 0x74, 0xc2,
+	0x80, 0xc4, 0xf6, 0xba, 0xba, 0x14, 0x5b, 0x52, 0xce, 0x06, 0x79, 0x3c, 0x1b, 0xe0, 0xb0, 0x04,
+	0x72, 0x01, 0xc5, 0x2d, 0x11, 0xf4, 0xc8, 0x20, 0x26, 0x14, 0x93, 0xab, 0x2e, 0xf5, 0x90, 0xda,
+	0xdb, 0xde, 0x50, 0x0a, 0xd9, 0x84, 0x95, 0xc4, 0xe5, 0x28, 0x8e, 0x23, 0x29, 0xb0, 0x01, 0x36,
+	0x4d, 0x3b, 0xeb, 0x78, 0x90, 0x47, 0xae, 0xf0, 0x70, 0x7c, 0x7e, 0x30, 0xae, 0x75, 0xed, 0xad,
+	0x23, 0x2a, 0x6b, 0x5f, 0xe8, 0x81, 0xdc, 0xb8, 0x68, 0x47, 0x56, 0x11, 0x11, 0x24, 0x46, 0xad,
+	0x36, 0xa6, 0x7e, 0x32, 0x59, 0x3f, 0xa8, 0x9e, 0x1d, 0x55, 0xaf, 0x27, 0x22, 0x25, 0xa5, 0x71,
+	0x7b, 0x17, 0xce, 0xae, 0xc4, 0x35, 0x8e, 0x09, 0xea, 0x76, 0xfc, 0x18, 0x7b, 0xc9, 0xdc, 0xfd,
+	0x6f, 0xbb, 0x34, 0x8c, 0xc8, 0x0b, 0xad, 0x21, 0xeb, 0x76, 0x7c, 0x17, 0x41, 0x6e, 0xe4, 0x20,
+	0x9e, 0xae, 0x6e, 0x47, 0x95, 0x5d, 0x72, 0x23, 0xe0, 0x57, 0x60, 0x5b, 0xd6, 0xa0, 0xec, 0x5f,
+	0x7d, 0x49, 0x14, 0x87, 0x04, 0x85, 0x84, 0xfa, 0xa2, 0xad, 0x86, 0x72, 0xca, 0xb9, 0x17, 0xe1,
+	0x1b, 0xd9, 0xb0, 0x4e, 0x02, 0x9f, 0x2a, 0x14, 0x3e, 0x05, 0x7b, 0x04, 0xc7, 0x61, 0x1f, 0x09,
+	0x12, 0x47, 0x01, 0x55, 0x28, 0x6a, 0xb5, 0x49, 0xeb, 0x07, 0x14, 0x50, 0x41, 0xe2, 0x1e, 0x0e,
+	0xd5, 0x28, 0x4e, 0x39, 0x0f, 0x14, 0xcf, 0x1d, 0xd2, 0x4a, 0x92, 0x55, 0x35, 0x24, 0xf8, 0x3d,
+	0xc8, 0xaa, 0xfd, 0xaf, 0x08, 0x41, 0xbc, 0xc3, 0x28, 0x67, 0x31, 0x6f, 0x07, 0x1d, 0xd4, 0xec,
+	0x7a, 0x3e, 0x11, 0xf6, 0xca, 0x74, 0xcd, 0x6d, 0x4b, 0x8d, 0x13, 0x42, 0x1a, 0x43, 0x85, 0x63,
+	0x25, 0x00, 0xbf, 0x04, 0xdb, 0x3e, 0xeb, 0xa1, 0x88, 0xfb, 0x32, 0x44, 0x82, 0xc2, 0x40, 0xbe,
+	0x51, 0xaf, 0x03, 0xea, 0xb1, 0x6b, 0x7b, 0x55, 0x9d, 0x70, 0xd3, 0x67, 0xbd, 0x33, 0xee, 0x3b,
+	0x58, 0x90, 0x53, 0x89, 0x5e, 0x2a, 0x10, 0x56, 0xc0, 0xde, 0x7b, 0x3c, 0xc7, 0x9a, 0xd6, 0x5e,
+	0x53, 0x02, 0xd9, 0x09, 0x81, 0xb3, 0x91, 0xb6, 0x85, 0x4f, 0xc0, 0xfd, 0x7f, 0x91, 0x91, 0x67,
+	0xe6, 0x76, 0x46, 0xb7, 0xfa, 0x6d, 0x09, 0x99, 0x76, 0x0e, 0xcb, 0x60, 0xf7, 0xbd, 0xf9, 0x91,
+	0x7d, 0xda, 0x64, 0xd4, 0x23, 0x9e, 0xbd, 0xa9, 0x3b, 0xeb, 0x76, 0x0a, 0xce, 0x02, 0x7a, 0xac,
+	0x28, 0xf2, 0xbb, 0xed, 0xc7, 0x2e, 0x8b, 0xbb, 0x91, 0x79, 0xb9, 0x99, 0x95, 0xfc, 0x14, 0x13,
+	0xed, 0x98, 0xf0, 0x36, 0x0b, 0x3d, 0xf3, 0x7a, 0x1b, 0x1a, 0xe0, 0xff, 0xc1, 0xaa, 0x9a, 0x09,
+	0x43, 0x8a, 0x7e, 0xd3, 0xad, 0x48, 0xab, 0x9b, 0x18, 0x1f, 0xfd, 0x6c, 0x01, 0x30, 0xf2, 0x59,
+	0x99, 0x05, 0x5b, 0x17, 0x35, 0xb7, 0x82, 0x6a, 0x75, 0xb7, 0x5a, 0x3b, 0x47, 0x2f, 0xce, 0x1b,
+	0xf5, 0x4a, 0xa9, 0x7a, 0x52, 0xad, 0x94, 0x33, 0x33, 0x70, 0x1d, 0xac, 0x8d, 0x82, 0xdf, 0x56,
+	0x1a, 0x19, 0x0b, 0x6e, 0x81, 0xf5, 0x51, 0x63, 0xf1, 0xb8, 0xe1, 0x16, 0xab, 0xe7, 0x99, 0x59,
+	0x08, 0xc1, 0xea, 0x28, 0x70, 0x5e, 0xcb, 0xcc, 0xc1, 0xfb, 0xc0, 0x1e, 0xb7, 0xa1, 0xcb, 0xaa,
+	0xfb, 0x0c, 0x5d, 0x54, 0xdc, 0x5a, 0x26, 0xf5, 0xe8, 0x77, 0x0b, 0xac, 0x8e, 0x7f, 0x99, 0xc1,
+	0x5d, 0x90, 0xad, 0x3b, 0xb5, 0x7a, 0xad, 0x51, 0x3c, 0x45, 0x0d, 0xb7, 0xe8, 0xbe, 0x68, 0x4c,
+	0x9c, 0x69, 0x1f, 0xe4, 0x26, 0x09, 0xe5, 0x4a, 0xbd, 0xd6, 0xa8, 0xba, 0xa8, 0x5e, 0x71, 0xaa,
+	0xb5, 0x72, 0xc6, 0x82, 0x0f, 0xc1, 0x83, 0x49, 0xce, 0x45, 0xcd, 0xad, 0x9e, 0x3f, 0x4d, 0x28,
+	0xb3, 0x70, 0x07, 0xdc, 0x9b, 0xa4, 0xd4, 0x8b, 0x8d, 0x46, 0xa5, 0xac, 0x0f, 0x3d, 0x89, 0x39,
+	0x95, 0xe7, 0x95, 0x92, 0x5b, 0x29, 0x67, 0x52, 0xef, 0xf3, 0x3c, 0x29, 0x56, 0x4f, 0x2b, 0xe5,
+	0xcc, 0xfc, 0xf1, 0xc9, 0xeb, 0xb7, 0x39, 0xeb, 0xcd, 0xdb, 0x9c, 0xf5, 0xf7, 0xdb, 0x9c, 0xf5,
+	0xf2, 0x5d, 0x6e, 0xe6, 0xcd, 0xbb, 0xdc, 0xcc, 0x1f, 0xef, 0x72, 0x33, 0xdf, 0x7d, 0xec, 0x07,
+	0xa2, 0xdd, 0x6d, 0xe6, 0x5b, 0x2c, 0x2a, 0x60, 0xc1, 0x22, 0x46, 0xc9, 0x27, 0xed, 0x6e, 0xb3,
+	0x60, 0xfe, 0xa5, 0xdc, 0xc8, 0x87, 0x82, 0x7a, 0x0f, 0xc9, 0x3f, 0x21, 0x0b, 0x6a, 0x2c, 0x7c,
+	0xf6, 0xcf, 0x00, 0x4f, 0x92, 0x20, 0x64, 0xc5, 0x0c, 0x00, 0x00,
+}
+
+func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightedVoteOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Option != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.VotingEndTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintGov(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.VotingStartTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.VotingStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintGov(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TotalDeposit) > 0 {
+		for iNdEx := len(m.TotalDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DepositEndTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.DepositEndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintGov(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintGov(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FinalTallyResult != nil {
+		{
+			size, err := m.FinalTallyResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NoWithVetoCount) > 0 {
+		i -= len(m.NoWithVetoCount)
+		copy(dAtA[i:], m.NoWithVetoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoWithVetoCount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NoCount) > 0 {
+		i -= len(m.NoCount)
+		copy(dAtA[i:], m.NoCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.NoCount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AbstainCount) > 0 {
+		i -= len(m.AbstainCount)
+		copy(dAtA[i:], m.AbstainCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.AbstainCount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.YesCount) > 0 {
+		i -= len(m.YesCount)
+		copy(dAtA[i:], m.YesCount)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.YesCount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteFeeSponsorshipMinBonded) > 0 {
+		i -= len(m.VoteFeeSponsorshipMinBonded)
+		copy(dAtA[i:], m.VoteFeeSponsorshipMinBonded)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VoteFeeSponsorshipMinBonded)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if len(m.InactiveProposalRefundRatio) > 0 {
+		i -= len(m.InactiveProposalRefundRatio)
+		copy(dAtA[i:], m.InactiveProposalRefundRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.InactiveProposalRefundRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.VetoMinDepositMultiplier) > 0 {
+		i -= len(m.VetoMinDepositMultiplier)
+		copy(dAtA[i:], m.VetoMinDepositMultiplier)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoMinDepositMultiplier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.VetoCooldownPeriod != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VetoCooldownPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VetoCooldownPeriod):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintGov(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MaxProposalsPerProposer != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxProposalsPerProposer))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.GovMsgRateLimitMaxVotes != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GovMsgRateLimitMaxVotes))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GovMsgRateLimitMaxProposals != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GovMsgRateLimitMaxProposals))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.GovMsgRateLimitWindow != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.GovMsgRateLimitWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.VoteFeeSponsorshipBudget) > 0 {
+		for iNdEx := len(m.VoteFeeSponsorshipBudget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoteFeeSponsorshipBudget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.EarlyTerminationCheckInterval != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.EarlyTerminationCheckInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxVoteRationaleLength != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.MaxVoteRationaleLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.VotingPeriodText != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriodText, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodText):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintGov(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.VotingPeriodSoftwareUpgrade != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriodSoftwareUpgrade, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodSoftwareUpgrade):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VotingPeriodParameterChange != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriodParameterChange, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodParameterChange):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotingPeriodDefault != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriodDefault, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodDefault):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedContentTypes) > 0 {
+		for iNdEx := len(m.AllowedContentTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContentTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedContentTypes[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.AllowedContentTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WeightedVoteOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != 0 {
+		n += 1 + sovGov(uint64(m.Option))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovGov(uint64(m.Status))
+	}
+	if m.FinalTallyResult != nil {
+		l = m.FinalTallyResult.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.DepositEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.DepositEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TotalDeposit) > 0 {
+		for _, e := range m.TotalDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingStartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingStartTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingEndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.VotingEndTime)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.YesCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.AbstainCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.NoWithVetoCount)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovGov(uint64(m.ProposalId))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.AllowedContentTypes) > 0 {
+		for _, s := range m.AllowedContentTypes {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.VotingPeriodDefault != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodDefault)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriodParameterChange != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodParameterChange)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriodSoftwareUpgrade != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodSoftwareUpgrade)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriodText != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriodText)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxVoteRationaleLength != 0 {
+		n += 1 + sovGov(uint64(m.MaxVoteRationaleLength))
+	}
+	if m.EarlyTerminationCheckInterval != 0 {
+		n += 1 + sovGov(uint64(m.EarlyTerminationCheckInterval))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.VoteFeeSponsorshipBudget) > 0 {
+		for _, e := range m.VoteFeeSponsorshipBudget {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.GovMsgRateLimitWindow != 0 {
+		n += 1 + sovGov(uint64(m.GovMsgRateLimitWindow))
+	}
+	if m.GovMsgRateLimitMaxProposals != 0 {
+		n += 1 + sovGov(uint64(m.GovMsgRateLimitMaxProposals))
+	}
+	if m.GovMsgRateLimitMaxVotes != 0 {
+		n += 2 + sovGov(uint64(m.GovMsgRateLimitMaxVotes))
+	}
+	if m.MaxProposalsPerProposer != 0 {
+		n += 2 + sovGov(uint64(m.MaxProposalsPerProposer))
+	}
+	if m.VetoCooldownPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VetoCooldownPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoMinDepositMultiplier)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.InactiveProposalRefundRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.VoteFeeSponsorshipMinBonded)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightedVoteOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightedVoteOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= VoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types1.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProposalStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalTallyResult == nil {
+				m.FinalTallyResult = &TallyResult{}
+			}
+			if err := m.FinalTallyResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DepositEndTime == nil {
+				m.DepositEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.DepositEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalDeposit = append(m.TotalDeposit, types.Coin{})
+			if err := m.TotalDeposit[len(m.TotalDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingStartTime == nil {
+				m.VotingStartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VotingStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingEndTime == nil {
+				m.VotingEndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.VotingEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &WeightedVoteOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContentTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContentTypes = append(m.AllowedContentTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodDefault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodDefault == nil {
+				m.VotingPeriodDefault = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriodDefault, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodParameterChange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodParameterChange == nil {
+				m.VotingPeriodParameterChange = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriodParameterChange, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodSoftwareUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodSoftwareUpgrade == nil {
+				m.VotingPeriodSoftwareUpgrade = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriodSoftwareUpgrade, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodText", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriodText == nil {
+				m.VotingPeriodText = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriodText, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteRationaleLength", wireType)
+			}
+			m.MaxVoteRationaleLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteRationaleLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyTerminationCheckInterval", wireType)
+			}
+			m.EarlyTerminationCheckInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EarlyTerminationCheckInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFeeSponsorshipBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteFeeSponsorshipBudget = append(m.VoteFeeSponsorshipBudget, types.Coin{})
+			if err := m.VoteFeeSponsorshipBudget[len(m.VoteFeeSponsorshipBudget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovMsgRateLimitWindow", wireType)
+			}
+			m.GovMsgRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovMsgRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovMsgRateLimitMaxProposals", wireType)
+			}
+			m.GovMsgRateLimitMaxProposals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovMsgRateLimitMaxProposals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovMsgRateLimitMaxVotes", wireType)
+			}
+			m.GovMsgRateLimitMaxVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GovMsgRateLimitMaxVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProposalsPerProposer", wireType)
+			}
+			m.MaxProposalsPerProposer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProposalsPerProposer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoCooldownPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VetoCooldownPeriod == nil {
+				m.VetoCooldownPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VetoCooldownPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoMinDepositMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoMinDepositMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveProposalRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveProposalRefundRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteFeeSponsorshipMinBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteFeeSponsorshipMinBonded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGov(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGov
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGov
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGov
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGov        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGov          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGov = fmt.Errorf("proto: unexpected end of group")
+)
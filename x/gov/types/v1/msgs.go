@@ -0,0 +1,307 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	v1beta1 "github.com/atomone-hub/govgen/x/gov/types"
+)
+
+// Governance message types and routes
+const (
+	TypeMsgDeposit           = "deposit"
+	TypeMsgVote              = "vote"
+	TypeMsgVoteWeighted      = "weighted_vote"
+	TypeMsgSubmitProposal    = "submit_proposal"
+	TypeMsgExecLegacyContent = "exec_legacy_content"
+	TypeMsgUpdateParams      = "update_params"
+
+	// MaxMetadataLen is the maximum length in bytes of the metadata of a
+	// proposal.
+	MaxMetadataLen = 255
+)
+
+var (
+	_, _, _, _ sdk.Msg                       = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgDeposit{}, &MsgVote{}
+	_, _       sdk.Msg                       = &MsgVoteWeighted{}, &MsgUpdateParams{}
+	_, _       types.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+)
+
+// NewMsgSubmitProposal creates a new MsgSubmitProposal.
+//
+//nolint:interfacer
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer sdk.AccAddress, metadata string) (*MsgSubmitProposal, error) {
+	m := &MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer.String(),
+		Metadata:       metadata,
+	}
+	for _, msg := range messages {
+		a, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		m.Messages = append(m.Messages, a)
+	}
+	return m, nil
+}
+
+// GetLegacyContent returns the content of the single MsgExecLegacyContent
+// message of the proposal.
+func (m *MsgSubmitProposal) GetLegacyContent() (v1beta1.Content, error) {
+	return LegacyContentFromMessages(m.Messages)
+}
+
+// Route implements Msg
+func (m MsgSubmitProposal) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (m MsgSubmitProposal) Type() string { return TypeMsgSubmitProposal }
+
+// ValidateBasic implements Msg
+func (m MsgSubmitProposal) ValidateBasic() error {
+	if len(m.Metadata) > MaxMetadataLen {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "metadata is longer than max length of %d", MaxMetadataLen)
+	}
+
+	content, err := m.GetLegacyContent()
+	if err != nil {
+		return err
+	}
+	for _, msg := range m.Messages {
+		if err := msg.GetCachedValue().(sdk.Msg).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	// the proposal is checked the same way as the equivalent v1beta1 one
+	legacyMsg := v1beta1.MsgSubmitProposal{
+		InitialDeposit: m.InitialDeposit,
+		Proposer:       m.Proposer,
+	}
+	if err := legacyMsg.SetContent(content); err != nil {
+		return err
+	}
+	return legacyMsg.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (m MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(m.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgSubmitProposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMessages(m.Messages, unpacker)
+}
+
+// NewMsgExecLegacyContent creates a new MsgExecLegacyContent wrapping the
+// given content.
+func NewMsgExecLegacyContent(content v1beta1.Content, authority string) (*MsgExecLegacyContent, error) {
+	msg, ok := content.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", content)
+	}
+	a, err := types.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgExecLegacyContent{Content: a, Authority: authority}, nil
+}
+
+// GetLegacyContent returns the legacy content of the message.
+func (m *MsgExecLegacyContent) GetLegacyContent() v1beta1.Content {
+	content, ok := m.Content.GetCachedValue().(v1beta1.Content)
+	if !ok {
+		return nil
+	}
+	return content
+}
+
+// Route implements Msg
+func (m MsgExecLegacyContent) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (m MsgExecLegacyContent) Type() string { return TypeMsgExecLegacyContent }
+
+// ValidateBasic implements Msg
+func (m MsgExecLegacyContent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	content := m.GetLegacyContent()
+	if content == nil {
+		return sdkerrors.Wrap(v1beta1.ErrInvalidProposalContent, "missing content")
+	}
+	return content.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (m MsgExecLegacyContent) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (m MsgExecLegacyContent) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgExecLegacyContent) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var content v1beta1.Content
+	return unpacker.UnpackAny(m.Content, &content)
+}
+
+// NewMsgDeposit creates a new MsgDeposit instance
+//
+//nolint:interfacer
+func NewMsgDeposit(depositor sdk.AccAddress, proposalID uint64, amount sdk.Coins) *MsgDeposit {
+	return &MsgDeposit{proposalID, depositor.String(), amount}
+}
+
+// Route implements Msg
+func (msg MsgDeposit) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (msg MsgDeposit) Type() string { return TypeMsgDeposit }
+
+// ValidateBasic implements Msg
+func (msg MsgDeposit) ValidateBasic() error {
+	return ConvertToV1beta1MsgDeposit(msg).ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	depositor, _ := sdk.AccAddressFromBech32(msg.Depositor)
+	return []sdk.AccAddress{depositor}
+}
+
+// NewMsgVote creates a message to cast a vote on an active proposal
+//
+//nolint:interfacer
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, option VoteOption, metadata string) *MsgVote {
+	return &MsgVote{proposalID, voter.String(), option, metadata}
+}
+
+// Route implements Msg
+func (msg MsgVote) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (msg MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic implements Msg
+func (msg MsgVote) ValidateBasic() error {
+	return ConvertToV1beta1MsgVote(msg).ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgVoteWeighted creates a message to cast a vote on an active proposal
+//
+//nolint:interfacer
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID uint64, options []*WeightedVoteOption, metadata string) *MsgVoteWeighted {
+	return &MsgVoteWeighted{proposalID, voter.String(), options, metadata}
+}
+
+// Route implements Msg
+func (msg MsgVoteWeighted) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (msg MsgVoteWeighted) Type() string { return TypeMsgVoteWeighted }
+
+// ValidateBasic implements Msg
+func (msg MsgVoteWeighted) ValidateBasic() error {
+	legacyMsg, err := ConvertToV1beta1MsgVoteWeighted(msg)
+	if err != nil {
+		return sdkerrors.Wrap(v1beta1.ErrInvalidVote, err.Error())
+	}
+	return legacyMsg.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(msg.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+// NewMsgUpdateParams creates a message to update the gov params with the
+// given authority.
+//
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{authority.String(), params}
+}
+
+// Route implements Msg
+func (msg MsgUpdateParams) Route() string { return v1beta1.RouterKey }
+
+// Type implements Msg
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// ValidateBasic implements Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	legacyMsg, err := ConvertToV1beta1MsgUpdateParams(msg)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return legacyMsg.ValidateBasic()
+}
+
+// GetSignBytes implements Msg
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func unpackMessages(messages []*types.Any, unpacker types.AnyUnpacker) error {
+	for _, m := range messages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(m, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
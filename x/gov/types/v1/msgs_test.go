@@ -0,0 +1,53 @@
+package v1_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/atomone-hub/govgen/x/gov/types"
+	v1 "github.com/atomone-hub/govgen/x/gov/types/v1"
+)
+
+func TestMsgSubmitProposal(t *testing.T) {
+	govAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	proposer := sdk.AccAddress("proposer")
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	exec, err := v1.NewMsgExecLegacyContent(types.NewTextProposal("title", "description"), govAddr)
+	require.NoError(t, err)
+	invalidExec, err := v1.NewMsgExecLegacyContent(types.NewTextProposal("", "description"), govAddr)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		messages []sdk.Msg
+		metadata string
+		expPass  bool
+	}{
+		{"valid", []sdk.Msg{exec}, "ipfs://metadata", true},
+		{"no message", nil, "", false},
+		{"invalid content", []sdk.Msg{invalidExec}, "", false},
+		{"metadata too long", []sdk.Msg{exec}, strings.Repeat("a", v1.MaxMetadataLen+1), false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := v1.NewMsgSubmitProposal(tt.messages, deposit, proposer, tt.metadata)
+			require.NoError(t, err)
+
+			if tt.expPass {
+				require.NoError(t, msg.ValidateBasic())
+				require.NotPanics(t, func() { msg.GetSignBytes() })
+				require.Equal(t, []sdk.AccAddress{proposer}, msg.GetSigners())
+			} else {
+				require.Error(t, msg.ValidateBasic())
+			}
+		})
+	}
+}
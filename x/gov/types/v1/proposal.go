@@ -0,0 +1,12 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ types.UnpackInterfacesMessage = Proposal{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return unpackMessages(p.Messages, unpacker)
+}
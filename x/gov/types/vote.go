@@ -13,8 +13,8 @@ import (
 // NewVote creates a new Vote instance
 //
 //nolint:interfacer
-func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
-	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options}
+func NewVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions, rationale string) Vote {
+	return Vote{ProposalId: proposalID, Voter: voter.String(), Options: options, Rationale: rationale}
 }
 
 func (v Vote) String() string {